@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
@@ -96,8 +97,11 @@ var Provider *schema.Provider
 // Provider be errantly reused in ProviderFactories.
 var testAccProviderConfigure sync.Once
 
+// providerConfigureMu serializes the configuration of Provider by concurrent tests in record/replay mode.
+var providerConfigureMu sync.Mutex
+
 func init() {
-	Provider = provider.Provider()
+	Provider = newProvider(func() conns.HTTPTransportWrapper { return vcr.WrapTransport })
 
 	Providers = map[string]*schema.Provider{
 		ProviderName: Provider,
@@ -106,18 +110,44 @@ func init() {
 	// Always allocate a new provider instance each invocation, otherwise gRPC
 	// ProviderConfigure() can overwrite configuration during concurrent testing.
 	ProviderFactories = map[string]func() (*schema.Provider, error){
-		ProviderName: func() (*schema.Provider, error) { return provider.Provider(), nil }, //nolint:unparam
+		ProviderName: func() (*schema.Provider, error) { //nolint:unparam
+			wrapper := vcr.TransportWrapper(vcr.CurrentTestName())
+
+			return newProvider(func() conns.HTTPTransportWrapper { return wrapper }), nil
+		},
+	}
+}
+
+// newProvider returns a new instance of the provider under test.
+// In record/replay mode, its AWS API requests are recorded to or replayed from a test's cassette,
+// as selected by the HTTP transport wrapper returned by wrapper when the provider is configured.
+func newProvider(wrapper func() conns.HTTPTransportWrapper) *schema.Provider {
+	p := provider.Provider()
+
+	if vcr.Enabled() {
+		configure := p.ConfigureContextFunc
+
+		p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return configure(conns.ContextWithHTTPTransportWrapper(ctx, wrapper()), d)
+		}
 	}
+
+	return p
 }
 
 // factoriesInit creates ProviderFactories for the provider under testing.
+// Provider factories are called by the running test after its PreCheck, so in record/replay mode
+// each provider instance is bound to the cassette of the test that calls its factory.
 func factoriesInit(providers *[]*schema.Provider, providerNames []string) map[string]func() (*schema.Provider, error) {
 	var factories = make(map[string]func() (*schema.Provider, error), len(providerNames))
 
 	for _, name := range providerNames {
-		p := provider.Provider()
+		var wrapper conns.HTTPTransportWrapper
+		p := newProvider(func() conns.HTTPTransportWrapper { return wrapper })
 
 		factories[name] = func() (*schema.Provider, error) { //nolint:unparam
+			wrapper = vcr.TransportWrapper(vcr.CurrentTestName())
+
 			return p, nil
 		}
 
@@ -188,30 +218,76 @@ func FactoriesMultipleRegion(providers *[]*schema.Provider, regions int) map[str
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
 func PreCheck(t *testing.T) {
+	// In record/replay mode, each test's requests are recorded to its own cassette,
+	// so Provider is configured for every test rather than once.
+	if vcr.Enabled() {
+		vcr.Activate(t)
+
+		if vcr.CurrentMode() == vcr.ModeReplay {
+			setReplayCredentials()
+		}
+
+		providerConfigureMu.Lock()
+		defer providerConfigureMu.Unlock()
+
+		preCheckConfigure(t)
+
+		return
+	}
+
+	testAccProviderConfigure.Do(func() {
+		preCheckConfigure(t)
+	})
+}
+
+// preCheckConfigure verifies the provider testing configuration and configures Provider.
+func preCheckConfigure(t *testing.T) {
+	conns.FailIfAllEnvVarEmpty(t, []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri}, "credentials for running acceptance testing")
+
+	if os.Getenv(conns.EnvVarAccessKeyId) != "" {
+		conns.FailIfEnvVarEmpty(t, conns.EnvVarSecretAccessKey, "static credentials value when using "+conns.EnvVarAccessKeyId)
+	}
+
+	// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
+	// a provider configuration with a region. This defaults to us-west-2 for provider
+	// developer simplicity and has been in the codebase for a very long time.
+	//
+	// This handling must be preserved until either:
+	//   * AWS_DEFAULT_REGION is required and checked above (should mention us-west-2 default)
+	//   * Region is automatically handled via shared AWS configuration file and still verified
+	region := Region()
+	os.Setenv(conns.EnvVarDefaultRegion, region)
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
-	testAccProviderConfigure.Do(func() {
-		conns.FailIfAllEnvVarEmpty(t, []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri}, "credentials for running acceptance testing")
+	err := Provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
 
-		if os.Getenv(conns.EnvVarAccessKeyId) != "" {
-			conns.FailIfEnvVarEmpty(t, conns.EnvVarSecretAccessKey, "static credentials value when using "+conns.EnvVarAccessKeyId)
+// setReplayCredentials sets placeholder static credentials, unless credentials are already set,
+// as replayed requests are not sent to AWS.
+func setReplayCredentials() {
+	for _, k := range []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri} {
+		if os.Getenv(k) != "" {
+			return
 		}
+	}
 
-		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
-		// a provider configuration with a region. This defaults to us-west-2 for provider
-		// developer simplicity and has been in the codebase for a very long time.
-		//
-		// This handling must be preserved until either:
-		//   * AWS_DEFAULT_REGION is required and checked above (should mention us-west-2 default)
-		//   * Region is automatically handled via shared AWS configuration file and still verified
-		region := Region()
-		os.Setenv(conns.EnvVarDefaultRegion, region)
-
-		err := Provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
-		if err != nil {
-			t.Fatal(err)
-		}
-	})
+	os.Setenv(conns.EnvVarAccessKeyId, "replay")
+	os.Setenv(conns.EnvVarSecretAccessKey, "replay")
+}
+
+// RandomWithPrefix returns a random name with the specified prefix, as sdkacctest.RandomWithPrefix.
+// In record/replay mode, names are generated from the seed recorded in the test's cassette,
+// so that replayed requests match those recorded.
+func RandomWithPrefix(t *testing.T, prefix string) string {
+	if !vcr.Enabled() {
+		return sdkacctest.RandomWithPrefix(prefix)
+	}
+
+	return fmt.Sprintf("%s-%d", prefix, vcr.RandInt(t))
 }
 
 // providerAccountID returns the account ID of an AWS provider
@@ -640,7 +716,7 @@ func PreCheckPartition(partition string, t *testing.T) {
 }
 
 func PreCheckOrganizationsAccount(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn
+	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if tfawserr.ErrMessageContains(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -653,7 +729,7 @@ func PreCheckOrganizationsAccount(t *testing.T) {
 }
 
 func PreCheckOrganizationsEnabled(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn
+	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if tfawserr.ErrMessageContains(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -665,13 +741,13 @@ func PreCheckOrganizationsEnabled(t *testing.T) {
 }
 
 func PreCheckOrganizationManagementAccount(t *testing.T) {
-	organization, err := tforganizations.FindOrganization(Provider.Meta().(*conns.AWSClient).OrganizationsConn)
+	organization, err := tforganizations.FindOrganization(Provider.Meta().(*conns.AWSClient).OrganizationsConn())
 
 	if err != nil {
 		t.Fatalf("error describing AWS Organization: %s", err)
 	}
 
-	callerIdentity, err := tfsts.FindCallerIdentity(Provider.Meta().(*conns.AWSClient).STSConn)
+	callerIdentity, err := tfsts.FindCallerIdentity(Provider.Meta().(*conns.AWSClient).STSConn())
 
 	if err != nil {
 		t.Fatalf("error getting current identity: %s", err)
@@ -683,7 +759,7 @@ func PreCheckOrganizationManagementAccount(t *testing.T) {
 }
 
 func PreCheckHasIAMRole(t *testing.T, roleName string) {
-	conn := Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	input := &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
@@ -702,7 +778,7 @@ func PreCheckHasIAMRole(t *testing.T, roleName string) {
 }
 
 func PreCheckIAMServiceLinkedRole(t *testing.T, pathPrefix string) {
-	conn := Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String(pathPrefix),
@@ -1110,6 +1186,100 @@ provider "aws" {
 `, tag1, value1, tag2, value2))
 }
 
+func ConfigDefaultTags_ExcludeResourceTypes1(resourceType, tag1, value1 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    resource_type_tags {
+      exclude_resource_types = [%[1]q]
+
+      tags = {
+        %[2]q = %[3]q
+      }
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, resourceType, tag1, value1))
+}
+
+func ConfigDefaultTags_IncludeResourceTypes1(resourceType, tag1, value1 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    resource_type_tags {
+      include_resource_types = [%[1]q]
+
+      tags = {
+        %[2]q = %[3]q
+      }
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, resourceType, tag1, value1))
+}
+
+func ConfigDefaultTags_Tags1IncludeResourceTypes1(tag1, value1, resourceType, tag2, value2 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+
+    resource_type_tags {
+      include_resource_types = [%[3]q]
+
+      tags = {
+        %[4]q = %[5]q
+      }
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, tag1, value1, resourceType, tag2, value2))
+}
+
+func ConfigRequiredTags_Keys1(key1 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  required_tags {
+    keys = [%[1]q]
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, key1))
+}
+
 func PreCheckAssumeRoleARN(t *testing.T) {
 	conns.SkipIfEnvVarEmpty(t, conns.EnvVarAccAssumeRoleARN, "Amazon Resource Name (ARN) of existing IAM Role to assume for testing restricted permissions")
 }
@@ -1216,7 +1386,7 @@ func RandomEmailAddress(domainName string) string {
 }
 
 func PreCheckOutpostsOutposts(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).OutpostsConn
+	conn := Provider.Meta().(*conns.AWSClient).OutpostsConn()
 
 	input := &outposts.ListOutpostsInput{}
 
@@ -1285,7 +1455,7 @@ func ACMCertificateRandomSubDomain(rootDomain string) string {
 
 func CheckACMPCACertificateAuthorityActivateCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn
+		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 
 		arn := aws.StringValue(certificateAuthority.Arn)
 
@@ -1342,7 +1512,7 @@ func CheckACMPCACertificateAuthorityActivateCA(certificateAuthority *acmpca.Cert
 
 func CheckACMPCACertificateAuthorityDisableCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn
+		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 
 		_, err := conn.UpdateCertificateAuthority(&acmpca.UpdateCertificateAuthorityInput{
 			CertificateAuthorityArn: certificateAuthority.Arn,
@@ -1360,7 +1530,7 @@ func CheckACMPCACertificateAuthorityExists(resourceName string, certificateAutho
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn
+		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 		input := &acmpca.DescribeCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(rs.Primary.ID),
 		}
@@ -1389,7 +1559,7 @@ func PreCheckAPIGatewayTypeEDGE(t *testing.T) {
 }
 
 func PreCheckDirectoryService(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).DSConn
+	conn := Provider.Meta().(*conns.AWSClient).DSConn()
 
 	input := &directoryservice.DescribeDirectoriesInput{}
 
@@ -1408,7 +1578,7 @@ func PreCheckDirectoryService(t *testing.T) {
 // and we do not have a good read-only way to determine this situation. Here we
 // opt to perform a creation that will fail so we can determine Simple AD support.
 func PreCheckDirectoryServiceSimpleDirectory(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).DSConn
+	conn := Provider.Meta().(*conns.AWSClient).DSConn()
 
 	input := &directoryservice.CreateDirectoryInput{
 		Name:     aws.String("corp.example.com"),
@@ -1681,7 +1851,7 @@ func CheckVPCExists(n string, vpc *ec2.Vpc) resource.TestCheckFunc {
 			return fmt.Errorf("No VPC ID is set")
 		}
 
-		conn := Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := Provider.Meta().(*conns.AWSClient).EC2Conn()
 		DescribeVpcOpts := &ec2.DescribeVpcsInput{
 			VpcIds: []*string{aws.String(rs.Primary.ID)},
 		}
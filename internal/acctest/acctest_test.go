@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -194,7 +193,7 @@ func TestAccAcctestProvider_endpoints(t *testing.T) {
 }
 
 func TestAccAcctestProvider_fipsEndpoint(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(ResourcePrefix)
+	rName := RandomWithPrefix(t, ResourcePrefix)
 	resourceName := "aws_s3_bucket.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -213,6 +212,26 @@ func TestAccAcctestProvider_fipsEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccAcctestProvider_useFIPSEndpoint(t *testing.T) {
+	rName := RandomWithPrefix(t, ResourcePrefix)
+	resourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { PreCheck(t) },
+		ErrorCheck:   ErrorCheck(t),
+		Providers:    Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUseFIPSEndpointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAcctestProvider_unusualEndpoints(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -760,22 +779,21 @@ func testAccCheckEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc
 			return fmt.Errorf("no providers initialized")
 		}
 
-		// Match conns.AWSClient struct field names to endpoint configuration names
-		endpointFieldNameF := func(key string) func(string) bool {
-			return func(name string) bool {
-				serviceUpper := ""
-				var err error
-				if serviceUpper, err = conns.ServiceProviderNameUpper(key); err != nil {
-					return false
-				}
+		// Match conns.AWSClient service client method names to endpoint configuration names
+		endpointConnF := func(client *conns.AWSClient, key string) reflect.Value {
+			serviceUpper, err := conns.ServiceProviderNameUpper(key)
 
-				// exception to dropping "service" because Config collides with various other "Config"s
-				if name == "ConfigServiceConn" && fmt.Sprintf("%sConn", serviceUpper) == "ConfigConn" {
-					return true
-				}
+			if err != nil {
+				return reflect.Value{}
+			}
 
-				return name == fmt.Sprintf("%sConn", serviceUpper)
+			method := reflect.ValueOf(client).MethodByName(fmt.Sprintf("%sConn", serviceUpper))
+
+			if !method.IsValid() {
+				return reflect.Value{}
 			}
+
+			return method.Call(nil)[0]
 		}
 
 		for _, provo := range *providers {
@@ -786,10 +804,10 @@ func testAccCheckEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc
 			providerClient := provo.Meta().(*conns.AWSClient)
 
 			for _, serviceKey := range conns.ServiceKeys() {
-				providerClientField := reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(serviceKey))
+				providerClientField := endpointConnF(providerClient, serviceKey)
 
 				if !providerClientField.IsValid() {
-					return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", serviceKey)
+					return fmt.Errorf("unable to match conns.AWSClient service client method name for endpoint name: %s", serviceKey)
 				}
 
 				actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
@@ -811,22 +829,21 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 			return fmt.Errorf("no providers initialized")
 		}
 
-		// Match conns.AWSClient struct field names to endpoint configuration names
-		endpointFieldNameF := func(key string) func(string) bool {
-			return func(name string) bool {
-				serviceUpper := ""
-				var err error
-				if serviceUpper, err = conns.ServiceProviderNameUpper(key); err != nil {
-					return false
-				}
+		// Match conns.AWSClient service client method names to endpoint configuration names
+		endpointConnF := func(client *conns.AWSClient, key string) reflect.Value {
+			serviceUpper, err := conns.ServiceProviderNameUpper(key)
 
-				// exception to dropping "service" because Config collides with various other "Config"s
-				if name == "ConfigServiceConn" && fmt.Sprintf("%sConn", serviceUpper) == "ConfigConn" {
-					return true
-				}
+			if err != nil {
+				return reflect.Value{}
+			}
 
-				return name == fmt.Sprintf("%sConn", serviceUpper)
+			method := reflect.ValueOf(client).MethodByName(fmt.Sprintf("%sConn", serviceUpper))
+
+			if !method.IsValid() {
+				return reflect.Value{}
 			}
+
+			return method.Call(nil)[0]
 		}
 
 		for _, provo := range *providers {
@@ -836,10 +853,10 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 
 			providerClient := provo.Meta().(*conns.AWSClient)
 
-			providerClientField := reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(unusual1[1]))
+			providerClientField := endpointConnF(providerClient, unusual1[1])
 
 			if !providerClientField.IsValid() {
-				return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", unusual1[1])
+				return fmt.Errorf("unable to match conns.AWSClient service client method name for endpoint name: %s", unusual1[1])
 			}
 
 			actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
@@ -849,10 +866,10 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 				return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", unusual1[1], expectedEndpoint, actualEndpoint)
 			}
 
-			providerClientField = reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(unusual2[1]))
+			providerClientField = endpointConnF(providerClient, unusual2[1])
 
 			if !providerClientField.IsValid() {
-				return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", unusual2[1])
+				return fmt.Errorf("unable to match conns.AWSClient service client method name for endpoint name: %s", unusual2[1])
 			}
 
 			actualEndpoint = reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
@@ -862,10 +879,10 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 				return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", unusual2[1], expectedEndpoint, actualEndpoint)
 			}
 
-			providerClientField = reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(unusual3[1]))
+			providerClientField = endpointConnF(providerClient, unusual3[1])
 
 			if !providerClientField.IsValid() {
-				return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", unusual3[1])
+				return fmt.Errorf("unable to match conns.AWSClient service client method name for endpoint name: %s", unusual3[1])
 			}
 
 			actualEndpoint = reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
@@ -917,6 +934,23 @@ resource "aws_s3_bucket" "test" {
 `, endpoint, rName))
 }
 
+func testAccUseFIPSEndpointConfig(rName string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  use_fips_endpoint = true
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+`, rName))
+}
+
 func testAccUnusualEndpointsConfig(unusual1, unusual2, unusual3 []string) string {
 	//lintignore:AT004
 	return ConfigCompose(
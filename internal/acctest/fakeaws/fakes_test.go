@@ -0,0 +1,158 @@
+package fakeaws_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+// testCRUD creates a resource from `create`, updates it with `update` and deletes it,
+// checking the attributes read after each step. It returns the resource's ID.
+func testCRUD(t *testing.T, client *conns.AWSClient, r *schema.Resource, create, update map[string]interface{}, checks ...func(*schema.ResourceData)) string {
+	t.Helper()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, create)
+
+	if err := r.Create(d, client); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+
+	id := d.Id()
+
+	if id == "" {
+		t.Fatal("resource ID not set by create")
+	}
+
+	if len(checks) > 0 {
+		checks[0](d)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, update)
+	d.SetId(id)
+
+	if err := r.Update(d, client); err != nil {
+		t.Fatalf("error updating: %s", err)
+	}
+
+	if len(checks) > 1 {
+		checks[1](d)
+	}
+
+	if err := r.Delete(d, client); err != nil {
+		t.Fatalf("error deleting: %s", err)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, update)
+	d.SetId(id)
+
+	if err := r.Read(d, client); err != nil {
+		t.Fatalf("error reading deleted resource: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("deleted resource (%s) still exists", id)
+	}
+
+	return id
+}
+
+func testCheckAttr(t *testing.T, d *schema.ResourceData, key string, want interface{}) {
+	t.Helper()
+
+	if got := d.Get(key); got != want {
+		t.Errorf("%s = %v, want %v", key, got, want)
+	}
+}
+
+func TestSQS_queue(t *testing.T) {
+	s := fakeaws.NewServer(t, fakeaws.NewSQS())
+
+	id := testCRUD(t, s.Client(t), tfsqs.ResourceQueue(),
+		map[string]interface{}{
+			"name": "test",
+			"tags": map[string]interface{}{"key1": "value1"},
+		},
+		map[string]interface{}{
+			"name":                       "test",
+			"visibility_timeout_seconds": 60,
+			"tags":                       map[string]interface{}{"key2": "value2"},
+		},
+		func(d *schema.ResourceData) {
+			testCheckAttr(t, d, "arn", "arn:aws:sqs:us-west-2:123456789012:test") //lintignore:AWSAT003,AWSAT005
+			testCheckAttr(t, d, "visibility_timeout_seconds", 30)
+			testCheckAttr(t, d, "tags.key1", "value1")
+		},
+		func(d *schema.ResourceData) {
+			testCheckAttr(t, d, "visibility_timeout_seconds", 60)
+			testCheckAttr(t, d, "tags.key2", "value2")
+		},
+	)
+
+	if want := s.URL + "/123456789012/test"; id != want {
+		t.Errorf("ID = %q, want %q", id, want)
+	}
+}
+
+func TestSNS_topic(t *testing.T) {
+	s := fakeaws.NewServer(t, fakeaws.NewSNS())
+
+	id := testCRUD(t, s.Client(t), tfsns.ResourceTopic(),
+		map[string]interface{}{
+			"name": "test",
+			"tags": map[string]interface{}{"key1": "value1"},
+		},
+		map[string]interface{}{
+			"name":         "test",
+			"display_name": "Test",
+			"tags":         map[string]interface{}{"key2": "value2"},
+		},
+		func(d *schema.ResourceData) {
+			testCheckAttr(t, d, "owner", fakeaws.DefaultAccountID)
+			testCheckAttr(t, d, "tags.key1", "value1")
+		},
+		func(d *schema.ResourceData) {
+			testCheckAttr(t, d, "display_name", "Test")
+			testCheckAttr(t, d, "tags.key2", "value2")
+		},
+	)
+
+	if want := "arn:aws:sns:us-west-2:123456789012:test"; id != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("ID = %q, want %q", id, want)
+	}
+}
+
+func TestSSM_parameter(t *testing.T) {
+	s := fakeaws.NewServer(t, fakeaws.NewSSM())
+
+	testCRUD(t, s.Client(t), tfssm.ResourceParameter(),
+		map[string]interface{}{
+			"name":  "/test",
+			"type":  "String",
+			"value": "value1",
+			"tags":  map[string]interface{}{"key1": "value1"},
+		},
+		map[string]interface{}{
+			"name":        "/test",
+			"type":        "SecureString",
+			"value":       "value2",
+			"description": "Test",
+			"tags":        map[string]interface{}{"key2": "value2"},
+		},
+		func(d *schema.ResourceData) {
+			testCheckAttr(t, d, "arn", "arn:aws:ssm:us-west-2:123456789012:parameter/test") //lintignore:AWSAT003,AWSAT005
+			testCheckAttr(t, d, "value", "value1")
+			testCheckAttr(t, d, "tags.key1", "value1")
+		},
+		func(d *schema.ResourceData) {
+			testCheckAttr(t, d, "description", "Test")
+			testCheckAttr(t, d, "key_id", "alias/aws/ssm")
+			testCheckAttr(t, d, "value", "value2")
+			testCheckAttr(t, d, "tags.key2", "value2")
+		},
+	)
+}
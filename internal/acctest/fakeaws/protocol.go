@@ -0,0 +1,365 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Protocol is the wire protocol of an AWS API.
+type Protocol string
+
+const (
+	ProtocolJSON    Protocol = "json"
+	ProtocolQuery   Protocol = "query"
+	ProtocolRESTXML Protocol = "rest-xml"
+)
+
+// fakeRequestID is the request ID of all responses.
+const fakeRequestID = "00000000-0000-0000-0000-000000000000"
+
+// Request is a request for an AWS API operation.
+type Request struct {
+	*http.Request
+
+	// Body is the request body.
+	Body []byte
+
+	// Operation is the name of the operation, e.g. "CreateQueue".
+	Operation string
+
+	// PathParams are the values of the path parameters of a REST-XML operation, e.g. "Bucket".
+	PathParams map[string]string
+
+	// Protocol is the wire protocol of the request.
+	Protocol Protocol
+
+	// Service is the signing name of the service, e.g. "sqs".
+	Service string
+
+	form url.Values
+}
+
+func (r *Request) parseForm() error {
+	if r.form != nil {
+		return nil
+	}
+
+	form, err := url.ParseQuery(string(r.Body))
+
+	if err != nil {
+		return err
+	}
+
+	r.form = form
+
+	return nil
+}
+
+// DecodeJSON decodes the body of a JSON protocol request into `v`.
+func (r *Request) DecodeJSON(v interface{}) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		return NewError(http.StatusBadRequest, "SerializationException", err.Error())
+	}
+
+	return nil
+}
+
+// Param returns the value of the specified query protocol parameter, e.g. "QueueName".
+func (r *Request) Param(name string) string {
+	return r.form.Get(name)
+}
+
+// ParamList returns the values of a query protocol list parameter, e.g. "AttributeName" for
+// "AttributeName.1", "AttributeName.2", ... or "TagKeys.member" for "TagKeys.member.1", ...
+func (r *Request) ParamList(prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		v, ok := r.form[prefix+"."+strconv.Itoa(i)]
+
+		if !ok {
+			return values
+		}
+
+		values = append(values, v[0])
+	}
+}
+
+// ParamMap returns the entries of a query protocol map parameter, e.g. "Attribute", "Name" and "Value" for
+// "Attribute.1.Name" and "Attribute.1.Value", ... or "Tags.member", "Key" and "Value" for "Tags.member.1.Key", ...
+func (r *Request) ParamMap(prefix, key, value string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k, ok := r.form[fmt.Sprintf("%s.%d.%s", prefix, i, key)]
+
+		if !ok {
+			return m
+		}
+
+		m[k[0]] = r.form.Get(fmt.Sprintf("%s.%d.%s", prefix, i, value))
+	}
+}
+
+// Error is an AWS API error response.
+type Error struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+// NewError returns an AWS API error response with the specified HTTP status code, error code and message.
+func NewError(statusCode int, code, message string) *Error {
+	return &Error{
+		Code:       code,
+		Message:    message,
+		StatusCode: statusCode,
+	}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+type jsonError struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+type queryError struct {
+	XMLName   xml.Name `xml:"ErrorResponse"`
+	Type      string   `xml:"Error>Type"`
+	Code      string   `xml:"Error>Code"`
+	Message   string   `xml:"Error>Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+// s3Error is the error response of Amazon S3, which unlike other REST-XML services is not wrapped in <ErrorResponse>.
+type s3Error struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+type queryResponse struct {
+	XMLName   xml.Name
+	Result    queryResult
+	RequestID string `xml:"ResponseMetadata>RequestId"`
+}
+
+// queryResult is the <Operation>Result element of a query protocol response, whose children are the output's fields.
+type queryResult struct {
+	name   string
+	output interface{}
+}
+
+func (r queryResult) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := xml.StartElement{Name: xml.Name{Local: r.name}}
+
+	if r.output == nil {
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+
+		return e.EncodeToken(start.End())
+	}
+
+	return e.EncodeElement(r.output, start)
+}
+
+func writeResponse(w http.ResponseWriter, r *Request, output interface{}, err error) {
+	w.Header().Set("X-Amz-Request-Id", fakeRequestID)
+	w.Header().Set("X-Amzn-Requestid", fakeRequestID)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	var body []byte
+
+	switch r.Protocol {
+	case ProtocolJSON:
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		if output == nil {
+			output = struct{}{}
+		}
+
+		body, err = json.Marshal(output)
+
+	case ProtocolQuery:
+		w.Header().Set("Content-Type", "text/xml")
+
+		body, err = xml.Marshal(queryResponse{
+			XMLName:   xml.Name{Local: r.Operation + "Response"},
+			Result:    queryResult{name: r.Operation + "Result", output: output},
+			RequestID: fakeRequestID,
+		})
+
+	default:
+		w.Header().Set("Content-Type", "application/xml")
+
+		if output != nil {
+			body, err = xml.Marshal(output)
+		}
+	}
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body) //nolint:errcheck
+}
+
+func writeError(w http.ResponseWriter, r *Request, err error) {
+	apiErr, ok := err.(*Error) //nolint:errorlint // Handlers return *Error unwrapped
+
+	if !ok {
+		apiErr = NewError(http.StatusInternalServerError, "InternalFailure", err.Error())
+	}
+
+	var body []byte
+
+	switch r.Protocol {
+	case ProtocolJSON:
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		body, _ = json.Marshal(jsonError{
+			Type:    apiErr.Code,
+			Message: apiErr.Message,
+		})
+
+	default:
+		w.Header().Set("Content-Type", "text/xml")
+
+		if r.Service == "s3" {
+			body, _ = xml.Marshal(s3Error{
+				Code:      apiErr.Code,
+				Message:   apiErr.Message,
+				RequestID: fakeRequestID,
+			})
+		} else {
+			errorType := "Sender"
+
+			if apiErr.StatusCode >= http.StatusInternalServerError {
+				errorType = "Receiver"
+			}
+
+			body, _ = xml.Marshal(queryError{
+				Type:      errorType,
+				Code:      apiErr.Code,
+				Message:   apiErr.Message,
+				RequestID: fakeRequestID,
+			})
+		}
+	}
+
+	w.WriteHeader(apiErr.StatusCode)
+	w.Write(body) //nolint:errcheck
+}
+
+// route maps REST-XML requests to an operation.
+type route struct {
+	handler   HandlerFunc
+	method    string
+	operation string
+	path      []string
+	query     []string
+	service   string
+}
+
+func newRoute(service, operation, method, pattern string, h HandlerFunc) *route {
+	r := &route{
+		handler:   h,
+		method:    method,
+		operation: operation,
+		service:   service,
+	}
+
+	path := pattern
+
+	if i := strings.Index(pattern, "?"); i >= 0 {
+		path = pattern[:i]
+
+		for _, v := range strings.Split(pattern[i+1:], "&") {
+			if v != "" {
+				r.query = append(r.query, v)
+			}
+		}
+	}
+
+	r.path = splitPath(path)
+
+	return r
+}
+
+// match returns the path parameters of the request, or nil if the request does not match the route.
+func (r *route) match(httpReq *http.Request) map[string]string {
+	if httpReq.Method != r.method {
+		return nil
+	}
+
+	query := httpReq.URL.Query()
+
+	for _, v := range r.query {
+		if _, ok := query[v]; !ok {
+			return nil
+		}
+	}
+
+	segments := splitPath(httpReq.URL.Path)
+	params := make(map[string]string)
+
+	for i, pattern := range r.path {
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "+}") {
+			if i >= len(segments) {
+				return nil
+			}
+
+			params[strings.Trim(pattern, "{+}")] = strings.Join(segments[i:], "/")
+
+			return params
+		}
+
+		if i >= len(segments) {
+			return nil
+		}
+
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "}") {
+			params[strings.Trim(pattern, "{}")] = segments[i]
+		} else if pattern != segments[i] {
+			return nil
+		}
+	}
+
+	if len(segments) != len(r.path) {
+		return nil
+	}
+
+	return params
+}
+
+func splitPath(path string) []string {
+	var segments []string
+
+	for _, v := range strings.Split(path, "/") {
+		if v != "" {
+			segments = append(segments, v)
+		}
+	}
+
+	return segments
+}
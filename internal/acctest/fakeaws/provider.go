@@ -0,0 +1,187 @@
+package fakeaws
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	fakeAccessKey = "fakeaws-access-key"
+	fakeSecretKey = "fakeaws-secret-key"
+)
+
+// Config returns the provider configuration that points the endpoints of every service at the Server.
+func (s *Server) Config() *conns.Config {
+	config := &conns.Config{
+		AccessKey:            fakeAccessKey,
+		Endpoints:            make(map[string]string),
+		MaxRetries:           1,
+		Region:               s.Region,
+		S3ForcePathStyle:     true,
+		SecretKey:            fakeSecretKey,
+		SkipGetEC2Platforms:  true,
+		SkipMetadataApiCheck: true,
+		SkipRegionValidation: true,
+		TerraformVersion:     "0.0.0",
+	}
+
+	for _, serviceKey := range conns.ServiceKeys() {
+		config.Endpoints[serviceKey] = s.URL
+	}
+
+	return config
+}
+
+// Client returns an AWSClient whose service clients send requests to the Server.
+// It can be passed as the `meta` argument to resource CRUD functions.
+func (s *Server) Client(t *testing.T) *conns.AWSClient {
+	client, err := s.Config().Client()
+
+	if err != nil {
+		t.Fatalf("error configuring fake AWS client: %s", err)
+	}
+
+	return client.(*conns.AWSClient)
+}
+
+// ProviderFactories returns provider factories for use in resource.TestCase.
+// The provider is configured by the Server rather than by the provider configuration block,
+// so that no request can reach AWS. Provider arguments such as default_tags are therefore ignored.
+func (s *Server) ProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"aws": func() (*schema.Provider, error) {
+			p := provider.Provider()
+
+			p.Schema["region"].DefaultFunc = func() (interface{}, error) {
+				return s.Region, nil
+			}
+			p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				client, err := s.Config().Client()
+
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+
+				return client, nil
+			}
+
+			return p, nil
+		},
+	}
+}
+
+// UnitTest runs the test case with the provider configured by the Server.
+// The Terraform CLI is required. The test is skipped if it is not available,
+// rather than downloading it during unit test runs.
+func (s *Server) UnitTest(t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("skipping fake AWS unit test: Terraform CLI not found (set TF_ACC_TERRAFORM_PATH)")
+		}
+	}
+
+	c.ProviderFactories = s.ProviderFactories()
+
+	resource.UnitTest(t, c)
+}
+
+// Resource is a resource of the provider whose CRUD functions are called directly, with a client
+// configured by the Server, rather than by the Terraform CLI.
+// Each method plans the change as Terraform would and fails the test on error.
+type Resource struct {
+	client   *conns.AWSClient
+	resource *schema.Resource
+	t        *testing.T
+}
+
+// Resource returns the provider's resource of the specified type, e.g. "aws_sqs_queue".
+// Unlike UnitTest, the Terraform CLI is not required.
+func (s *Server) Resource(t *testing.T, resourceType string) *Resource {
+	r, ok := provider.Provider().ResourcesMap[resourceType]
+
+	if !ok {
+		t.Fatalf("unknown resource type: %s", resourceType)
+	}
+
+	return &Resource{
+		client:   s.Client(t),
+		resource: r,
+		t:        t,
+	}
+}
+
+// Create creates the resource from the specified configuration and returns its state.
+func (r *Resource) Create(config map[string]interface{}) *terraform.InstanceState {
+	r.t.Helper()
+
+	return r.apply(nil, config)
+}
+
+// Read refreshes the resource's state. It returns nil if the resource no longer exists.
+func (r *Resource) Read(state *terraform.InstanceState) *terraform.InstanceState {
+	r.t.Helper()
+
+	state, diags := r.resource.RefreshWithoutUpgrade(context.Background(), state, r.client)
+
+	if diags.HasError() {
+		r.t.Fatalf("error reading: %v", diags)
+	}
+
+	return state
+}
+
+// Update updates the resource to the specified configuration and returns its new state.
+func (r *Resource) Update(state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	r.t.Helper()
+
+	return r.apply(state, config)
+}
+
+// Delete deletes the resource.
+func (r *Resource) Delete(state *terraform.InstanceState) {
+	r.t.Helper()
+
+	state, diags := r.resource.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, r.client)
+
+	if diags.HasError() {
+		r.t.Fatalf("error deleting: %v", diags)
+	}
+
+	if state != nil && state.ID != "" {
+		r.t.Fatalf("resource (%s) not deleted", state.ID)
+	}
+}
+
+func (r *Resource) apply(state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	r.t.Helper()
+
+	ctx := context.Background()
+	diff, err := r.resource.Diff(ctx, state, terraform.NewResourceConfigRaw(config), r.client)
+
+	if err != nil {
+		r.t.Fatalf("error planning: %s", err)
+	}
+
+	state, diags := r.resource.Apply(ctx, state, diff, r.client)
+
+	if diags.HasError() {
+		r.t.Fatalf("error applying: %v", diags)
+	}
+
+	if state == nil || state.ID == "" {
+		r.t.Fatal("resource ID not set by apply")
+	}
+
+	return state
+}
@@ -0,0 +1,227 @@
+// Package fakeaws provides an in-process stand-in for AWS service APIs,
+// so that resource CRUD can be unit tested without credentials or network access.
+//
+// A Server is an httptest.Server to which the endpoints of every service are pointed.
+// Tests register canned or stateful handlers for each operation they expect to be called,
+// or register ready-made stateful fakes such as NewSQS, NewSNS and NewSSM:
+//
+//	func TestQueue_fakeAWS(t *testing.T) {
+//		s := fakeaws.NewServer(t, fakeaws.NewSQS())
+//
+//		s.UnitTest(t, resource.TestCase{
+//			Steps: []resource.TestStep{
+//				{
+//					Config: `resource "aws_sqs_queue" "test" { name = "test" }`,
+//				},
+//			},
+//		})
+//	}
+package fakeaws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID of the credentials used with a Server.
+	DefaultAccountID = "123456789012"
+
+	// DefaultRegion is the AWS Region of the provider configured by a Server.
+	DefaultRegion = "us-west-2" //lintignore:AWSAT003
+)
+
+// HandlerFunc handles a request for an AWS API operation.
+// The returned output is encoded in the request's protocol: JSON for the JSON protocol,
+// and XML for the query and REST-XML protocols, wrapped in the <Operation>Result element for the query protocol.
+// A returned *Error is encoded as an AWS error response. Other errors are returned as internal failures.
+type HandlerFunc func(r *Request) (interface{}, error)
+
+// Fake is a stateful stand-in for an AWS service that registers handlers for the operations it supports.
+type Fake interface {
+	Register(s *Server)
+}
+
+// Server is an in-process stand-in for AWS service APIs.
+type Server struct {
+	AccountID string
+	Region    string
+	URL       string
+
+	calls    []string
+	handlers map[string]map[string]HandlerFunc
+	mu       sync.Mutex
+	routes   []*route
+	server   *httptest.Server
+	t        *testing.T
+}
+
+// NewServer starts a Server that is closed when the test completes.
+// The Server handles STS GetCallerIdentity, used to validate the provider's credentials,
+// in addition to the operations of the specified fakes.
+func NewServer(t *testing.T, fakes ...Fake) *Server {
+	s := &Server{
+		AccountID: DefaultAccountID,
+		Region:    DefaultRegion,
+		handlers:  make(map[string]map[string]HandlerFunc),
+		t:         t,
+	}
+
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL
+	t.Cleanup(s.server.Close)
+
+	newSTS().Register(s)
+
+	for _, fake := range fakes {
+		fake.Register(s)
+	}
+
+	return s
+}
+
+// Handle registers the handler for the specified operation of a service using the JSON or query protocol.
+// `service` is the service's signing name, e.g. "sqs".
+func (s *Server) Handle(service, operation string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.handlers[service] == nil {
+		s.handlers[service] = make(map[string]HandlerFunc)
+	}
+
+	s.handlers[service][operation] = h
+}
+
+// HandleRESTXML registers the handler for the specified operation of a service using the REST-XML protocol.
+// The operation's HTTP method and path pattern are those of the service's API model, e.g.
+// "PUT" and "/{Bucket}?tagging". Path parameters are available from Request.PathParams.
+func (s *Server) HandleRESTXML(service, operation, method, pattern string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = append(s.routes, newRoute(service, operation, method, pattern, h))
+}
+
+// Calls returns the operations called so far, in order, formatted as "service:Operation".
+func (s *Server) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var calls []string
+
+	return append(calls, s.calls...)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, httpReq *http.Request) {
+	body, err := ioutil.ReadAll(httpReq.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r, h := s.resolve(httpReq, body)
+
+	if h == nil {
+		s.t.Errorf("fakeaws: unhandled %s request for service (%s): %s %s", r.Protocol, r.Service, httpReq.Method, httpReq.URL)
+		writeResponse(w, r, nil, NewError(http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("fakeaws: operation not implemented: %s", r.Operation)))
+		return
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, fmt.Sprintf("%s:%s", r.Service, r.Operation))
+	s.mu.Unlock()
+
+	log.Printf("[DEBUG] fakeaws: %s:%s", r.Service, r.Operation)
+
+	output, err := h(r)
+
+	writeResponse(w, r, output, err)
+}
+
+// resolve returns the request and the handler for its operation, which is nil if none is registered.
+func (s *Server) resolve(httpReq *http.Request, body []byte) (*Request, HandlerFunc) {
+	r := &Request{
+		Request: httpReq,
+		Body:    body,
+		Service: signingName(httpReq),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if target := httpReq.Header.Get("X-Amz-Target"); target != "" {
+		r.Protocol = ProtocolJSON
+		r.Operation = target[strings.LastIndex(target, ".")+1:]
+
+		return r, s.handlers[r.Service][r.Operation]
+	}
+
+	if strings.HasPrefix(httpReq.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if err := r.parseForm(); err == nil && r.form.Get("Action") != "" {
+			r.Protocol = ProtocolQuery
+			r.Operation = r.form.Get("Action")
+
+			return r, s.handlers[r.Service][r.Operation]
+		}
+	}
+
+	r.Protocol = ProtocolRESTXML
+
+	var matches []*route
+
+	for _, route := range s.routes {
+		if route.service == r.Service && route.match(httpReq) != nil {
+			matches = append(matches, route)
+		}
+	}
+
+	if len(matches) == 0 {
+		return r, nil
+	}
+
+	// Prefer the most specific route, e.g. "/{Bucket}?tagging" over "/{Bucket}".
+	sort.SliceStable(matches, func(i, j int) bool {
+		return len(matches[i].query) > len(matches[j].query)
+	})
+
+	route := matches[0]
+	r.Operation = route.operation
+	r.PathParams = route.match(httpReq)
+
+	return r, route.handler
+}
+
+// signingName returns the service signing name from the request's Signature Version 4 credential scope,
+// e.g. "Credential=AKID/20211018/us-west-2/sqs/aws4_request".
+func signingName(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	i := strings.Index(authorization, "Credential=")
+
+	if i < 0 {
+		return ""
+	}
+
+	credential := authorization[i+len("Credential="):]
+
+	if i := strings.IndexAny(credential, ", "); i >= 0 {
+		credential = credential[:i]
+	}
+
+	parts := strings.Split(credential, "/")
+
+	if len(parts) != 5 {
+		return ""
+	}
+
+	return parts[3]
+}
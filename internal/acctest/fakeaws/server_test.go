@@ -0,0 +1,191 @@
+package fakeaws_test
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+)
+
+func TestServer_client(t *testing.T) {
+	s := fakeaws.NewServer(t)
+	client := s.Client(t)
+
+	if got, want := client.AccountID, fakeaws.DefaultAccountID; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+
+	if got, want := client.Region, fakeaws.DefaultRegion; got != want {
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+
+	if got, want := client.Partition, "aws"; got != want {
+		t.Errorf("Partition = %q, want %q", got, want)
+	}
+}
+
+func TestServer_query(t *testing.T) {
+	s := fakeaws.NewServer(t)
+
+	s.Handle("sqs", "GetQueueUrl", func(r *fakeaws.Request) (interface{}, error) {
+		if got, want := r.Param("QueueName"), "test"; got != want {
+			t.Errorf("QueueName = %q, want %q", got, want)
+		}
+
+		return &struct{ QueueUrl string }{QueueUrl: "https://example.com/test"}, nil
+	})
+
+	output, err := s.Client(t).SQSConn().GetQueueUrl(&sqs.GetQueueUrlInput{
+		QueueName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.QueueUrl), "https://example.com/test"; got != want {
+		t.Errorf("QueueUrl = %q, want %q", got, want)
+	}
+
+	if got, want := serviceCalls(s, "sqs"), []string{"sqs:GetQueueUrl"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls() = %v, want %v", got, want)
+	}
+}
+
+func TestServer_json(t *testing.T) {
+	s := fakeaws.NewServer(t)
+
+	s.Handle("ssm", "GetParameter", func(r *fakeaws.Request) (interface{}, error) {
+		var input struct{ Name string }
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{
+			"Parameter": map[string]interface{}{
+				"Name":  input.Name,
+				"Value": "value",
+			},
+		}, nil
+	})
+
+	output, err := s.Client(t).SSMConn().GetParameter(&ssm.GetParameterInput{
+		Name: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Parameter.Name), "test"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	if got, want := aws.StringValue(output.Parameter.Value), "value"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+}
+
+func TestServer_restXML(t *testing.T) {
+	s := fakeaws.NewServer(t)
+
+	s.HandleRESTXML("s3", "HeadBucket", http.MethodHead, "/{Bucket}", func(r *fakeaws.Request) (interface{}, error) {
+		return nil, nil
+	})
+	s.HandleRESTXML("s3", "GetBucketTagging", http.MethodGet, "/{Bucket}?tagging", func(r *fakeaws.Request) (interface{}, error) {
+		return &struct {
+			XMLName struct{} `xml:"Tagging"`
+			Tags    []struct {
+				Key   string
+				Value string
+			} `xml:"TagSet>Tag"`
+		}{
+			Tags: []struct {
+				Key   string
+				Value string
+			}{
+				{Key: "Bucket", Value: r.PathParams["Bucket"]},
+			},
+		}, nil
+	})
+	s.HandleRESTXML("s3", "GetObject", http.MethodGet, "/{Bucket}/{Key+}", func(r *fakeaws.Request) (interface{}, error) {
+		return nil, fakeaws.NewError(http.StatusNotFound, s3.ErrCodeNoSuchKey, r.PathParams["Key"])
+	})
+
+	conn := s.Client(t).S3Conn()
+
+	if _, err := conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output, err := conn.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output.TagSet), 1; got != want {
+		t.Fatalf("len(TagSet) = %d, want %d", got, want)
+	}
+
+	if got, want := aws.StringValue(output.TagSet[0].Value), "test"; got != want {
+		t.Errorf("Bucket tag = %q, want %q", got, want)
+	}
+
+	_, err = conn.GetObject(&s3.GetObjectInput{Bucket: aws.String("test"), Key: aws.String("a/b")})
+
+	if !tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchKey, "a/b") {
+		t.Errorf("GetObject error = %v, want %s", err, s3.ErrCodeNoSuchKey)
+	}
+
+	if got, want := serviceCalls(s, "s3"), []string{"s3:HeadBucket", "s3:GetBucketTagging", "s3:GetObject"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls() = %v, want %v", got, want)
+	}
+}
+
+func TestServer_errors(t *testing.T) {
+	s := fakeaws.NewServer(t)
+
+	s.Handle("sqs", "GetQueueUrl", func(r *fakeaws.Request) (interface{}, error) {
+		return nil, fakeaws.NewError(http.StatusBadRequest, sqs.ErrCodeQueueDoesNotExist, "query error")
+	})
+	s.Handle("ssm", "GetParameter", func(r *fakeaws.Request) (interface{}, error) {
+		return nil, fakeaws.NewError(http.StatusBadRequest, ssm.ErrCodeParameterNotFound, "json error")
+	})
+
+	client := s.Client(t)
+
+	_, err := client.SQSConn().GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("test")})
+
+	if !tfawserr.ErrMessageContains(err, sqs.ErrCodeQueueDoesNotExist, "query error") {
+		t.Errorf("query protocol error = %v, want %s", err, sqs.ErrCodeQueueDoesNotExist)
+	}
+
+	_, err = client.SSMConn().GetParameter(&ssm.GetParameterInput{Name: aws.String("test")})
+
+	if !tfawserr.ErrMessageContains(err, ssm.ErrCodeParameterNotFound, "json error") {
+		t.Errorf("JSON protocol error = %v, want %s", err, ssm.ErrCodeParameterNotFound)
+	}
+}
+
+// serviceCalls returns the calls to the specified service's operations,
+// ignoring the calls made while configuring the client.
+func serviceCalls(s *fakeaws.Server, service string) []string {
+	var calls []string
+
+	for _, call := range s.Calls() {
+		if strings.HasPrefix(call, service+":") {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
@@ -0,0 +1,217 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// SNS is a stateful fake of the Amazon SNS topic management operations.
+type SNS struct {
+	mu     sync.Mutex
+	topics map[string]*snsTopic // Keyed by topic ARN.
+}
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// NewSNS returns a fake of Amazon SNS with no topics.
+func NewSNS() *SNS {
+	return &SNS{
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+// snsReadOnlyTopicAttributes are the topic attributes that cannot be set.
+var snsReadOnlyTopicAttributes = map[string]bool{
+	"EffectiveDeliveryPolicy": true,
+	"Owner":                   true,
+	"SubscriptionsConfirmed":  true,
+	"SubscriptionsDeleted":    true,
+	"SubscriptionsPending":    true,
+	"TopicArn":                true,
+}
+
+type snsEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type snsTag struct {
+	Key   string
+	Value string
+}
+
+type snsCreateTopicOutput struct {
+	TopicArn string
+}
+
+type snsGetTopicAttributesOutput struct {
+	Attributes []snsEntry `xml:"Attributes>entry"`
+}
+
+type snsListTagsForResourceOutput struct {
+	Tags []snsTag `xml:"Tags>member"`
+}
+
+// Register implements Fake.
+func (f *SNS) Register(s *Server) {
+	s.Handle("sns", "CreateTopic", func(r *Request) (interface{}, error) {
+		name := r.Param("Name")
+		arn := fmt.Sprintf("arn:aws:sns:%s:%s:%s", s.Region, s.AccountID, name)
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, ok := f.topics[arn]; ok {
+			return &snsCreateTopicOutput{TopicArn: arn}, nil
+		}
+
+		topic := &snsTopic{
+			attributes: map[string]string{
+				"DisplayName":            "",
+				"Owner":                  s.AccountID,
+				"SubscriptionsConfirmed": "0",
+				"SubscriptionsDeleted":   "0",
+				"SubscriptionsPending":   "0",
+				"TopicArn":               arn,
+			},
+			tags: r.ParamMap("Tags.member", "Key", "Value"),
+		}
+
+		for k, v := range r.ParamMap("Attributes.entry", "key", "value") {
+			topic.attributes[k] = v
+		}
+
+		f.topics[arn] = topic
+
+		return &snsCreateTopicOutput{TopicArn: arn}, nil
+	})
+
+	s.Handle("sns", "DeleteTopic", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, err := f.topic(r.Param("TopicArn")); err != nil {
+			return nil, err
+		}
+
+		delete(f.topics, r.Param("TopicArn"))
+
+		return nil, nil
+	})
+
+	s.Handle("sns", "GetTopicAttributes", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		topic, err := f.topic(r.Param("TopicArn"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := &snsGetTopicAttributesOutput{}
+
+		for k, v := range topic.attributes {
+			output.Attributes = append(output.Attributes, snsEntry{Key: k, Value: v})
+		}
+
+		sort.Slice(output.Attributes, func(i, j int) bool {
+			return output.Attributes[i].Key < output.Attributes[j].Key
+		})
+
+		return output, nil
+	})
+
+	s.Handle("sns", "ListTagsForResource", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		topic, err := f.topic(r.Param("ResourceArn"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := &snsListTagsForResourceOutput{}
+
+		for k, v := range topic.tags {
+			output.Tags = append(output.Tags, snsTag{Key: k, Value: v})
+		}
+
+		sort.Slice(output.Tags, func(i, j int) bool {
+			return output.Tags[i].Key < output.Tags[j].Key
+		})
+
+		return output, nil
+	})
+
+	s.Handle("sns", "SetTopicAttributes", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		topic, err := f.topic(r.Param("TopicArn"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := r.Param("AttributeName")
+
+		if snsReadOnlyTopicAttributes[name] {
+			return nil, NewError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: AttributeName")
+		}
+
+		topic.attributes[name] = r.Param("AttributeValue")
+
+		return nil, nil
+	})
+
+	s.Handle("sns", "TagResource", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		topic, err := f.topic(r.Param("ResourceArn"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range r.ParamMap("Tags.member", "Key", "Value") {
+			topic.tags[k] = v
+		}
+
+		return nil, nil
+	})
+
+	s.Handle("sns", "UntagResource", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		topic, err := f.topic(r.Param("ResourceArn"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range r.ParamList("TagKeys.member") {
+			delete(topic.tags, k)
+		}
+
+		return nil, nil
+	})
+}
+
+func (f *SNS) topic(arn string) (*snsTopic, error) {
+	topic, ok := f.topics[arn]
+
+	if !ok {
+		return nil, NewError(http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return topic, nil
+}
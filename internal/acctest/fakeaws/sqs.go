@@ -0,0 +1,258 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// SQS is a stateful fake of the Amazon SQS queue management operations.
+type SQS struct {
+	mu     sync.Mutex
+	queues map[string]*sqsQueue // Keyed by queue URL.
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	name       string
+	tags       map[string]string
+}
+
+// NewSQS returns a fake of Amazon SQS with no queues.
+func NewSQS() *SQS {
+	return &SQS{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+type sqsAttribute struct {
+	Name  string
+	Value string
+}
+
+type sqsTag struct {
+	Key   string
+	Value string
+}
+
+type sqsCreateQueueOutput struct {
+	QueueURL string `xml:"QueueUrl"`
+}
+
+type sqsGetQueueAttributesOutput struct {
+	Attributes []sqsAttribute `xml:"Attribute"`
+}
+
+type sqsGetQueueURLOutput struct {
+	QueueURL string `xml:"QueueUrl"`
+}
+
+type sqsListQueueTagsOutput struct {
+	Tags []sqsTag `xml:"Tag"`
+}
+
+// Register implements Fake.
+func (f *SQS) Register(s *Server) {
+	s.Handle("sqs", "CreateQueue", func(r *Request) (interface{}, error) {
+		name := r.Param("QueueName")
+		url := fmt.Sprintf("%s/%s/%s", s.URL, s.AccountID, name)
+		attributes := r.ParamMap("Attribute", "Name", "Value")
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if queue, ok := f.queues[url]; ok {
+			for k, v := range attributes {
+				if queue.attributes[k] != v {
+					return nil, NewError(http.StatusBadRequest, "QueueAlreadyExists", fmt.Sprintf("A queue already exists with the same name and a different value for attribute %s", k))
+				}
+			}
+
+			return &sqsCreateQueueOutput{QueueURL: url}, nil
+		}
+
+		now := strconv.FormatInt(time.Now().Unix(), 10)
+		queue := &sqsQueue{
+			attributes: map[string]string{
+				"ApproximateNumberOfMessages":           "0",
+				"ApproximateNumberOfMessagesDelayed":    "0",
+				"ApproximateNumberOfMessagesNotVisible": "0",
+				"CreatedTimestamp":                      now,
+				"DelaySeconds":                          "0",
+				"LastModifiedTimestamp":                 now,
+				"MaximumMessageSize":                    "262144",
+				"MessageRetentionPeriod":                "345600",
+				"QueueArn":                              fmt.Sprintf("arn:aws:sqs:%s:%s:%s", s.Region, s.AccountID, name),
+				"ReceiveMessageWaitTimeSeconds":         "0",
+				"VisibilityTimeout":                     "30",
+			},
+			name: name,
+			tags: r.ParamMap("Tag", "Key", "Value"),
+		}
+
+		if attributes["FifoQueue"] == "true" {
+			queue.attributes["ContentBasedDeduplication"] = "false"
+			queue.attributes["DeduplicationScope"] = "queue"
+			queue.attributes["FifoThroughputLimit"] = "perQueue"
+		}
+
+		for k, v := range attributes {
+			queue.attributes[k] = v
+		}
+
+		f.queues[url] = queue
+
+		return &sqsCreateQueueOutput{QueueURL: url}, nil
+	})
+
+	s.Handle("sqs", "DeleteQueue", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, err := f.queue(r); err != nil {
+			return nil, err
+		}
+
+		delete(f.queues, r.Param("QueueUrl"))
+
+		return nil, nil
+	})
+
+	s.Handle("sqs", "GetQueueAttributes", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		queue, err := f.queue(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		names := make(map[string]bool)
+
+		for _, name := range r.ParamList("AttributeName") {
+			names[name] = true
+		}
+
+		output := &sqsGetQueueAttributesOutput{}
+
+		for k, v := range queue.attributes {
+			if names["All"] || names[k] {
+				output.Attributes = append(output.Attributes, sqsAttribute{Name: k, Value: v})
+			}
+		}
+
+		sort.Slice(output.Attributes, func(i, j int) bool {
+			return output.Attributes[i].Name < output.Attributes[j].Name
+		})
+
+		return output, nil
+	})
+
+	s.Handle("sqs", "GetQueueUrl", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		for url, queue := range f.queues {
+			if queue.name == r.Param("QueueName") {
+				return &sqsGetQueueURLOutput{QueueURL: url}, nil
+			}
+		}
+
+		return nil, sqsNonExistentQueueError()
+	})
+
+	s.Handle("sqs", "ListQueueTags", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		queue, err := f.queue(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := &sqsListQueueTagsOutput{}
+
+		for k, v := range queue.tags {
+			output.Tags = append(output.Tags, sqsTag{Key: k, Value: v})
+		}
+
+		sort.Slice(output.Tags, func(i, j int) bool {
+			return output.Tags[i].Key < output.Tags[j].Key
+		})
+
+		return output, nil
+	})
+
+	s.Handle("sqs", "SetQueueAttributes", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		queue, err := f.queue(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range r.ParamMap("Attribute", "Name", "Value") {
+			queue.attributes[k] = v
+		}
+
+		queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+		return nil, nil
+	})
+
+	s.Handle("sqs", "TagQueue", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		queue, err := f.queue(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range r.ParamMap("Tag", "Key", "Value") {
+			queue.tags[k] = v
+		}
+
+		return nil, nil
+	})
+
+	s.Handle("sqs", "UntagQueue", func(r *Request) (interface{}, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		queue, err := f.queue(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range r.ParamList("TagKey") {
+			delete(queue.tags, k)
+		}
+
+		return nil, nil
+	})
+}
+
+// queue returns the queue identified by the request's QueueUrl parameter.
+func (f *SQS) queue(r *Request) (*sqsQueue, error) {
+	queue, ok := f.queues[r.Param("QueueUrl")]
+
+	if !ok {
+		return nil, sqsNonExistentQueueError()
+	}
+
+	return queue, nil
+}
+
+func sqsNonExistentQueueError() *Error {
+	return NewError(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+}
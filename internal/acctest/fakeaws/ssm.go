@@ -0,0 +1,394 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SSM is a stateful fake of the AWS Systems Manager Parameter Store operations.
+type SSM struct {
+	mu         sync.Mutex
+	parameters map[string]*ssmParameter // Keyed by parameter name.
+}
+
+type ssmParameter struct {
+	AllowedPattern   string  `json:",omitempty"`
+	ARN              string  `json:",omitempty"`
+	DataType         string  `json:",omitempty"`
+	Description      string  `json:",omitempty"`
+	KeyID            string  `json:"KeyId,omitempty"`
+	LastModifiedDate float64 `json:",omitempty"`
+	Name             string  `json:",omitempty"`
+	Tier             string  `json:",omitempty"`
+	Type             string  `json:",omitempty"`
+	Value            string  `json:",omitempty"`
+	Version          int64   `json:",omitempty"`
+
+	tags map[string]string
+}
+
+// NewSSM returns a fake of AWS Systems Manager with no parameters.
+func NewSSM() *SSM {
+	return &SSM{
+		parameters: make(map[string]*ssmParameter),
+	}
+}
+
+type ssmTag struct {
+	Key   string
+	Value string
+}
+
+type ssmParameterStringFilter struct {
+	Key    string
+	Option string
+	Values []string
+}
+
+type ssmPutParameterInput struct {
+	AllowedPattern *string
+	DataType       *string
+	Description    *string
+	KeyID          *string `json:"KeyId"`
+	Name           string
+	Overwrite      bool
+	Tags           []ssmTag
+	Tier           *string
+	Type           *string
+	Value          string
+}
+
+type ssmPutParameterOutput struct {
+	Tier    string
+	Version int64
+}
+
+type ssmGetParameterInput struct {
+	Name           string
+	WithDecryption bool
+}
+
+type ssmGetParameterOutput struct {
+	Parameter *ssmParameter
+}
+
+type ssmDeleteParameterInput struct {
+	Name string
+}
+
+type ssmDescribeParametersInput struct {
+	ParameterFilters []ssmParameterStringFilter
+}
+
+type ssmDescribeParametersOutput struct {
+	Parameters []*ssmParameter
+}
+
+type ssmTagsInput struct {
+	ResourceID   string `json:"ResourceId"`
+	ResourceType string
+	TagKeys      []string
+	Tags         []ssmTag
+}
+
+type ssmListTagsForResourceOutput struct {
+	TagList []ssmTag
+}
+
+// Register implements Fake.
+func (f *SSM) Register(s *Server) {
+	s.Handle("ssm", "PutParameter", func(r *Request) (interface{}, error) {
+		var input ssmPutParameterInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		parameter, ok := f.parameters[input.Name]
+
+		if ok && !input.Overwrite {
+			return nil, NewError(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+
+		if input.Overwrite && len(input.Tags) > 0 {
+			return nil, NewError(http.StatusBadRequest, "ValidationException", "Invalid request: tags and overwrite can't be used together.")
+		}
+
+		if !ok {
+			if input.Type == nil {
+				return nil, NewError(http.StatusBadRequest, "ValidationException", "A parameter type is required when you create a parameter.")
+			}
+
+			parameter = &ssmParameter{
+				ARN:      fmt.Sprintf("arn:aws:ssm:%s:%s:parameter/%s", s.Region, s.AccountID, strings.TrimPrefix(input.Name, "/")),
+				DataType: "text",
+				Name:     input.Name,
+				Tier:     "Standard",
+				tags:     make(map[string]string),
+			}
+
+			for _, tag := range input.Tags {
+				parameter.tags[tag.Key] = tag.Value
+			}
+
+			f.parameters[input.Name] = parameter
+		}
+
+		if input.AllowedPattern != nil {
+			parameter.AllowedPattern = *input.AllowedPattern
+		}
+
+		if input.DataType != nil {
+			parameter.DataType = *input.DataType
+		}
+
+		if input.Description != nil {
+			parameter.Description = *input.Description
+		}
+
+		if input.Tier != nil && *input.Tier != "" {
+			parameter.Tier = *input.Tier
+		}
+
+		if input.Type != nil {
+			parameter.Type = *input.Type
+		}
+
+		if parameter.Type == "SecureString" {
+			parameter.KeyID = "alias/aws/ssm"
+
+			if input.KeyID != nil {
+				parameter.KeyID = *input.KeyID
+			}
+		} else {
+			parameter.KeyID = ""
+		}
+
+		parameter.LastModifiedDate = float64(time.Now().Unix())
+		parameter.Value = input.Value
+		parameter.Version++
+
+		return &ssmPutParameterOutput{
+			Tier:    parameter.Tier,
+			Version: parameter.Version,
+		}, nil
+	})
+
+	s.Handle("ssm", "GetParameter", func(r *Request) (interface{}, error) {
+		var input ssmGetParameterInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		parameter, err := f.parameter(input.Name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := &ssmParameter{
+			ARN:              parameter.ARN,
+			DataType:         parameter.DataType,
+			LastModifiedDate: parameter.LastModifiedDate,
+			Name:             parameter.Name,
+			Type:             parameter.Type,
+			Value:            parameter.Value,
+			Version:          parameter.Version,
+		}
+
+		if parameter.Type == "SecureString" && !input.WithDecryption {
+			output.Value = "encrypted"
+		}
+
+		return &ssmGetParameterOutput{Parameter: output}, nil
+	})
+
+	s.Handle("ssm", "DeleteParameter", func(r *Request) (interface{}, error) {
+		var input ssmDeleteParameterInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, err := f.parameter(input.Name); err != nil {
+			return nil, err
+		}
+
+		delete(f.parameters, input.Name)
+
+		return nil, nil
+	})
+
+	s.Handle("ssm", "DescribeParameters", func(r *Request) (interface{}, error) {
+		var input ssmDescribeParametersInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		output := &ssmDescribeParametersOutput{}
+
+		for _, parameter := range f.parameters {
+			if !ssmParameterMatchesFilters(parameter, input.ParameterFilters) {
+				continue
+			}
+
+			v := *parameter
+			v.ARN = ""
+			v.Value = ""
+			output.Parameters = append(output.Parameters, &v)
+		}
+
+		sort.Slice(output.Parameters, func(i, j int) bool {
+			return output.Parameters[i].Name < output.Parameters[j].Name
+		})
+
+		return output, nil
+	})
+
+	s.Handle("ssm", "AddTagsToResource", func(r *Request) (interface{}, error) {
+		var input ssmTagsInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		parameter, err := f.taggedParameter(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range input.Tags {
+			parameter.tags[tag.Key] = tag.Value
+		}
+
+		return nil, nil
+	})
+
+	s.Handle("ssm", "ListTagsForResource", func(r *Request) (interface{}, error) {
+		var input ssmTagsInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		parameter, err := f.taggedParameter(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := &ssmListTagsForResourceOutput{
+			TagList: []ssmTag{},
+		}
+
+		for k, v := range parameter.tags {
+			output.TagList = append(output.TagList, ssmTag{Key: k, Value: v})
+		}
+
+		sort.Slice(output.TagList, func(i, j int) bool {
+			return output.TagList[i].Key < output.TagList[j].Key
+		})
+
+		return output, nil
+	})
+
+	s.Handle("ssm", "RemoveTagsFromResource", func(r *Request) (interface{}, error) {
+		var input ssmTagsInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return nil, err
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		parameter, err := f.taggedParameter(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range input.TagKeys {
+			delete(parameter.tags, k)
+		}
+
+		return nil, nil
+	})
+}
+
+func (f *SSM) parameter(name string) (*ssmParameter, error) {
+	parameter, ok := f.parameters[name]
+
+	if !ok {
+		return nil, NewError(http.StatusBadRequest, "ParameterNotFound", "")
+	}
+
+	return parameter, nil
+}
+
+// taggedParameter returns the parameter identified by the input of a tagging operation.
+func (f *SSM) taggedParameter(input ssmTagsInput) (*ssmParameter, error) {
+	if input.ResourceType != "Parameter" {
+		return nil, NewError(http.StatusBadRequest, "InvalidResourceType", fmt.Sprintf("fakeaws: unsupported resource type: %s", input.ResourceType))
+	}
+
+	parameter, ok := f.parameters[input.ResourceID]
+
+	if !ok {
+		return nil, NewError(http.StatusBadRequest, "InvalidResourceId", "")
+	}
+
+	return parameter, nil
+}
+
+// ssmParameterMatchesFilters returns whether the parameter matches all the "Name" filters.
+// Other filter keys are not supported and match all parameters.
+func ssmParameterMatchesFilters(parameter *ssmParameter, filters []ssmParameterStringFilter) bool {
+	for _, filter := range filters {
+		if filter.Key != "Name" {
+			continue
+		}
+
+		matched := false
+
+		for _, value := range filter.Values {
+			switch filter.Option {
+			case "BeginsWith":
+				matched = matched || strings.HasPrefix(parameter.Name, value)
+			default:
+				matched = matched || parameter.Name == value
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
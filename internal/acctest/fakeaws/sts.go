@@ -0,0 +1,28 @@
+package fakeaws
+
+import (
+	"fmt"
+)
+
+// stsFake handles the STS operations used to validate the provider's credentials.
+type stsFake struct{}
+
+func newSTS() *stsFake {
+	return &stsFake{}
+}
+
+type getCallerIdentityOutput struct {
+	Account string
+	Arn     string
+	UserID  string `xml:"UserId"`
+}
+
+func (f *stsFake) Register(s *Server) {
+	s.Handle("sts", "GetCallerIdentity", func(r *Request) (interface{}, error) {
+		return &getCallerIdentityOutput{
+			Account: s.AccountID,
+			Arn:     fmt.Sprintf("arn:aws:iam::%s:user/fakeaws", s.AccountID),
+			UserID:  "AIDAFAKEAWS",
+		}, nil
+	})
+}
@@ -0,0 +1,237 @@
+package vcr
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Cassette is the recording of the AWS API requests of an acceptance test.
+type Cassette struct {
+	// Seed is the seed of the test's random names.
+	Seed int64 `json:"seed"`
+
+	// Interactions are the requests and their responses, in the order in which they were sent.
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	replayed bool
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a recorded request or response body.
+// It is encoded as a JSON string if valid UTF-8, otherwise as a base64 encoded JSON object.
+type Body []byte
+
+type base64Body struct {
+	Base64 string `json:"base64"`
+}
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+
+	return json.Marshal(base64Body{Base64: base64.StdEncoding.EncodeToString(b)})
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+
+	var v base64Body
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(v.Base64)
+
+	if err != nil {
+		return err
+	}
+
+	*b = decoded
+
+	return nil
+}
+
+// recordedRequestHeaders are the request headers that are recorded.
+// Other headers, notably Authorization, are not.
+var recordedRequestHeaders = []string{
+	"Content-Type",
+	"X-Amz-Target",
+}
+
+// operation returns the operation of a JSON or query protocol request, if any.
+func (r Request) operation() string {
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		return v
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(r.Body)); err == nil {
+			return values.Get("Action")
+		}
+	}
+
+	return ""
+}
+
+// match returns the first interaction not yet replayed whose request has the same method, URL and operation as `r`,
+// preferring one with the same body. Bodies are not required to match as they can contain idempotency tokens.
+func (c *Cassette) match(r Request) *Interaction {
+	var match *Interaction
+
+	for _, v := range c.Interactions {
+		if v.replayed || v.Request.Method != r.Method || v.Request.URL != r.URL || v.Request.operation() != r.operation() {
+			continue
+		}
+
+		if string(v.Request.Body) == string(r.Body) {
+			return v
+		}
+
+		if match == nil {
+			match = v
+		}
+	}
+
+	return match
+}
+
+const (
+	// scrubbedAccessKeyID replaces recorded access key IDs.
+	scrubbedAccessKeyID = "AKIA00000000SCRUBBED"
+
+	// scrubbedAccountIDFormat is the format of the account IDs replacing recorded account IDs,
+	// starting from 123456789012.
+	scrubbedAccountIDFormat = "1234567890%02d"
+
+	// scrubbedSecret replaces recorded secret access keys and session tokens.
+	scrubbedSecret = "SCRUBBED"
+)
+
+var (
+	accessKeyIDRegexp    = regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`)
+	callerIdentityRegexp = regexp.MustCompile(`<GetCallerIdentityResult>[\s\S]*?<Account>(\d{12})</Account>`)
+	jsonSecretRegexp     = regexp.MustCompile(`("(?i:SecretAccessKey|SessionToken)"\s*:\s*")[^"]*`)
+	xmlSecretRegexp      = regexp.MustCompile(`(<(?:SecretAccessKey|SessionToken)>)[^<]*`)
+)
+
+// scrub replaces credentials and the IDs of the AWS accounts whose caller identity was requested
+// with placeholders. Each account ID is replaced consistently, so that replayed requests match.
+func (c *Cassette) scrub() {
+	var oldnew []string
+
+	for _, v := range c.Interactions {
+		for _, m := range callerIdentityRegexp.FindAllStringSubmatch(string(v.Response.Body), -1) {
+			accountID := m[1]
+			seen := false
+
+			for i := 0; i < len(oldnew); i += 2 {
+				if oldnew[i] == accountID {
+					seen = true
+					break
+				}
+			}
+
+			if !seen {
+				oldnew = append(oldnew, accountID, fmt.Sprintf(scrubbedAccountIDFormat, 12+len(oldnew)/2))
+			}
+		}
+	}
+
+	replacer := strings.NewReplacer(oldnew...)
+	scrub := func(s string) string {
+		s = replacer.Replace(s)
+		s = accessKeyIDRegexp.ReplaceAllString(s, scrubbedAccessKeyID)
+		s = jsonSecretRegexp.ReplaceAllString(s, "${1}"+scrubbedSecret)
+		s = xmlSecretRegexp.ReplaceAllString(s, "${1}"+scrubbedSecret)
+
+		return s
+	}
+	scrubHeader := func(header http.Header) {
+		for k, values := range header {
+			for i, v := range values {
+				header[k][i] = scrub(v)
+			}
+		}
+	}
+
+	for _, v := range c.Interactions {
+		v.Request.URL = scrub(v.Request.URL)
+		scrubHeader(v.Request.Header)
+		v.Request.Body = Body(scrub(string(v.Request.Body)))
+		scrubHeader(v.Response.Header)
+		v.Response.Body = Body(scrub(string(v.Response.Body)))
+	}
+}
+
+// loadCassette reads the cassette at the specified path.
+func loadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cassette{}
+
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("error reading cassette (%s): %w", path, err)
+	}
+
+	return c, nil
+}
+
+// save scrubs and writes the cassette to the specified path.
+func (c *Cassette) save(path string) error {
+	c.scrub()
+
+	b, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", path, err)
+	}
+
+	if err := os.WriteFile(path, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+// Package vcr records the AWS API requests of acceptance tests to cassette files and replays them,
+// so that acceptance tests can be run without AWS credentials or network access.
+//
+// Record/replay mode is enabled by the TF_ACC_VCR_MODE environment variable, RECORD or REPLAY.
+// Each test's cassette is named after the test and stored in the directory specified by the
+// TF_ACC_VCR_PATH environment variable, by default testdata/cassettes in the test's package.
+//
+// A test's cassette is activated by acctest.PreCheck, which starts a session keyed by the test name.
+// Each provider instance created by a test's provider factories records to, or replays from, that
+// test's session, and requests made by the test itself, such as those of check functions, use the
+// session of the calling test, so concurrent tests do not share cassettes.
+//
+// The testing package does not expose the running test to code called without a *testing.T, so the
+// test is found from the ID of the calling goroutine, which Activate binds to the test. Only code
+// running on the test's own goroutine is attributed to it: the test function, its PreCheck and check
+// functions, and the provider factories called by resource.Test. Provider factories bind the providers
+// they create to the test explicitly, with TransportWrapper, as Terraform calls providers on other
+// goroutines. The provider instance shared by acceptance tests, acctest.Provider, is not bound to a
+// test, so the requests Terraform makes through it cannot be recorded or replayed.
+package vcr
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Mode is the record/replay mode.
+type Mode string
+
+const (
+	ModeRecord Mode = "RECORD"
+	ModeReplay Mode = "REPLAY"
+)
+
+// DefaultPath is the default directory of cassettes, relative to the test's package.
+const DefaultPath = "testdata/cassettes"
+
+// CurrentMode returns the record/replay mode set by the TF_ACC_VCR_MODE environment variable,
+// or an empty string if record/replay mode is not enabled.
+func CurrentMode() Mode {
+	return Mode(strings.ToUpper(os.Getenv(conns.EnvVarAccVCRMode)))
+}
+
+// Enabled returns whether record/replay mode is enabled.
+func Enabled() bool {
+	return CurrentMode() != ""
+}
+
+// session is the recording or replaying of a test's cassette.
+type session struct {
+	cassette *Cassette
+	mode     Mode
+	mu       sync.Mutex
+	path     string
+	rand     *rand.Rand
+}
+
+var (
+	// sessions are the sessions of running tests, keyed by test name.
+	sessions = make(map[string]*session)
+
+	// tests are the names of tests with an active session, keyed by the ID of the goroutine running the test.
+	tests = make(map[uint64]string)
+
+	sessionsMu sync.RWMutex
+)
+
+// testSession returns the test's session, starting it if necessary.
+// In replay mode, the test is skipped if it has no cassette.
+func testSession(t *testing.T) *session {
+	t.Helper()
+
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	name := t.Name()
+
+	if s, ok := sessions[name]; ok {
+		return s
+	}
+
+	mode := CurrentMode()
+
+	if mode != ModeRecord && mode != ModeReplay {
+		t.Fatalf("invalid %s value (%s): must be %s or %s", conns.EnvVarAccVCRMode, mode, ModeRecord, ModeReplay)
+	}
+
+	dir := conns.GetEnvVarWithDefault(conns.EnvVarAccVCRPath, DefaultPath)
+	s, err := newSession(mode, cassettePath(dir, name))
+
+	if os.IsNotExist(err) {
+		t.Skipf("skipping replay of acceptance test: no cassette (%s)", s.path)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessions[name] = s
+
+	t.Cleanup(func() {
+		sessionsMu.Lock()
+		delete(sessions, name)
+		sessionsMu.Unlock()
+	})
+
+	return s
+}
+
+// namedSession returns the session of the named test, or nil if the test has no active session.
+func namedSession(name string) *session {
+	sessionsMu.RLock()
+	defer sessionsMu.RUnlock()
+
+	return sessions[name]
+}
+
+// CurrentTestName returns the name of the test with an active session running on the calling goroutine,
+// or an empty string if there is none.
+func CurrentTestName() string {
+	sessionsMu.RLock()
+	defer sessionsMu.RUnlock()
+
+	return tests[goroutineID()]
+}
+
+// goroutineID returns the ID of the calling goroutine, parsed from the "goroutine <ID> [<status>]:"
+// header of its stack trace, or 0 if the header cannot be parsed.
+// The runtime does not otherwise expose goroutine IDs and does not guarantee the format of the header,
+// so the ID is only used to find the test running on the calling goroutine, never to identify a session.
+// The testing package runs each test, and each subtest, on its own goroutine, but code that a test
+// runs on new goroutines has different IDs and is not attributed to the test.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+
+	return id
+}
+
+func newSession(mode Mode, path string) (*session, error) {
+	s := &session{
+		mode: mode,
+		path: path,
+	}
+
+	switch mode {
+	case ModeRecord:
+		s.cassette = &Cassette{Seed: time.Now().UnixNano()}
+	case ModeReplay:
+		cassette, err := loadCassette(path)
+
+		if err != nil {
+			return s, err
+		}
+
+		s.cassette = cassette
+	}
+
+	s.rand = rand.New(rand.NewSource(s.cassette.Seed))
+
+	return s, nil
+}
+
+// cassettePath returns the path of the cassette of the named test.
+func cassettePath(dir, testName string) string {
+	return filepath.Join(dir, strings.ReplaceAll(testName, "/", "_")+".json")
+}
+
+// Activate starts recording the test's requests to, or replaying them from, its cassette.
+// In record mode, the cassette is saved when the test completes, unless the test failed or was skipped.
+func Activate(t *testing.T) {
+	t.Helper()
+
+	s := testSession(t)
+	id := goroutineID()
+
+	if id == 0 {
+		t.Fatal("vcr: unable to determine the goroutine running the test")
+	}
+
+	sessionsMu.Lock()
+	_, ok := tests[id]
+	tests[id] = t.Name()
+	sessionsMu.Unlock()
+
+	if ok {
+		return
+	}
+
+	log.Printf("[INFO] vcr: %s mode, cassette: %s", s.mode, s.path)
+
+	t.Cleanup(func() {
+		sessionsMu.Lock()
+		delete(tests, id)
+		sessionsMu.Unlock()
+
+		if s.mode == ModeRecord && !t.Failed() && !t.Skipped() {
+			if err := s.cassette.save(s.path); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+}
+
+// RandInt returns a random integer generated from the seed recorded in the test's cassette.
+func RandInt(t *testing.T) int {
+	t.Helper()
+
+	s := testSession(t)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rand.Int()
+}
+
+// WrapTransport wraps an HTTP transport so that requests are recorded to, or replayed from,
+// the cassette of the test making the request. It is used as a conns.HTTPTransportWrapper.
+func WrapTransport(next http.RoundTripper) http.RoundTripper {
+	return &transport{next: next}
+}
+
+// TransportWrapper returns a conns.HTTPTransportWrapper that wraps HTTP transports so that requests are
+// recorded to, or replayed from, the cassette of the named test, wherever the request is made.
+// If name is empty, the cassette of the test making the request is used, as WrapTransport.
+func TransportWrapper(name string) conns.HTTPTransportWrapper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &transport{next: next, testName: name}
+	}
+}
+
+type transport struct {
+	next     http.RoundTripper
+	testName string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(httpReq *http.Request) (*http.Response, error) {
+	name := t.testName
+
+	if name == "" {
+		name = CurrentTestName()
+	}
+
+	s := namedSession(name)
+
+	if s == nil {
+		return nil, fmt.Errorf("vcr: no active cassette for request (%s %s): acceptance tests must call acctest.PreCheck and use ProviderFactories", httpReq.Method, httpReq.URL)
+	}
+
+	var body []byte
+
+	if httpReq.Body != nil {
+		var err error
+
+		body, err = ioutil.ReadAll(httpReq.Body)
+		httpReq.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		httpReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	req := Request{
+		Method: httpReq.Method,
+		URL:    httpReq.URL.String(),
+		Header: make(http.Header),
+		Body:   body,
+	}
+
+	for _, k := range recordedRequestHeaders {
+		if v := httpReq.Header.Get(k); v != "" {
+			req.Header.Set(k, v)
+		}
+	}
+
+	if s.mode == ModeReplay {
+		return s.replay(httpReq, req)
+	}
+
+	return s.record(httpReq, req, t.next)
+}
+
+func (s *session) record(httpReq *http.Request, req Request, next http.RoundTripper) (*http.Response, error) {
+	httpResp, err := next.RoundTrip(httpReq)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(httpResp.Body)
+	httpResp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cassette.Interactions = append(s.cassette.Interactions, &Interaction{
+		Request: req,
+		Response: Response{
+			StatusCode: httpResp.StatusCode,
+			Header:     httpResp.Header.Clone(),
+			Body:       body,
+		},
+	})
+
+	return httpResp, nil
+}
+
+func (s *session) replay(httpReq *http.Request, req Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	interaction := s.cassette.match(req)
+
+	if interaction == nil {
+		return nil, fmt.Errorf("vcr: no recorded response for request (%s %s) in cassette (%s)", req.Method, req.URL, s.path)
+	}
+
+	interaction.replayed = true
+
+	return &http.Response{
+		Body:          ioutil.NopCloser(bytes.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Header:        interaction.Response.Header.Clone(),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       httpReq,
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+	}, nil
+}
@@ -0,0 +1,563 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// s3ConnURICleaningDisabled is the registry key for the S3 client with REST protocol URI cleaning disabled.
+const s3ConnURICleaningDisabled = "s3-uricleaningdisabled"
+
+// s3ConnForRegionPrefix is the prefix of the registry keys for S3 clients in regions other than the provider's.
+const s3ConnForRegionPrefix = "s3-region-"
+
+// mediaConvertAccountConn is the registry key for the MediaConvert client using the account-specific API endpoint.
+const mediaConvertAccountConn = "mediaconvert-account"
+
+// conn returns the client for the specified service, creating it on first use.
+func (client *AWSClient) conn(key string) interface{} {
+	return client.lazyConn(key, func() interface{} {
+		sess := client.session.Copy(client.serviceConfig(key))
+
+		if handler, ok := serviceRetryHandlers[key]; ok {
+			sess.Handlers.Retry.PushBack(handler)
+		}
+
+		client.requestThrottler.install(&sess.Handlers, key)
+
+		return serviceData[key].NewConn(sess)
+	})
+}
+
+// lazyConnEntry is the registry entry of a lazily created client.
+type lazyConnEntry struct {
+	once sync.Once
+	conn interface{}
+	err  error
+}
+
+// lazyConn returns the client registered under the specified key.
+// The client is created by calling newConn the first time the key is requested.
+// It is safe to call lazyConn concurrently.
+func (client *AWSClient) lazyConn(key string, newConn func() interface{}) interface{} {
+	conn, _ := client.lazyConnE(key, func() (interface{}, error) {
+		return newConn(), nil
+	})
+
+	return conn
+}
+
+// lazyConnE returns the client registered under the specified key.
+// The client is created by calling newConn the first time the key is requested.
+// Clients are created outside the registry lock, so creating one client does not block callers of others.
+// If newConn returns an error, the error is returned and the next request for the key calls newConn again.
+// It is safe to call lazyConnE concurrently.
+func (client *AWSClient) lazyConnE(key string, newConn func() (interface{}, error)) (interface{}, error) {
+	if client.base != nil {
+		conn, err := client.base.lazyConnE(key, newConn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if client.resourceContext != nil {
+			return withResourceContext(conn, *client.resourceContext), nil
+		}
+
+		return conn, nil
+	}
+
+	client.connsLock.Lock()
+
+	entry, ok := client.conns[key]
+
+	if !ok {
+		if client.conns == nil {
+			client.conns = make(map[string]*lazyConnEntry)
+		}
+
+		entry = &lazyConnEntry{}
+		client.conns[key] = entry
+	}
+
+	client.connsLock.Unlock()
+
+	entry.once.Do(func() {
+		entry.conn, entry.err = newConn()
+	})
+
+	if entry.err != nil {
+		client.connsLock.Lock()
+		if client.conns[key] == entry {
+			delete(client.conns, key)
+		}
+		client.connsLock.Unlock()
+
+		return nil, entry.err
+	}
+
+	return entry.conn, nil
+}
+
+// ForResourceType returns the client to use for resources of the specified Terraform resource type.
+// If the default or required tags configuration depends on the resource type, the returned client is a copy
+// with the configuration resolved for the resource type that shares service clients with the original.
+func (client *AWSClient) ForResourceType(resourceType string) *AWSClient {
+	if client.base != nil {
+		return client.base.ForResourceType(resourceType)
+	}
+
+	defaultTagsConfig := client.DefaultTagsConfig.ForResourceType(resourceType)
+
+	if defaultTagsConfig == client.DefaultTagsConfig && client.RequiredTagsConfig == nil {
+		return client
+	}
+
+	client.connsLock.Lock()
+	defer client.connsLock.Unlock()
+
+	if v, ok := client.resourceTypeClients[resourceType]; ok {
+		return v
+	}
+
+	if client.resourceTypeClients == nil {
+		client.resourceTypeClients = make(map[string]*AWSClient)
+	}
+
+	v := client.copy()
+	v.DefaultTagsConfig = defaultTagsConfig
+	v.RequiredTagsConfig = client.RequiredTagsConfig.ForResourceType(resourceType)
+	client.resourceTypeClients[resourceType] = v
+
+	return v
+}
+
+// ForResource returns a copy of the client whose service clients attribute API calls to the specified
+// Terraform resource in request logs, unless they are made with a context from NewResourceContext.
+// This attributes the API calls of CRUD functions that do not pass a context to the AWS Go SDK.
+func (client *AWSClient) ForResource(resourceType, id string) *AWSClient {
+	v := client.copy()
+	v.resourceContext = &resourceContext{resourceType: resourceType, id: id}
+
+	return v
+}
+
+// copy returns a copy of the client that shares service clients with the original.
+func (client *AWSClient) copy() *AWSClient {
+	base := client
+
+	if client.base != nil {
+		base = client.base
+	}
+
+	return &AWSClient{
+		AccountID:          client.AccountID,
+		DefaultTagsConfig:  client.DefaultTagsConfig,
+		DNSSuffix:          client.DNSSuffix,
+		IgnoreTagsConfig:   client.IgnoreTagsConfig,
+		Partition:          client.Partition,
+		Region:             client.Region,
+		RequiredTagsConfig: client.RequiredTagsConfig,
+		ReverseDNSPrefix:   client.ReverseDNSPrefix,
+		SupportedPlatforms: client.SupportedPlatforms,
+		TerraformVersion:   client.TerraformVersion,
+
+		allowedRegions:             client.allowedRegions,
+		base:                       base,
+		endpoints:                  client.endpoints,
+		eventualConsistencyTimeout: client.eventualConsistencyTimeout,
+		forbiddenRegions:           client.forbiddenRegions,
+		requestThrottler:           client.requestThrottler,
+		resourceContext:            client.resourceContext,
+		s3ForcePathStyle:           client.s3ForcePathStyle,
+		session:                    client.session,
+		useDualStackEndpoint:       client.useDualStackEndpoint,
+		useFIPSEndpoint:            client.useFIPSEndpoint,
+	}
+}
+
+// serviceConfig returns the configuration applied to a copy of the base session
+// when creating the client for the specified service.
+func (client *AWSClient) serviceConfig(key string) *aws.Config {
+	return client.serviceConfigForRegion(key, client.Region)
+}
+
+// serviceConfigForRegion returns the configuration applied to a copy of the base session
+// when creating the client for the specified service in the specified region.
+func (client *AWSClient) serviceConfigForRegion(key, region string) *aws.Config {
+	config := &aws.Config{
+		Endpoint: aws.String(client.endpoints[key]),
+	}
+
+	if region != client.Region {
+		config.Region = aws.String(region)
+	}
+
+	if key == S3 {
+		config.S3ForcePathStyle = aws.Bool(client.s3ForcePathStyle)
+	}
+
+	// Force "global" services to correct regions
+	switch client.Partition {
+	case endpoints.AwsPartitionID:
+		switch key {
+		case GlobalAccelerator, Route53RecoveryControlConfig, Route53RecoveryReadiness:
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		case Route53, Shield:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		}
+	case endpoints.AwsCnPartitionID:
+		if key == Route53 {
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		}
+	case endpoints.AwsUsGovPartitionID:
+		if key == Route53 {
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		}
+	}
+
+	// Explicitly configured endpoints take precedence over FIPS and dual-stack endpoints.
+	if aws.StringValue(config.Endpoint) == "" && (client.useFIPSEndpoint || client.useDualStackEndpoint) {
+		if v := aws.StringValue(config.Region); v != "" {
+			region = v
+		}
+
+		endpoint, err := resolveEndpointVariant(serviceData[key].AWSEndpointsID, region, client.useFIPSEndpoint, client.useDualStackEndpoint)
+
+		if err != nil {
+			log.Printf("[WARN] Unable to resolve FIPS or dual-stack endpoint for %s, using default endpoint: %s", serviceData[key].AWSClientName, err)
+		} else {
+			log.Printf("[DEBUG] Using %s endpoint: %s", serviceData[key].AWSClientName, endpoint.URL)
+			config.Endpoint = aws.String(endpoint.URL)
+
+			// Requests to global service endpoints are signed for the region in the endpoint's credential scope.
+			if endpoint.SigningRegion != region {
+				config.Region = aws.String(endpoint.SigningRegion)
+			}
+		}
+	}
+
+	return config
+}
+
+// serviceRetryHandlers are added to the Retry handlers of the corresponding service client when it is created.
+var serviceRetryHandlers = map[string]func(*request.Request){
+	APIGateway: func(r *request.Request) {
+		// Many operations can return an error such as:
+		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+		// Handle them all globally for the service client.
+		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	AppAutoScaling: func(r *request.Request) {
+		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+			return
+		}
+		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// StartDeployment operations can return a ConflictException
+	// if ongoing deployments are in-progress, thus we handle them
+	// here for the service client.
+	AppConfig: func(r *request.Request) {
+		if r.Operation.Name == "StartDeployment" {
+			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	AppSync: func(r *request.Request) {
+		if r.Operation.Name == "CreateGraphqlApi" {
+			if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	Chime: func(r *request.Request) {
+		// When calling CreateVoiceConnector across multiple resources,
+		// the API can randomly return a BadRequestException without explanation
+		if r.Operation.Name == "CreateVoiceConnector" {
+			if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	CloudHSMV2: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	ConfigService: func(r *request.Request) {
+		// When calling Config Organization Rules API actions immediately
+		// after Organization creation, the API can randomly return the
+		// OrganizationAccessDeniedException error for a few minutes, even
+		// after succeeding a few requests.
+		switch r.Operation.Name {
+		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+			if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+				return
+			}
+
+			// We only want to retry briefly as the default max retry count would
+			// excessively retry when the error could be legitimate.
+			// We currently depend on the DefaultRetryer exponential backoff here.
+			// ~10 retries gives a fair backoff of a few seconds.
+			if r.RetryCount < 9 {
+				r.Retryable = aws.Bool(true)
+			} else {
+				r.Retryable = aws.Bool(false)
+			}
+		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+					r.Retryable = aws.Bool(true)
+				}
+				return
+			}
+
+			// We only want to retry briefly as the default max retry count would
+			// excessively retry when the error could be legitimate.
+			// We currently depend on the DefaultRetryer exponential backoff here.
+			// ~10 retries gives a fair backoff of a few seconds.
+			if r.RetryCount < 9 {
+				r.Retryable = aws.Bool(true)
+			} else {
+				r.Retryable = aws.Bool(false)
+			}
+		}
+	},
+
+	CloudFormation: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	DynamoDB: func(r *request.Request) {
+		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+			return
+		}
+		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	EC2: func(r *request.Request) {
+		if r.Operation.Name == "CreateClientVpnEndpoint" {
+			if tfawserr.ErrMessageContains(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "CreateVpnConnection" {
+			if tfawserr.ErrMessageContains(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "CreateVpnGateway" {
+			if tfawserr.ErrMessageContains(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+			if tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	FMS: func(r *request.Request) {
+		// Acceptance testing creates and deletes resources in quick succession.
+		// The FMS onboarding process into Organizations is opaque to consumers.
+		// Since we cannot reasonably check this status before receiving the error,
+		// set the operation as retryable.
+		switch r.Operation.Name {
+		case "AssociateAdminAccount":
+			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+				r.Retryable = aws.Bool(true)
+			}
+		case "DisassociateAdminAccount":
+			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	Kafka: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	Kinesis: func(r *request.Request) {
+		if r.Operation.Name == "CreateStream" {
+			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	Organizations: func(r *request.Request) {
+		// Retry on the following error:
+		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+		if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+	SecurityHub: func(r *request.Request) {
+		switch r.Operation.Name {
+		case "EnableOrganizationAdminAccount":
+			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+	SSOAdmin: func(r *request.Request) {
+		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	StorageGateway: func(r *request.Request) {
+		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+		if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	WAFV2: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+			r.Retryable = aws.Bool(true)
+		}
+
+		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+			r.Retryable = aws.Bool(true)
+		}
+
+		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+}
+
+func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
+	return client.lazyConn(s3ConnURICleaningDisabled, func() interface{} {
+		config := client.serviceConfig(S3)
+		config.DisableRestProtocolURICleaning = aws.Bool(true)
+
+		sess := client.session.Copy(config)
+		client.requestThrottler.install(&sess.Handlers, S3)
+
+		return s3.New(sess)
+	}).(*s3.S3)
+}
+
+// S3ConnForRegion returns the S3 client for the specified region.
+// Requests for a bucket must be sent to the bucket's region, so bucket configuration is read with this client.
+func (client *AWSClient) S3ConnForRegion(region string) *s3.S3 {
+	if region == "" || region == client.Region {
+		return client.S3Conn()
+	}
+
+	return client.lazyConn(s3ConnForRegionPrefix+region, func() interface{} {
+		sess := client.session.Copy(client.serviceConfigForRegion(S3, region))
+		client.requestThrottler.install(&sess.Handlers, S3)
+
+		return s3.New(sess)
+	}).(*s3.S3)
+}
+
+// MediaConvertAccountConn returns the MediaConvert client for the account-specific API endpoint.
+// The endpoint is discovered with DescribeEndpoints on first use.
+func (client *AWSClient) MediaConvertAccountConn() (*mediaconvert.MediaConvert, error) {
+	conn, err := client.lazyConnE(mediaConvertAccountConn, func() (interface{}, error) {
+		input := &mediaconvert.DescribeEndpointsInput{
+			Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
+		}
+
+		output, err := client.MediaConvertConn().DescribeEndpoints(input)
+
+		if err != nil {
+			return nil, fmt.Errorf("error describing MediaConvert Endpoints: %w", err)
+		}
+
+		if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil || output.Endpoints[0].Url == nil {
+			return nil, fmt.Errorf("error describing MediaConvert Endpoints: empty response or URL")
+		}
+
+		config := client.serviceConfig(MediaConvert)
+		config.Endpoint = output.Endpoints[0].Url
+
+		sess := client.session.Copy(config)
+		client.requestThrottler.install(&sess.Handlers, MediaConvert)
+
+		return mediaconvert.New(sess), nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.(*mediaconvert.MediaConvert), nil
+}
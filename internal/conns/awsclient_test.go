@@ -0,0 +1,423 @@
+package conns
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func testSession(tb testing.TB, region string) *session.Session {
+	tb.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(region),
+	})
+
+	if err != nil {
+		tb.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func testAWSClient(tb testing.TB, partition string, region string, endpoints map[string]string) *AWSClient {
+	tb.Helper()
+
+	return &AWSClient{
+		Partition: partition,
+		Region:    region,
+		endpoints: endpoints,
+		session:   testSession(tb, region),
+	}
+}
+
+func TestAWSClientConn(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		SQS: "http://sqs.test",
+	})
+
+	if got, expected := len(client.conns), 0; got != expected {
+		t.Fatalf("got %d clients before first use, expected %d", got, expected)
+	}
+
+	conn := client.SQSConn()
+
+	if got, expected := aws.StringValue(conn.Config.Endpoint), "http://sqs.test"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(conn.Config.Region), endpoints.UsWest2RegionID; got != expected {
+		t.Errorf("got region %s, expected %s", got, expected)
+	}
+
+	if client.SQSConn() != conn {
+		t.Error("expected the same client to be returned on subsequent calls")
+	}
+
+	if got, expected := len(client.conns), 1; got != expected {
+		t.Errorf("got %d clients after first use, expected %d", got, expected)
+	}
+}
+
+func TestAWSClientForResourceType(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		SQS: "http://sqs.test",
+	})
+
+	if client.ForResourceType("aws_instance") != client {
+		t.Error("expected the client itself without default tags")
+	}
+
+	client.DefaultTagsConfig = &tftags.DefaultConfig{
+		Tags: tftags.New(map[string]string{
+			"Type": "${resource_type}",
+		}),
+	}
+
+	instanceClient := client.ForResourceType("aws_instance")
+
+	if instanceClient == client {
+		t.Fatal("expected a copy of the client")
+	}
+
+	if got, expected := aws.StringValue(instanceClient.DefaultTagsConfig.Tags.KeyValue("Type")), "aws_instance"; got != expected {
+		t.Errorf("got Type tag %s, expected %s", got, expected)
+	}
+
+	if instanceClient.ForResourceType("aws_instance") != instanceClient {
+		t.Error("expected the same copy to be returned on subsequent calls")
+	}
+
+	if got, expected := aws.StringValue(client.ForResourceType("aws_vpc").DefaultTagsConfig.Tags.KeyValue("Type")), "aws_vpc"; got != expected {
+		t.Errorf("got Type tag %s, expected %s", got, expected)
+	}
+
+	if instanceClient.SQSConn() != client.SQSConn() {
+		t.Error("expected the copy to share service clients")
+	}
+}
+
+func TestAWSClientConnGlobalServiceRegion(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Partition        string
+		Region           string
+		Conn             func(*AWSClient) *aws.Config
+		ExpectedEndpoint string
+		ExpectedRegion   string
+	}{
+		{
+			Name:      "AWS Commercial Route 53",
+			Partition: endpoints.AwsPartitionID,
+			Region:    endpoints.UsWest2RegionID,
+			Conn: func(client *AWSClient) *aws.Config {
+				return &client.Route53Conn().Config
+			},
+			ExpectedRegion: endpoints.UsEast1RegionID,
+		},
+		{
+			Name:      "AWS Commercial Global Accelerator",
+			Partition: endpoints.AwsPartitionID,
+			Region:    endpoints.UsEast1RegionID,
+			Conn: func(client *AWSClient) *aws.Config {
+				return &client.GlobalAcceleratorConn().Config
+			},
+			ExpectedRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:      "AWS China Route 53",
+			Partition: endpoints.AwsCnPartitionID,
+			Region:    endpoints.CnNorth1RegionID,
+			Conn: func(client *AWSClient) *aws.Config {
+				return &client.Route53Conn().Config
+			},
+			ExpectedEndpoint: "https://api.route53.cn",
+			ExpectedRegion:   endpoints.CnNorthwest1RegionID,
+		},
+		{
+			Name:      "AWS GovCloud (US) Route 53",
+			Partition: endpoints.AwsUsGovPartitionID,
+			Region:    endpoints.UsGovEast1RegionID,
+			Conn: func(client *AWSClient) *aws.Config {
+				return &client.Route53Conn().Config
+			},
+			ExpectedRegion: endpoints.UsGovWest1RegionID,
+		},
+		{
+			Name:      "AWS GovCloud (US) Shield",
+			Partition: endpoints.AwsUsGovPartitionID,
+			Region:    endpoints.UsGovEast1RegionID,
+			Conn: func(client *AWSClient) *aws.Config {
+				return &client.ShieldConn().Config
+			},
+			ExpectedRegion: endpoints.UsGovEast1RegionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testAWSClient(t, testCase.Partition, testCase.Region, nil)
+
+			config := testCase.Conn(client)
+
+			if testCase.ExpectedEndpoint != "" {
+				if got := aws.StringValue(config.Endpoint); got != testCase.ExpectedEndpoint {
+					t.Errorf("got endpoint %s, expected %s", got, testCase.ExpectedEndpoint)
+				}
+			}
+
+			if got := aws.StringValue(config.Region); got != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", got, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestAWSClientS3Conn(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+	client.s3ForcePathStyle = true
+
+	conn := client.S3Conn()
+	connURICleaningDisabled := client.S3ConnURICleaningDisabled()
+
+	if conn == connURICleaningDisabled {
+		t.Fatal("expected distinct S3 clients")
+	}
+
+	if !aws.BoolValue(conn.Config.S3ForcePathStyle) || !aws.BoolValue(connURICleaningDisabled.Config.S3ForcePathStyle) {
+		t.Error("expected S3ForcePathStyle to be set on both S3 clients")
+	}
+
+	if aws.BoolValue(conn.Config.DisableRestProtocolURICleaning) {
+		t.Error("expected DisableRestProtocolURICleaning to be unset on S3Conn")
+	}
+
+	if !aws.BoolValue(connURICleaningDisabled.Config.DisableRestProtocolURICleaning) {
+		t.Error("expected DisableRestProtocolURICleaning to be set on S3ConnURICleaningDisabled")
+	}
+}
+
+func TestAWSClientS3ConnForRegion(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+	client.s3ForcePathStyle = true
+
+	if client.S3ConnForRegion(endpoints.UsWest2RegionID) != client.S3Conn() {
+		t.Error("expected the provider's S3 client for the provider's region")
+	}
+
+	if client.S3ConnForRegion("") != client.S3Conn() {
+		t.Error("expected the provider's S3 client for an empty region")
+	}
+
+	conn := client.S3ConnForRegion(endpoints.EuWest1RegionID)
+
+	if conn == client.S3Conn() {
+		t.Fatal("expected a distinct S3 client for another region")
+	}
+
+	if conn != client.S3ConnForRegion(endpoints.EuWest1RegionID) {
+		t.Error("expected the S3 client for a region to be reused")
+	}
+
+	if got, expected := aws.StringValue(conn.Config.Region), endpoints.EuWest1RegionID; got != expected {
+		t.Errorf("got region %s, expected %s", got, expected)
+	}
+
+	if !aws.BoolValue(conn.Config.S3ForcePathStyle) {
+		t.Error("expected S3ForcePathStyle to be set")
+	}
+}
+
+func TestAWSClientS3ConnForRegionFIPS(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+	client.useFIPSEndpoint = true
+
+	conn := client.S3ConnForRegion(endpoints.UsEast2RegionID)
+
+	if got, expected := aws.StringValue(conn.Config.Endpoint), "https://s3-fips.us-east-2.amazonaws.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+}
+
+func TestAWSClientConnConcurrent(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+
+	const n = 32
+	var wg sync.WaitGroup
+	results := make([]interface{}, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			results[i] = client.EC2Conn()
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i := 1; i < n; i++ {
+		if results[i] != results[0] {
+			t.Fatalf("got different EC2 clients from concurrent calls")
+		}
+	}
+}
+
+func TestAWSClientLazyConnPerKey(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan interface{})
+
+	go func() {
+		done <- client.lazyConn("slow", func() interface{} {
+			close(started)
+			<-release
+
+			return "slow"
+		})
+	}()
+
+	<-started
+
+	// Creating a client for another key must not wait for the slow client.
+	if got, expected := client.lazyConn("fast", func() interface{} { return "fast" }), "fast"; got != expected {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	close(release)
+
+	if got, expected := <-done, "slow"; got != expected {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestAWSClientLazyConnEError(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+
+	calls := 0
+	newConn := func() (interface{}, error) {
+		calls++
+
+		if calls == 1 {
+			return nil, errors.New("test error")
+		}
+
+		return "conn", nil
+	}
+
+	if _, err := client.lazyConnE("key", newConn); err == nil {
+		t.Fatal("expected error")
+	}
+
+	conn, err := client.lazyConnE("key", newConn)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := conn, "conn"; got != expected {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if _, err := client.lazyConnE("key", newConn); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := calls, 2; got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+}
+
+func TestAWSClientMediaConvertAccountConn(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"endpoints":[{"url":"https://abcd1234.mediaconvert.us-west-2.amazonaws.com"}]}`)
+	}))
+	defer server.Close()
+
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		MediaConvert: server.URL,
+	})
+
+	conn, err := client.MediaConvertAccountConn()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(conn.Config.Endpoint), "https://abcd1234.mediaconvert.us-west-2.amazonaws.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	resourceClient := client.ForResource("aws_media_convert_queue", "test")
+
+	if _, err := resourceClient.MediaConvertAccountConn(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := requests, 1; got != expected {
+		t.Errorf("got %d DescribeEndpoints requests, expected %d", got, expected)
+	}
+}
+
+func TestServiceDataNewConn(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+
+	for _, key := range ServiceKeys() {
+		if serviceData[key].NewConn == nil {
+			t.Errorf("no NewConn for service %s", key)
+			continue
+		}
+
+		if conn := client.conn(key); conn == nil {
+			t.Errorf("nil client for service %s", key)
+		}
+	}
+}
+
+// BenchmarkAWSClientAllConns creates every service client, as the provider did before clients were created lazily.
+func BenchmarkAWSClientAllConns(b *testing.B) {
+	keys := ServiceKeys()
+	sess := testSession(b, endpoints.UsWest2RegionID)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		client := &AWSClient{Partition: endpoints.AwsPartitionID, session: sess}
+
+		for _, key := range keys {
+			client.conn(key)
+		}
+	}
+}
+
+// BenchmarkAWSClientFewConns creates only the clients used by a typical small configuration.
+func BenchmarkAWSClientFewConns(b *testing.B) {
+	sess := testSession(b, endpoints.UsWest2RegionID)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		client := &AWSClient{Partition: endpoints.AwsPartitionID, session: sess}
+
+		client.IAMConn()
+		client.S3Conn()
+	}
+}
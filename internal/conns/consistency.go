@@ -0,0 +1,65 @@
+package conns
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// DefaultConsistencyPolicy is the eventual consistency policy of services without an entry in consistencyPolicies.
+var DefaultConsistencyPolicy = tfresource.ConsistencyPolicy{
+	Timeout: 1 * time.Minute,
+}
+
+// consistencyPolicies are the eventual consistency policies of services whose new resources are known to take
+// longer to become visible than the default, keyed by service name.
+var consistencyPolicies = map[string]tfresource.ConsistencyPolicy{
+	AppMesh: {
+		Timeout: 2 * time.Minute,
+	},
+	Backup: {
+		Timeout: 2 * time.Minute,
+	},
+	EC2: {
+		Timeout: 2 * time.Minute,
+	},
+	ECR: {
+		Timeout: 2 * time.Minute,
+	},
+	ELBV2: {
+		Timeout: 2 * time.Minute,
+	},
+	IAM: {
+		Timeout: 2 * time.Minute,
+		// IAM reads can succeed and then fail again immediately after creation.
+		ContinuousTargetOccurence: 2,
+	},
+	KMS: {
+		Timeout: 2 * time.Minute,
+	},
+	Route53: {
+		Timeout: 2 * time.Minute,
+	},
+	SecretsManager: {
+		Timeout: 2 * time.Minute,
+	},
+}
+
+// ConsistencyPolicyForService returns the default eventual consistency policy of the specified service.
+func ConsistencyPolicyForService(service string) tfresource.ConsistencyPolicy {
+	if policy, ok := consistencyPolicies[service]; ok {
+		return policy
+	}
+
+	return DefaultConsistencyPolicy
+}
+
+// ConsistencyPolicy returns the eventual consistency policy to use when reading new resources of the specified service.
+// The provider's eventual_consistency_timeout argument, if configured, overrides the timeout of all services
+// in the policy's Read method only. The policy's Timeout is always the service default.
+func (client *AWSClient) ConsistencyPolicy(service string) tfresource.ConsistencyPolicy {
+	policy := ConsistencyPolicyForService(service)
+	policy.ReadTimeout = client.eventualConsistencyTimeout
+
+	return policy
+}
@@ -0,0 +1,71 @@
+package conns
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestConsistencyPolicyServices(t *testing.T) {
+	for service := range consistencyPolicies {
+		if _, ok := serviceData[service]; !ok {
+			t.Errorf("consistency policy service (%s) is not a known service", service)
+		}
+	}
+}
+
+func TestAWSClientConsistencyPolicy(t *testing.T) {
+	zero := time.Duration(0)
+	override := 10 * time.Minute
+
+	testCases := []struct {
+		Name                       string
+		EventualConsistencyTimeout *time.Duration
+		Service                    string
+		Expected                   tfresource.ConsistencyPolicy
+	}{
+		{
+			Name:     "default",
+			Service:  SQS,
+			Expected: DefaultConsistencyPolicy,
+		},
+		{
+			Name:     "service",
+			Service:  EC2,
+			Expected: tfresource.ConsistencyPolicy{Timeout: 2 * time.Minute},
+		},
+		{
+			Name:                       "override default",
+			EventualConsistencyTimeout: &override,
+			Service:                    SQS,
+			Expected:                   tfresource.ConsistencyPolicy{Timeout: 1 * time.Minute, ReadTimeout: &override},
+		},
+		{
+			Name:                       "override service",
+			EventualConsistencyTimeout: &override,
+			Service:                    IAM,
+			Expected:                   tfresource.ConsistencyPolicy{Timeout: 2 * time.Minute, ContinuousTargetOccurence: 2, ReadTimeout: &override},
+		},
+		{
+			Name:                       "disabled",
+			EventualConsistencyTimeout: &zero,
+			Service:                    EC2,
+			Expected:                   tfresource.ConsistencyPolicy{Timeout: 2 * time.Minute, ReadTimeout: &zero},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &AWSClient{
+				eventualConsistencyTimeout: testCase.EventualConsistencyTimeout,
+			}
+
+			// The timeout of waiters for changes to propagate is never overridden.
+			if got := client.ConsistencyPolicy(testCase.Service); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// dualStackDNSSuffixes are the DNS suffixes of the IPv6 dual-stack endpoints in each partition.
+// The AWS Go SDK endpoints model only describes dual-stack endpoints for Amazon S3 and S3 Control.
+var dualStackDNSSuffixes = map[string]string{
+	endpoints.AwsPartitionID:      "api.aws",
+	endpoints.AwsCnPartitionID:    "api.amazonwebservices.com.cn",
+	endpoints.AwsUsGovPartitionID: "api.aws",
+}
+
+// resolvedEndpoint is a FIPS and/or dual-stack endpoint for a service.
+type resolvedEndpoint struct {
+	URL           string
+	SigningRegion string
+}
+
+// resolveEndpointVariant returns the FIPS and/or dual-stack endpoint for the service with the specified
+// endpoints ID (ServiceDatum.AWSEndpointsID) in the specified region.
+//
+// FIPS endpoints are only described in the AWS Go SDK endpoints model as pseudo-regions
+// (e.g. "fips-us-west-2", "us-west-2-fips" or "aws-global-fips"), so the pseudo-region whose credential scope
+// matches the service's regular endpoint is used. If there is no such pseudo-region the hostname is derived
+// from the partition's hostname template, as the AWS SDKs do for unmodeled endpoint variants.
+func resolveEndpointVariant(endpointsID, region string, useFIPS, useDualStack bool) (*resolvedEndpoint, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	if !ok {
+		return nil, fmt.Errorf("unable to determine partition for region (%s)", region)
+	}
+
+	// FIPS and dual-stack STS endpoints are regional.
+	regular, err := partition.EndpointFor(endpointsID, region, endpoints.ResolveUnknownServiceOption, endpoints.STSRegionalEndpointOption)
+
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s endpoint in region (%s): %w", endpointsID, region, err)
+	}
+
+	dualStack, err := partition.EndpointFor(endpointsID, region, endpoints.ResolveUnknownServiceOption, endpoints.STSRegionalEndpointOption, endpoints.UseDualStackOption)
+
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s dual-stack endpoint in region (%s): %w", endpointsID, region, err)
+	}
+
+	// Amazon S3 style "SERVICE.dualstack.REGION.amazonaws.com" endpoints.
+	modeledDualStack := dualStack.URL != regular.URL
+
+	if !useFIPS {
+		if modeledDualStack {
+			return &resolvedEndpoint{URL: dualStack.URL, SigningRegion: dualStack.SigningRegion}, nil
+		}
+
+		dnsSuffix, ok := dualStackDNSSuffixes[partition.ID()]
+
+		if !ok {
+			return nil, fmt.Errorf("dual-stack endpoints are not supported in partition (%s)", partition.ID())
+		}
+
+		return &resolvedEndpoint{
+			URL:           fmt.Sprintf("https://%s.%s.%s", endpointsID, regular.SigningRegion, dnsSuffix),
+			SigningRegion: regular.SigningRegion,
+		}, nil
+	}
+
+	if !useDualStack {
+		if fips, ok := modeledFIPSEndpoint(partition, endpointsID, region, regular.SigningRegion); ok {
+			return fips, nil
+		}
+
+		return &resolvedEndpoint{
+			URL:           fmt.Sprintf("https://%s-fips.%s.%s", endpointsID, regular.SigningRegion, partition.DNSSuffix()),
+			SigningRegion: regular.SigningRegion,
+		}, nil
+	}
+
+	if modeledDualStack {
+		return &resolvedEndpoint{
+			URL:           fmt.Sprintf("https://%s-fips.dualstack.%s.%s", endpointsID, dualStack.SigningRegion, partition.DNSSuffix()),
+			SigningRegion: dualStack.SigningRegion,
+		}, nil
+	}
+
+	dnsSuffix, ok := dualStackDNSSuffixes[partition.ID()]
+
+	if !ok {
+		return nil, fmt.Errorf("dual-stack endpoints are not supported in partition (%s)", partition.ID())
+	}
+
+	return &resolvedEndpoint{
+		URL:           fmt.Sprintf("https://%s-fips.%s.%s", endpointsID, regular.SigningRegion, dnsSuffix),
+		SigningRegion: regular.SigningRegion,
+	}, nil
+}
+
+// modeledFIPSEndpoint returns the FIPS endpoint described by a pseudo-region in the AWS Go SDK endpoints model.
+func modeledFIPSEndpoint(partition endpoints.Partition, endpointsID, region, signingRegion string) (*resolvedEndpoint, bool) {
+	service, ok := partition.Services()[endpointsID]
+
+	if !ok {
+		return nil, false
+	}
+
+	serviceEndpoints := service.Endpoints()
+
+	// Prefer the conventional pseudo-region names, then any other FIPS pseudo-region with the same credential scope.
+	ids := []string{"fips-" + region, region + "-fips"}
+
+	var others []string
+
+	for id := range serviceEndpoints {
+		if strings.Contains(id, "fips") {
+			others = append(others, id)
+		}
+	}
+
+	sort.Strings(others)
+	ids = append(ids, others...)
+
+	for _, id := range ids {
+		endpoint, ok := serviceEndpoints[id]
+
+		if !ok {
+			continue
+		}
+
+		resolved, err := endpoint.ResolveEndpoint()
+
+		if err != nil || resolved.SigningRegion != signingRegion {
+			continue
+		}
+
+		return &resolvedEndpoint{URL: resolved.URL, SigningRegion: resolved.SigningRegion}, true
+	}
+
+	return nil, false
+}
+
+// stsEndpoint returns the STS endpoint used to validate credentials and assume IAM Roles.
+// An explicitly configured endpoint takes precedence over FIPS and dual-stack endpoints.
+func (c *Config) stsEndpoint() string {
+	if v := c.Endpoints[STS]; v != "" || !(c.UseFIPSEndpoint || c.UseDualStackEndpoint) {
+		return v
+	}
+
+	endpoint, err := resolveEndpointVariant(serviceData[STS].AWSEndpointsID, c.Region, c.UseFIPSEndpoint, c.UseDualStackEndpoint)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to resolve FIPS or dual-stack endpoint for STS, using default endpoint: %s", err)
+		return ""
+	}
+
+	return endpoint.URL
+}
@@ -0,0 +1,207 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestResolveEndpointVariant(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		EndpointsID           string
+		Region                string
+		UseFIPS               bool
+		UseDualStack          bool
+		ExpectedError         bool
+		ExpectedURL           string
+		ExpectedSigningRegion string
+	}{
+		{
+			Name:                  "FIPS pseudo-region",
+			EndpointsID:           "ec2",
+			Region:                endpoints.UsWest2RegionID,
+			UseFIPS:               true,
+			ExpectedURL:           "https://ec2-fips.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:                  "FIPS global service",
+			EndpointsID:           "iam",
+			Region:                endpoints.UsWest2RegionID,
+			UseFIPS:               true,
+			ExpectedURL:           "https://iam-fips.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsEast1RegionID,
+		},
+		{
+			Name:                  "FIPS regional STS",
+			EndpointsID:           "sts",
+			Region:                endpoints.UsWest2RegionID,
+			UseFIPS:               true,
+			ExpectedURL:           "https://sts-fips.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:                  "FIPS not modeled",
+			EndpointsID:           "s3",
+			Region:                endpoints.UsWest2RegionID,
+			UseFIPS:               true,
+			ExpectedURL:           "https://s3-fips.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:                  "FIPS AWS GovCloud (US)",
+			EndpointsID:           "ec2",
+			Region:                endpoints.UsGovWest1RegionID,
+			UseFIPS:               true,
+			ExpectedURL:           "https://ec2-fips.us-gov-west-1.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsGovWest1RegionID,
+		},
+		{
+			Name:                  "dual-stack",
+			EndpointsID:           "ec2",
+			Region:                endpoints.UsWest2RegionID,
+			UseDualStack:          true,
+			ExpectedURL:           "https://ec2.us-west-2.api.aws",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:                  "dual-stack modeled",
+			EndpointsID:           "s3",
+			Region:                endpoints.UsWest2RegionID,
+			UseDualStack:          true,
+			ExpectedURL:           "https://s3.dualstack.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:                  "dual-stack AWS China",
+			EndpointsID:           "ec2",
+			Region:                endpoints.CnNorth1RegionID,
+			UseDualStack:          true,
+			ExpectedURL:           "https://ec2.cn-north-1.api.amazonwebservices.com.cn",
+			ExpectedSigningRegion: endpoints.CnNorth1RegionID,
+		},
+		{
+			Name:          "dual-stack unsupported partition",
+			EndpointsID:   "ec2",
+			Region:        endpoints.UsIsoEast1RegionID,
+			UseDualStack:  true,
+			ExpectedError: true,
+		},
+		{
+			Name:                  "FIPS and dual-stack",
+			EndpointsID:           "ec2",
+			Region:                endpoints.UsWest2RegionID,
+			UseFIPS:               true,
+			UseDualStack:          true,
+			ExpectedURL:           "https://ec2-fips.us-west-2.api.aws",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:                  "FIPS and dual-stack modeled",
+			EndpointsID:           "s3",
+			Region:                endpoints.UsWest2RegionID,
+			UseFIPS:               true,
+			UseDualStack:          true,
+			ExpectedURL:           "https://s3-fips.dualstack.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:          "unknown region",
+			EndpointsID:   "ec2",
+			Region:        "unknown",
+			UseFIPS:       true,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := resolveEndpointVariant(testCase.EndpointsID, testCase.Region, testCase.UseFIPS, testCase.UseDualStack)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got endpoint %s", got.URL)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.URL != testCase.ExpectedURL {
+				t.Errorf("got URL %s, expected %s", got.URL, testCase.ExpectedURL)
+			}
+
+			if got.SigningRegion != testCase.ExpectedSigningRegion {
+				t.Errorf("got signing region %s, expected %s", got.SigningRegion, testCase.ExpectedSigningRegion)
+			}
+		})
+	}
+}
+
+func TestAWSClientConnEndpointVariant(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		SQS: "http://sqs.test",
+	})
+	client.useFIPSEndpoint = true
+
+	if got, expected := aws.StringValue(client.SQSConn().Config.Endpoint), "http://sqs.test"; got != expected {
+		t.Errorf("got SQS endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.EC2Conn().Config.Endpoint), "https://ec2-fips.us-west-2.amazonaws.com"; got != expected {
+		t.Errorf("got EC2 endpoint %s, expected %s", got, expected)
+	}
+
+	iamConfig := client.IAMConn().Config
+
+	if got, expected := aws.StringValue(iamConfig.Endpoint), "https://iam-fips.amazonaws.com"; got != expected {
+		t.Errorf("got IAM endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(iamConfig.Region), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got IAM region %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigSTSEndpoint(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Config   *Config
+		Expected string
+	}{
+		{
+			Name:   "default",
+			Config: &Config{Region: endpoints.UsWest2RegionID},
+		},
+		{
+			Name: "FIPS",
+			Config: &Config{
+				Region:          endpoints.UsWest2RegionID,
+				UseFIPSEndpoint: true,
+			},
+			Expected: "https://sts-fips.us-west-2.amazonaws.com",
+		},
+		{
+			Name: "explicit endpoint",
+			Config: &Config{
+				Endpoints:       map[string]string{STS: "http://sts.test"},
+				Region:          endpoints.UsWest2RegionID,
+				UseFIPSEndpoint: true,
+			},
+			Expected: "http://sts.test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Config.stsEndpoint(); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -14,6 +14,9 @@ const (
 	// See also AWS_SECRET_ACCESS_KEY and AWS_PROFILE
 	EnvVarAccessKeyId = "AWS_ACCESS_KEY_ID"
 
+	// PEM encoded certificate bundle trusted in addition to the system certificate pool (AWS Go SDK does not provide this as constant)
+	EnvVarCABundle = "AWS_CA_BUNDLE"
+
 	// Container credentials endpoint
 	// See also AWS_ACCESS_KEY_ID and AWS_PROFILE
 	EnvVarContainerCredentialsFullUri = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
@@ -21,12 +24,42 @@ const (
 	// Default AWS region for tests (AWS Go SDK does not provide this as constant)
 	EnvVarDefaultRegion = "AWS_DEFAULT_REGION"
 
+	// EC2 Instance Metadata Service endpoint (AWS Go SDK does not provide this as constant)
+	// See also AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE
+	EnvVarEC2MetadataServiceEndpoint = "AWS_EC2_METADATA_SERVICE_ENDPOINT"
+
+	// EC2 Instance Metadata Service endpoint mode, IPv4 or IPv6 (AWS Go SDK does not provide this as constant)
+	// See also AWS_EC2_METADATA_SERVICE_ENDPOINT
+	EnvVarEC2MetadataServiceEndpointMode = "AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE"
+
 	// Default AWS shared configuration profile for tests (AWS Go SDK does not provide this as constant)
 	EnvVarProfile = "AWS_PROFILE"
 
+	// IAM Role to assume with a web identity token (AWS Go SDK does not provide this as constant)
+	// See also AWS_ROLE_SESSION_NAME and AWS_WEB_IDENTITY_TOKEN_FILE
+	EnvVarRoleARN = "AWS_ROLE_ARN"
+
+	// Session name used when assuming an IAM Role with a web identity token (AWS Go SDK does not provide this as constant)
+	EnvVarRoleSessionName = "AWS_ROLE_SESSION_NAME"
+
+	// Retry mode, standard or adaptive (AWS Go SDK does not provide this as constant)
+	EnvVarRetryMode = "AWS_RETRY_MODE"
+
 	// Default static credential value for tests (AWS Go SDK does not provide this as constant)
 	// See also AWS_ACCESS_KEY_ID and AWS_PROFILE
 	EnvVarSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+
+	// Resolve IPv6 dual-stack endpoints (AWS Go SDK does not provide this as constant)
+	// See also AWS_USE_FIPS_ENDPOINT
+	EnvVarUseDualStackEndpoint = "AWS_USE_DUALSTACK_ENDPOINT"
+
+	// Resolve FIPS endpoints (AWS Go SDK does not provide this as constant)
+	// See also AWS_USE_DUALSTACK_ENDPOINT
+	EnvVarUseFIPSEndpoint = "AWS_USE_FIPS_ENDPOINT"
+
+	// File containing an OAuth 2.0 access token or OpenID Connect ID token (AWS Go SDK does not provide this as constant)
+	// See also AWS_ROLE_ARN and AWS_ROLE_SESSION_NAME
+	EnvVarWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
 )
 
 // Custom environment variables used in the Terraform AWS Provider testing.
@@ -54,6 +87,14 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// Record/replay mode of acceptance tests, RECORD or REPLAY
+	// See also TF_ACC_VCR_PATH
+	EnvVarAccVCRMode = "TF_ACC_VCR_MODE"
+
+	// Directory containing the record/replay cassettes of acceptance tests, by default testdata/cassettes
+	// See also TF_ACC_VCR_MODE
+	EnvVarAccVCRPath = "TF_ACC_VCR_PATH"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -72,6 +113,31 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for filtering, scheduling and reporting with resource sweepers
+const (
+	// Report the resources that would be deleted without deleting them
+	EnvVarSweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Minimum age, as a Go duration, of resources to delete.
+	// Resources that do not expose a creation timestamp are not deleted if set.
+	EnvVarSweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Comma-separated tag keys or key=value pairs, one of which a resource must have to be deleted
+	EnvVarSweepAllowTags = "TF_AWS_SWEEP_ALLOW_TAGS"
+
+	// Comma-separated tag keys or key=value pairs that protect a resource from deletion
+	EnvVarSweepDenyTags = "TF_AWS_SWEEP_DENY_TAGS"
+
+	// Path of a JSON file to which the sweep report is written
+	EnvVarSweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// Maximum number of sweepers run concurrently in a region
+	EnvVarSweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// Comma-separated service=limit pairs capping the number of concurrent deletions per service, e.g. ec2=5,iam=2
+	EnvVarSweepServiceConcurrency = "TF_AWS_SWEEP_SERVICE_CONCURRENCY"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
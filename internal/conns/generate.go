@@ -0,0 +1,4 @@
+//go:generate go run ../generate/awsclient/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package conns
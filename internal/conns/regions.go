@@ -0,0 +1,38 @@
+package conns
+
+import (
+	"fmt"
+)
+
+// RegionsRestricted returns whether the provider configuration restricts the regions in which resources can be managed.
+// Resources can use it to avoid looking up the region of a related resource unnecessarily.
+func (client *AWSClient) RegionsRestricted() bool {
+	return len(client.allowedRegions) > 0 || len(client.forbiddenRegions) > 0
+}
+
+// ValidateRegion returns an error if the provider configuration does not allow resources to be managed in the specified region.
+// Resources that accept an explicit region, e.g. for replicas or copy sources, must call it before making any change.
+func (client *AWSClient) ValidateRegion(region string) error {
+	return validateRegion(region, client.allowedRegions, client.forbiddenRegions)
+}
+
+// validateRegion checks if the given AWS region is specifically allowed or forbidden.
+func validateRegion(region string, allowedRegions, forbiddenRegions []string) error {
+	for _, forbiddenRegion := range forbiddenRegions {
+		if region == forbiddenRegion {
+			return fmt.Errorf("Forbidden AWS Region: %s", region)
+		}
+	}
+
+	if len(allowedRegions) > 0 {
+		for _, allowedRegion := range allowedRegions {
+			if region == allowedRegion {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	return nil
+}
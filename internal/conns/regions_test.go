@@ -0,0 +1,94 @@
+package conns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestAWSClientValidateRegion(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		AllowedRegions   []string
+		ForbiddenRegions []string
+		Region           string
+		ExpectedError    string
+	}{
+		{
+			Name:   "no restrictions",
+			Region: endpoints.UsEast1RegionID,
+		},
+		{
+			Name:           "allowed",
+			AllowedRegions: []string{endpoints.UsEast1RegionID, endpoints.UsWest2RegionID},
+			Region:         endpoints.UsWest2RegionID,
+		},
+		{
+			Name:           "not allowed",
+			AllowedRegions: []string{endpoints.UsEast1RegionID, endpoints.UsWest2RegionID},
+			Region:         endpoints.EuWest1RegionID,
+			ExpectedError:  "AWS Region not allowed: eu-west-1",
+		},
+		{
+			Name:             "forbidden",
+			ForbiddenRegions: []string{endpoints.EuWest1RegionID},
+			Region:           endpoints.EuWest1RegionID,
+			ExpectedError:    "Forbidden AWS Region: eu-west-1",
+		},
+		{
+			Name:             "not forbidden",
+			ForbiddenRegions: []string{endpoints.EuWest1RegionID},
+			Region:           endpoints.UsEast1RegionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &AWSClient{
+				allowedRegions:   testCase.AllowedRegions,
+				forbiddenRegions: testCase.ForbiddenRegions,
+			}
+
+			if got, expected := client.RegionsRestricted(), len(testCase.AllowedRegions) > 0 || len(testCase.ForbiddenRegions) > 0; got != expected {
+				t.Errorf("got RegionsRestricted %t, expected %t", got, expected)
+			}
+
+			err := client.ForResourceType("aws_test").ValidateRegion(testCase.Region)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got := err.Error(); got != testCase.ExpectedError {
+				t.Errorf("got error %q, expected %q", got, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestConfigClientForbiddenRegion(t *testing.T) {
+	config := &Config{
+		ForbiddenRegions: []string{endpoints.UsEast1RegionID},
+		Region:           endpoints.UsEast1RegionID,
+	}
+
+	// The region is checked before credentials are validated or any API calls are made.
+	_, err := config.Client()
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if !strings.Contains(err.Error(), "Forbidden AWS Region") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
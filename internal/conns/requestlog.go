@@ -0,0 +1,323 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	requestLoggerHandlerName   = "tfsdk.RequestLogger"
+	resourceContextHandlerName = "tfsdk.ResourceContext"
+
+	redactedValue = "(sensitive value)"
+)
+
+// knownSensitiveFieldNames are API shape members that contain secrets but are not
+// marked as sensitive in the AWS Go SDK API models.
+var knownSensitiveFieldNames = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"Password",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+}
+
+type resourceContextKey struct{}
+
+type resourceContext struct {
+	resourceType string
+	id           string
+}
+
+// NewResourceContext returns a copy of the specified context that carries the Terraform resource type and ID.
+// API calls made with the returned context are attributed to the resource in request logs.
+func NewResourceContext(ctx context.Context, resourceType, id string) context.Context {
+	return context.WithValue(ctx, resourceContextKey{}, resourceContext{resourceType: resourceType, id: id})
+}
+
+func resourceContextFromContext(ctx context.Context) (resourceContext, bool) {
+	v, ok := ctx.Value(resourceContextKey{}).(resourceContext)
+
+	return v, ok
+}
+
+// withResourceContext returns a copy of the specified AWS Go SDK service client
+// whose API calls not made with a resource context are attributed to the specified resource.
+// Service clients are pointers to structs embedding *client.Client, e.g. *sqs.SQS.
+func withResourceContext(conn interface{}, rc resourceContext) interface{} {
+	v := reflect.ValueOf(conn)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct || v.Elem().NumField() == 0 {
+		return conn
+	}
+
+	c, ok := v.Elem().Field(0).Interface().(*client.Client)
+
+	if !ok || c == nil {
+		return conn
+	}
+
+	cc := *c
+	cc.Handlers = c.Handlers.Copy()
+	cc.Handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: resourceContextHandlerName,
+		Fn: func(r *request.Request) {
+			if _, ok := resourceContextFromContext(r.Context()); !ok {
+				r.SetContext(context.WithValue(r.Context(), resourceContextKey{}, rc))
+			}
+		},
+	})
+
+	result := reflect.New(v.Elem().Type())
+	result.Elem().Set(v.Elem())
+	result.Elem().Field(0).Set(reflect.ValueOf(&cc))
+
+	return result.Interface()
+}
+
+// requestLogEntry is the structured log line written for each API call.
+type requestLogEntry struct {
+	Service        string      `json:"service"`
+	Operation      string      `json:"operation"`
+	Region         string      `json:"region,omitempty"`
+	DurationMS     int64       `json:"duration_ms"`
+	Retries        int         `json:"retries"`
+	HTTPStatus     int         `json:"http_status,omitempty"`
+	RequestID      string      `json:"request_id,omitempty"`
+	ResourceType   string      `json:"tf_resource_type,omitempty"`
+	ResourceID     string      `json:"tf_resource_id,omitempty"`
+	ErrorCode      string      `json:"error_code,omitempty"`
+	ErrorMessage   string      `json:"error_message,omitempty"`
+	RequestParams  interface{} `json:"request,omitempty"`
+	ResponseResult interface{} `json:"response,omitempty"`
+}
+
+// requestLogger writes a structured, redacted log line for each API call.
+// It replaces the AWS Go SDK debug logging, which writes raw request and response bodies.
+type requestLogger struct {
+	// sensitiveFields are the field names redacted in the API calls of each Terraform resource type.
+	sensitiveFields map[string]map[string]struct{}
+	// defaultSensitiveFields are the field names redacted in API calls that are not attributed to a resource.
+	// The sensitive attributes of resources are not redacted in such calls, as the same name may be sensitive
+	// in one resource's API shapes but not in another's, e.g. "Name".
+	defaultSensitiveFields map[string]struct{}
+	now                    func() time.Time
+	print                  func(string)
+}
+
+// newRequestLogger returns a requestLogger that redacts the specified sensitive attributes of each Terraform resource type,
+// in addition to API shape members marked as sensitive.
+func newRequestLogger(sensitiveAttributes map[string][]string) *requestLogger {
+	l := &requestLogger{
+		sensitiveFields:        make(map[string]map[string]struct{}),
+		defaultSensitiveFields: make(map[string]struct{}),
+		now:                    time.Now,
+		print: func(s string) {
+			log.Printf("[DEBUG] [aws-sdk-go] %s", s)
+		},
+	}
+
+	for _, name := range knownSensitiveFieldNames {
+		l.defaultSensitiveFields[name] = struct{}{}
+	}
+
+	for resourceType, attributeNames := range sensitiveAttributes {
+		fields := make(map[string]struct{})
+
+		for _, name := range knownSensitiveFieldNames {
+			fields[name] = struct{}{}
+		}
+
+		for _, attributeName := range attributeNames {
+			fieldName := fieldNameForAttribute(attributeName)
+			fields[fieldName] = struct{}{}
+		}
+
+		l.sensitiveFields[resourceType] = fields
+	}
+
+	return l
+}
+
+// install adds the logger's handler to the specified handlers.
+func (l *requestLogger) install(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: requestLoggerHandlerName,
+		Fn:   l.logRequest,
+	})
+}
+
+func (l *requestLogger) logRequest(r *request.Request) {
+	entry := requestLogEntry{
+		Service:    r.ClientInfo.ServiceID,
+		Operation:  r.Operation.Name,
+		Region:     aws.StringValue(r.Config.Region),
+		DurationMS: l.now().Sub(r.Time).Milliseconds(),
+		Retries:    r.RetryCount,
+		RequestID:  r.RequestID,
+	}
+
+	if r.HTTPResponse != nil {
+		entry.HTTPStatus = r.HTTPResponse.StatusCode
+	}
+
+	sensitiveFields := l.defaultSensitiveFields
+
+	if v, ok := resourceContextFromContext(r.Context()); ok {
+		entry.ResourceType = v.resourceType
+		entry.ResourceID = v.id
+
+		if fields, ok := l.sensitiveFields[v.resourceType]; ok {
+			sensitiveFields = fields
+		}
+	}
+
+	entry.RequestParams = redact(reflect.ValueOf(r.Params), sensitiveFields)
+
+	if r.Error != nil {
+		if awsErr, ok := r.Error.(awserr.Error); ok {
+			entry.ErrorCode = awsErr.Code()
+			entry.ErrorMessage = awsErr.Message()
+		} else {
+			entry.ErrorMessage = r.Error.Error()
+		}
+	} else {
+		entry.ResponseResult = redact(reflect.ValueOf(r.Data), sensitiveFields)
+	}
+
+	line, err := json.Marshal(entry)
+
+	if err != nil {
+		log.Printf("[WARN] %s/%s: marshaling request log entry: %s", r.ClientInfo.ServiceName, r.Operation.Name, err)
+		return
+	}
+
+	l.print(string(line))
+}
+
+var (
+	readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// redact returns a JSON-serializable copy of an API shape with sensitive values replaced.
+// Struct fields are redacted if they are marked as sensitive in the API model or their name is in sensitiveFields.
+func redact(v reflect.Value, sensitiveFields map[string]struct{}) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type().Implements(readerType) && v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+
+		return "(stream)"
+	}
+
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+
+		return redact(v.Elem(), sensitiveFields)
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface()
+		}
+
+		fields := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			ft := v.Type().Field(i)
+
+			if ft.PkgPath != "" {
+				continue
+			}
+
+			fv := v.Field(i)
+
+			if fv.IsZero() {
+				continue
+			}
+
+			if _, ok := sensitiveFields[ft.Name]; ok || ft.Tag.Get("sensitive") == "true" {
+				fields[ft.Name] = redactedValue
+				continue
+			}
+
+			fields[ft.Name] = redact(fv, sensitiveFields)
+		}
+
+		if len(fields) == 0 {
+			return nil
+		}
+
+		return fields
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("(%d bytes)", v.Len())
+		}
+
+		values := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			values[i] = redact(v.Index(i), sensitiveFields)
+		}
+
+		return values
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		values := make(map[string]interface{}, v.Len())
+
+		for _, k := range v.MapKeys() {
+			values[fmt.Sprint(k.Interface())] = redact(v.MapIndex(k), sensitiveFields)
+		}
+
+		return values
+	}
+
+	return v.Interface()
+}
+
+// fieldNameForAttribute returns the API shape member name corresponding to a Terraform attribute name,
+// e.g. "secret_string" => "SecretString".
+func fieldNameForAttribute(attributeName string) string {
+	var sb strings.Builder
+
+	for _, part := range strings.Split(attributeName, "_") {
+		if part == "" {
+			continue
+		}
+
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,278 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestRedact(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Value           interface{}
+		SensitiveFields map[string]struct{}
+		Expected        string
+	}{
+		{
+			Name:     "nil",
+			Value:    (*ssm.PutParameterInput)(nil),
+			Expected: `null`,
+		},
+		{
+			Name: "sensitive in API model",
+			Value: &ssm.PutParameterInput{
+				Name:  aws.String("/test"),
+				Tags:  []*ssm.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+				Type:  aws.String(ssm.ParameterTypeSecureString),
+				Value: aws.String("secret"),
+			},
+			Expected: `{"Name":"/test","Tags":[{"Key":"Name","Value":"test"}],"Type":"SecureString","Value":"(sensitive value)"}`,
+		},
+		{
+			Name: "sensitive nested",
+			Value: &secretsmanager.GetSecretValueOutput{
+				Name:         aws.String("test"),
+				SecretBinary: []byte("secret"),
+				SecretString: aws.String("secret"),
+			},
+			Expected: `{"Name":"test","SecretBinary":"(sensitive value)","SecretString":"(sensitive value)"}`,
+		},
+		{
+			Name: "known sensitive",
+			Value: &rds.CreateDBInstanceInput{
+				DBInstanceIdentifier: aws.String("test"),
+				MasterUserPassword:   aws.String("secret"),
+			},
+			SensitiveFields: newRequestLogger(nil).defaultSensitiveFields,
+			Expected:        `{"DBInstanceIdentifier":"test","MasterUserPassword":"(sensitive value)"}`,
+		},
+		{
+			Name: "sensitive field names",
+			Value: &ssm.PutParameterInput{
+				Description: aws.String("test"),
+				Name:        aws.String("/test"),
+			},
+			SensitiveFields: map[string]struct{}{"Name": {}},
+			Expected:        `{"Description":"test","Name":"(sensitive value)"}`,
+		},
+		{
+			Name: "stream and blob",
+			Value: &s3.PutObjectInput{
+				Body:       strings.NewReader("content"),
+				Bucket:     aws.String("test"),
+				ContentMD5: aws.String("Q29udGVudA=="),
+				Key:        aws.String("test"),
+				Metadata:   map[string]*string{"key": aws.String("value")},
+			},
+			Expected: `{"Body":"(stream)","Bucket":"test","ContentMD5":"Q29udGVudA==","Key":"test","Metadata":{"key":"value"}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := json.Marshal(redact(reflect.ValueOf(testCase.Value), testCase.SensitiveFields))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFieldNameForAttribute(t *testing.T) {
+	testCases := map[string]string{
+		"password":        "Password",
+		"secret_string":   "SecretString",
+		"master_password": "MasterPassword",
+	}
+
+	for attributeName, expected := range testCases {
+		if got := fieldNameForAttribute(attributeName); got != expected {
+			t.Errorf("%s: got %s, expected %s", attributeName, got, expected)
+		}
+	}
+}
+
+func TestRequestLogger(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.Header().Set("X-Amzn-Requestid", "01234567-89ab-cdef-0123-456789abcdef")
+		fmt.Fprint(w, testAssumeRoleResponse)
+	}))
+	defer ts.Close()
+
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsEast1RegionID, map[string]string{
+		STS: ts.URL,
+	})
+
+	var lines []string
+
+	logger := newRequestLogger(map[string][]string{
+		"aws_test": {"role_session_name"},
+	})
+	logger.now = func() time.Time { return time.Unix(0, 0) }
+	logger.print = func(s string) {
+		lines = append(lines, s)
+	}
+	logger.install(&client.session.Handlers)
+
+	ctx := NewResourceContext(context.Background(), "aws_test", "test-id")
+
+	_, err := client.STSConn().AssumeRoleWithContext(ctx, &sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+		RoleSessionName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(lines), 1; got != expected {
+		t.Fatalf("got %d log lines, expected %d", got, expected)
+	}
+
+	for _, secret := range []string{"secret-access-key", "session-token", `"test"`} {
+		if strings.Contains(lines[0], secret) {
+			t.Errorf("log line contains sensitive value %s: %s", secret, lines[0])
+		}
+	}
+
+	var entry map[string]interface{}
+
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("unexpected error unmarshaling log line: %s", err)
+	}
+
+	for key, expected := range map[string]interface{}{
+		"service":          sts.ServiceID,
+		"operation":        "AssumeRole",
+		"region":           endpoints.UsEast1RegionID,
+		"retries":          float64(0),
+		"http_status":      float64(http.StatusOK),
+		"request_id":       "01234567-89ab-cdef-0123-456789abcdef",
+		"tf_resource_type": "aws_test",
+		"tf_resource_id":   "test-id",
+	} {
+		if got := entry[key]; got != expected {
+			t.Errorf("got %s %v, expected %v", key, got, expected)
+		}
+	}
+
+	if got, expected := fmt.Sprint(entry["response"]), "ASIAEXAMPLE"; !strings.Contains(got, expected) {
+		t.Errorf("got response %s, expected it to contain %s", got, expected)
+	}
+}
+
+func TestRequestLoggerForResource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, testAssumeRoleResponse)
+	}))
+	defer ts.Close()
+
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsEast1RegionID, map[string]string{
+		STS: ts.URL,
+	})
+
+	var lines []string
+
+	logger := newRequestLogger(map[string][]string{
+		"aws_test": {"role_session_name"},
+	})
+	logger.print = func(s string) {
+		lines = append(lines, s)
+	}
+	logger.install(&client.session.Handlers)
+
+	input := &sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+		RoleSessionName: aws.String("test-session"),
+	}
+
+	// API calls made without a context are attributed to the resource of the client.
+	if _, err := client.ForResource("aws_test", "test-id").STSConn().AssumeRole(input); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// API calls made with a resource context are attributed to the resource of the context.
+	ctx := NewResourceContext(context.Background(), "aws_other", "other-id")
+
+	if _, err := client.ForResource("aws_test", "test-id").STSConn().AssumeRoleWithContext(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// API calls of the original client are not attributed and resource sensitive attributes are not redacted.
+	if _, err := client.STSConn().AssumeRole(input); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(lines), 3; got != expected {
+		t.Fatalf("got %d log lines, expected %d", got, expected)
+	}
+
+	for i, expected := range []struct {
+		resourceType string
+		resourceID   string
+		redacted     bool
+	}{
+		{"aws_test", "test-id", true},
+		{"aws_other", "other-id", false},
+		{"", "", false},
+	} {
+		var entry map[string]interface{}
+
+		if err := json.Unmarshal([]byte(lines[i]), &entry); err != nil {
+			t.Fatalf("unexpected error unmarshaling log line: %s", err)
+		}
+
+		for key, expected := range map[string]string{
+			"tf_resource_type": expected.resourceType,
+			"tf_resource_id":   expected.resourceID,
+		} {
+			if got, _ := entry[key].(string); got != expected {
+				t.Errorf("line %d: got %s %q, expected %q", i, key, got, expected)
+			}
+		}
+
+		if got := strings.Contains(lines[i], "test-session"); got == expected.redacted {
+			t.Errorf("line %d: got RoleSessionName redacted %t, expected %t: %s", i, !got, expected.redacted, lines[i])
+		}
+	}
+}
+
+// lintignore:AWSAT005
+const testAssumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/test/test</Arn>
+      <AssumedRoleId>AROAEXAMPLE:test</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>secret-access-key</SecretAccessKey>
+      <SessionToken>session-token</SessionToken>
+      <Expiration>2021-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
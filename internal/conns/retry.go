@@ -0,0 +1,430 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// RetryModeStandard retries requests with exponential backoff, limited by a provider-wide retry quota.
+	RetryModeStandard = "standard"
+	// RetryModeAdaptive additionally limits the rate of requests to a service after it has throttled requests.
+	RetryModeAdaptive = "adaptive"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+// RateLimit is a client-side limit on the rate of requests to a service.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests.
+	RequestsPerSecond float64
+	// Burst is the number of requests that can be made before the rate is limited.
+	// Defaults to RequestsPerSecond, rounded up.
+	Burst int
+}
+
+const (
+	// Standard retry mode settings, as used by the AWS SDKs.
+	retryQuotaCapacity        = 500
+	retryQuotaRetryCost       = 5
+	retryQuotaTimeoutCost     = 10
+	retryQuotaNoRetryIncrease = 1
+	retryMaxBackoff           = 20 * time.Second
+
+	// Adaptive retry mode settings, as used by the AWS SDKs.
+	adaptiveBeta          = 0.7
+	adaptiveScaleConstant = 0.4
+	adaptiveSmooth        = 0.8
+	adaptiveMinFillRate   = 0.5
+	adaptiveMinCapacity   = 1
+)
+
+// retryer is the request.Retryer used in standard and adaptive retry modes.
+// Retries are drawn from a retry quota that is shared by all service clients, so that
+// an outage or sustained throttling does not multiply the load on AWS with retries.
+type retryer struct {
+	client.DefaultRetryer
+
+	quota *retryQuota
+}
+
+func newRetryer(maxRetries int, quota *retryQuota) *retryer {
+	if maxRetries == 0 {
+		maxRetries = client.DefaultRetryerMaxNumRetries
+	}
+
+	return &retryer{
+		DefaultRetryer: client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MaxRetryDelay:    retryMaxBackoff,
+			MaxThrottleDelay: retryMaxBackoff,
+		},
+		quota: quota,
+	}
+}
+
+func (r *retryer) ShouldRetry(req *request.Request) bool {
+	if req.RetryCount >= r.MaxRetries() || !r.DefaultRetryer.ShouldRetry(req) {
+		return false
+	}
+
+	cost := retryQuotaRetryCost
+	if isErrorTimeout(req) {
+		cost = retryQuotaTimeoutCost
+	}
+
+	if !r.quota.acquireRetry(req, cost) {
+		log.Printf("[DEBUG] %s/%s: retry quota exhausted, not retrying: %s", req.ClientInfo.ServiceName, req.Operation.Name, req.Error)
+		return false
+	}
+
+	return true
+}
+
+// retryQuota is a token bucket of retries. Retries remove tokens and successful requests return them.
+type retryQuota struct {
+	lock      sync.Mutex
+	available int
+	retryCost map[*request.Request]int // cost of the last retry of each request being retried
+}
+
+func newRetryQuota() *retryQuota {
+	return &retryQuota{
+		available: retryQuotaCapacity,
+		retryCost: make(map[*request.Request]int),
+	}
+}
+
+// acquireRetry removes the cost of a retry of the request from the quota, recording it
+// so that it is returned if the retry succeeds.
+func (q *retryQuota) acquireRetry(r *request.Request, cost int) bool {
+	if !q.acquire(cost) {
+		return false
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.retryCost[r] = cost
+
+	return true
+}
+
+// complete returns tokens to the quota once the request has completed: the cost of its last retry
+// if it succeeded after being retried, or a fixed amount if it succeeded without being retried.
+func (q *retryQuota) complete(r *request.Request) {
+	q.lock.Lock()
+	cost, retried := q.retryCost[r]
+	delete(q.retryCost, r)
+	q.lock.Unlock()
+
+	if r.Error != nil {
+		return
+	}
+
+	if retried {
+		q.release(cost)
+	} else {
+		q.release(retryQuotaNoRetryIncrease)
+	}
+}
+
+func (q *retryQuota) acquire(cost int) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if cost > q.available {
+		return false
+	}
+
+	q.available -= cost
+
+	return true
+}
+
+func (q *retryQuota) release(amount int) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.available += amount
+
+	if q.available > retryQuotaCapacity {
+		q.available = retryQuotaCapacity
+	}
+}
+
+// tokenBucket limits the rate of requests.
+// Tokens are reserved ahead of time, so that concurrent callers wait in turn.
+type tokenBucket struct {
+	capacity   float64
+	fillRate   float64
+	lastRefill time.Time
+	tokens     float64
+}
+
+func newTokenBucket(fillRate float64, capacity float64, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity:   capacity,
+		fillRate:   fillRate,
+		lastRefill: now,
+		tokens:     capacity,
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.lastRefill).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+elapsed*b.fillRate)
+		b.lastRefill = now
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.fillRate * float64(time.Second))
+}
+
+// adaptiveRate is the client sending rate of a service in adaptive retry mode.
+// The rate is reduced multiplicatively when requests are throttled and recovers along a cubic curve,
+// as in the CUBIC congestion control algorithm.
+type adaptiveRate struct {
+	bucket           *tokenBucket // nil until the first throttled request
+	lastMaxRate      float64
+	lastThrottleTime float64
+	lastTxRateBucket float64
+	measuredTxRate   float64
+	requestCount     int
+	timeWindow       float64
+}
+
+func (a *adaptiveRate) updateMeasuredRate(t float64) {
+	timeBucket := math.Floor(t*2) / 2
+	a.requestCount++
+
+	if timeBucket > a.lastTxRateBucket {
+		currentRate := float64(a.requestCount) / (timeBucket - a.lastTxRateBucket)
+		a.measuredTxRate = currentRate*adaptiveSmooth + a.measuredTxRate*(1-adaptiveSmooth)
+		a.requestCount = 0
+		a.lastTxRateBucket = timeBucket
+	}
+}
+
+func newAdaptiveRate(now time.Time) *adaptiveRate {
+	return &adaptiveRate{
+		lastTxRateBucket: math.Floor(unixSeconds(now)),
+	}
+}
+
+// update updates the sending rate after a response and returns the new rate.
+func (a *adaptiveRate) update(now time.Time, throttled bool) float64 {
+	t := unixSeconds(now)
+
+	a.updateMeasuredRate(t)
+
+	var calculatedRate float64
+
+	if throttled {
+		rateToUse := a.measuredTxRate
+		if a.bucket != nil {
+			rateToUse = math.Min(rateToUse, a.bucket.fillRate)
+		}
+
+		a.lastMaxRate = rateToUse
+		a.timeWindow = math.Cbrt(a.lastMaxRate * (1 - adaptiveBeta) / adaptiveScaleConstant)
+		a.lastThrottleTime = t
+
+		calculatedRate = rateToUse * adaptiveBeta
+
+		if a.bucket == nil {
+			a.bucket = newTokenBucket(adaptiveMinFillRate, adaptiveMinCapacity, now)
+		}
+	} else {
+		if a.bucket == nil {
+			return 0
+		}
+
+		a.timeWindow = math.Cbrt(a.lastMaxRate * (1 - adaptiveBeta) / adaptiveScaleConstant)
+		calculatedRate = adaptiveScaleConstant*math.Pow(t-a.lastThrottleTime-a.timeWindow, 3) + a.lastMaxRate
+	}
+
+	newRate := math.Min(calculatedRate, 2*a.measuredTxRate)
+
+	a.bucket.refill(now)
+	a.bucket.fillRate = math.Max(newRate, adaptiveMinFillRate)
+	a.bucket.capacity = math.Max(newRate, adaptiveMinCapacity)
+	a.bucket.tokens = math.Min(a.bucket.tokens, a.bucket.capacity)
+
+	return a.bucket.fillRate
+}
+
+// requestThrottler applies client-side rate limits and retry mode bookkeeping to requests.
+// A single requestThrottler is shared by the handlers of every service client.
+type requestThrottler struct {
+	adaptive   bool
+	quota      *retryQuota
+	rateLimits map[string]RateLimit
+
+	lock          sync.Mutex
+	adaptiveRates map[string]*adaptiveRate
+	staticBuckets map[string]*tokenBucket
+	now           func() time.Time
+	sleep         func(context.Context, time.Duration) error
+}
+
+func newRequestThrottler(retryMode string, rateLimits map[string]RateLimit, quota *retryQuota) *requestThrottler {
+	return &requestThrottler{
+		adaptive:      retryMode == RetryModeAdaptive,
+		quota:         quota,
+		rateLimits:    rateLimits,
+		adaptiveRates: make(map[string]*adaptiveRate),
+		staticBuckets: make(map[string]*tokenBucket),
+		now:           time.Now,
+		sleep:         aws.SleepWithContext,
+	}
+}
+
+// install adds the throttler's handlers to the handlers of the specified service's client.
+func (t *requestThrottler) install(handlers *request.Handlers, key string) {
+	if t == nil {
+		return
+	}
+
+	if _, ok := t.rateLimits[key]; ok || t.adaptive {
+		handlers.Sign.PushFrontNamed(request.NamedHandler{
+			Name: "tfsdk.RequestThrottler.Wait",
+			Fn: func(r *request.Request) {
+				t.wait(r, key)
+			},
+		})
+	}
+
+	if t.adaptive {
+		handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: "tfsdk.RequestThrottler.UpdateRate",
+			Fn: func(r *request.Request) {
+				t.updateRate(r, key)
+			},
+		})
+	}
+
+	if t.quota != nil {
+		handlers.Complete.PushBackNamed(request.NamedHandler{
+			Name: "tfsdk.RequestThrottler.ReleaseRetryQuota",
+			Fn: func(r *request.Request) {
+				t.quota.complete(r)
+			},
+		})
+	}
+}
+
+// reserve reserves a request to the specified service and returns how long to wait before sending it.
+func (t *requestThrottler) reserve(key string) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+
+	var delay time.Duration
+
+	if rateLimit, ok := t.rateLimits[key]; ok {
+		bucket, ok := t.staticBuckets[key]
+
+		if !ok {
+			burst := float64(rateLimit.Burst)
+			if burst <= 0 {
+				burst = math.Max(math.Ceil(rateLimit.RequestsPerSecond), 1)
+			}
+
+			bucket = newTokenBucket(rateLimit.RequestsPerSecond, burst, now)
+			t.staticBuckets[key] = bucket
+		}
+
+		delay = bucket.reserve(now)
+	}
+
+	if rate, ok := t.adaptiveRates[key]; ok && rate.bucket != nil {
+		if v := rate.bucket.reserve(now); v > delay {
+			delay = v
+		}
+	}
+
+	return delay
+}
+
+func (t *requestThrottler) wait(r *request.Request, key string) {
+	delay := t.reserve(key)
+
+	if delay <= 0 {
+		return
+	}
+
+	log.Printf("[DEBUG] %s/%s: waiting %s for client-side rate limit", r.ClientInfo.ServiceName, r.Operation.Name, delay)
+
+	if err := t.sleep(r.Context(), delay); err != nil {
+		r.Error = err
+	}
+}
+
+func (t *requestThrottler) updateRate(r *request.Request, key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	rate, ok := t.adaptiveRates[key]
+
+	if !ok {
+		rate = newAdaptiveRate(t.now())
+		t.adaptiveRates[key] = rate
+	}
+
+	throttled := r.IsErrorThrottle()
+	newRate := rate.update(t.now(), throttled)
+
+	if throttled {
+		log.Printf("[DEBUG] %s/%s: request throttled, client sending rate limited to %.2f requests/second", r.ClientInfo.ServiceName, r.Operation.Name, newRate)
+	}
+}
+
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+func isErrorTimeout(r *request.Request) bool {
+	if r.Error == nil {
+		return false
+	}
+
+	type timeout interface {
+		Timeout() bool
+	}
+
+	err := r.Error
+
+	if awsErr, ok := err.(interface{ OrigErr() error }); ok && awsErr.OrigErr() != nil {
+		err = awsErr.OrigErr()
+	}
+
+	if err, ok := err.(timeout); ok {
+		return err.Timeout()
+	}
+
+	return false
+}
@@ -0,0 +1,268 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2, 2, now)
+
+	for i, expected := range []time.Duration{0, 0, 500 * time.Millisecond, 1 * time.Second} {
+		if got := bucket.reserve(now); got != expected {
+			t.Errorf("reservation %d: got delay %s, expected %s", i, got, expected)
+		}
+	}
+
+	// Two tokens have been refilled, which repays the two reservations made ahead of time.
+	now = now.Add(1 * time.Second)
+
+	if got, expected := bucket.reserve(now), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s after refill, expected %s", got, expected)
+	}
+
+	// The bucket never holds more than its capacity.
+	now = now.Add(1 * time.Minute)
+
+	for i, expected := range []time.Duration{0, 0, 500 * time.Millisecond} {
+		if got := bucket.reserve(now); got != expected {
+			t.Errorf("reservation %d after idle: got delay %s, expected %s", i, got, expected)
+		}
+	}
+}
+
+func TestRetryQuota(t *testing.T) {
+	quota := newRetryQuota()
+
+	var retries int
+	for quota.acquire(retryQuotaRetryCost) {
+		retries++
+	}
+
+	if got, expected := retries, retryQuotaCapacity/retryQuotaRetryCost; got != expected {
+		t.Errorf("got %d retries from a full quota, expected %d", got, expected)
+	}
+
+	quota.release(retryQuotaRetryCost)
+
+	if !quota.acquire(retryQuotaRetryCost) {
+		t.Error("expected retry after release")
+	}
+
+	quota.release(2 * retryQuotaCapacity)
+
+	if got, expected := quota.available, retryQuotaCapacity; got != expected {
+		t.Errorf("got %d available, expected capacity %d", got, expected)
+	}
+}
+
+func TestRetryQuotaComplete(t *testing.T) {
+	quota := newRetryQuota()
+
+	// A successful retry after a timeout returns the timeout cost.
+	r := &request.Request{}
+
+	if !quota.acquireRetry(r, retryQuotaTimeoutCost) {
+		t.Fatal("expected retry from a full quota")
+	}
+
+	quota.complete(r)
+
+	if got, expected := quota.available, retryQuotaCapacity; got != expected {
+		t.Errorf("got %d available after successful retry, expected %d", got, expected)
+	}
+
+	// A failed retry returns nothing.
+	r = &request.Request{}
+
+	quota.acquireRetry(r, retryQuotaTimeoutCost)
+	r.Error = fmt.Errorf("test error")
+	quota.complete(r)
+
+	if got, expected := quota.available, retryQuotaCapacity-retryQuotaTimeoutCost; got != expected {
+		t.Errorf("got %d available after failed retry, expected %d", got, expected)
+	}
+
+	// A request succeeding without retries returns the fixed no-retry increase.
+	quota.complete(&request.Request{})
+
+	if got, expected := quota.available, retryQuotaCapacity-retryQuotaTimeoutCost+retryQuotaNoRetryIncrease; got != expected {
+		t.Errorf("got %d available after request without retries, expected %d", got, expected)
+	}
+
+	if got := len(quota.retryCost); got != 0 {
+		t.Errorf("got %d retry costs recorded after completion, expected none", got)
+	}
+}
+
+func TestAdaptiveRate(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rate := newAdaptiveRate(now)
+
+	// Send 10 requests per second for 5 seconds.
+	for i := 0; i < 50; i++ {
+		now = now.Add(100 * time.Millisecond)
+
+		if got := rate.update(now, false); got != 0 {
+			t.Fatalf("got sending rate %g before throttling, expected unlimited", got)
+		}
+	}
+
+	now = now.Add(100 * time.Millisecond)
+	throttledRate := rate.update(now, true)
+
+	if throttledRate <= adaptiveMinFillRate || throttledRate >= 10 {
+		t.Fatalf("got sending rate %g after throttling, expected it to be reduced from ~10", throttledRate)
+	}
+
+	if got, expected := throttledRate, rate.lastMaxRate*adaptiveBeta; got != expected {
+		t.Errorf("got sending rate %g after throttling, expected %g", got, expected)
+	}
+
+	// The sending rate recovers towards the rate at which requests were throttled.
+	var recoveredRate float64
+	for i := 0; i < 100; i++ {
+		now = now.Add(100 * time.Millisecond)
+		recoveredRate = rate.update(now, false)
+	}
+
+	if recoveredRate <= throttledRate {
+		t.Errorf("got sending rate %g after recovery, expected more than %g", recoveredRate, throttledRate)
+	}
+}
+
+func TestRequestThrottlerRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+	defer ts.Close()
+
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsEast1RegionID, map[string]string{
+		STS: ts.URL,
+	})
+
+	now := time.Now()
+	var delays []time.Duration
+
+	client.requestThrottler = newRequestThrottler("", map[string]RateLimit{STS: {RequestsPerSecond: 1, Burst: 1}}, nil)
+	client.requestThrottler.now = func() time.Time { return now }
+	client.requestThrottler.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, expected := fmt.Sprint(delays), fmt.Sprint([]time.Duration{1 * time.Second, 2 * time.Second}); got != expected {
+		t.Errorf("got delays %s, expected %s", got, expected)
+	}
+
+	// Other services are not limited.
+	if handlers := client.IAMConn().Handlers.Copy(); handlers.Sign.Swap("tfsdk.RequestThrottler.Wait", request.NamedHandler{}) {
+		t.Error("expected no rate limit handler for IAM")
+	}
+}
+
+func TestRequestThrottlerAdaptive(t *testing.T) {
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+
+		// Throttle every other request.
+		if atomic.AddInt32(&requests, 1)%2 == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, testThrottlingResponse)
+			return
+		}
+
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+	defer ts.Close()
+
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsEast1RegionID, map[string]string{
+		STS: ts.URL,
+	})
+
+	quota := newRetryQuota()
+	client.session = client.session.Copy(request.WithRetryer(&aws.Config{SleepDelay: func(time.Duration) {}}, newRetryer(3, quota)))
+
+	var delays []time.Duration
+
+	client.requestThrottler = newRequestThrottler(RetryModeAdaptive, nil, quota)
+	client.requestThrottler.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, expected := atomic.LoadInt32(&requests), int32(10); got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+
+	if len(delays) == 0 {
+		t.Error("expected requests to be delayed by the client sending rate after throttling")
+	}
+
+	// Each successful retry returns its cost to the quota.
+	if got, expected := quota.available, retryQuotaCapacity; got != expected {
+		t.Errorf("got %d available in retry quota, expected %d", got, expected)
+	}
+}
+
+func TestRetryerQuotaExhausted(t *testing.T) {
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsEast1RegionID, map[string]string{
+		STS: ts.URL,
+	})
+
+	quota := newRetryQuota()
+	quota.available = retryQuotaRetryCost
+
+	client.session = client.session.Copy(request.WithRetryer(&aws.Config{SleepDelay: func(time.Duration) {}}, newRetryer(3, quota)))
+
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, expected := atomic.LoadInt32(&requests), int32(2); got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+}
+
+const testThrottlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
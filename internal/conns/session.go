@@ -0,0 +1,360 @@
+package conns
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/go-homedir"
+)
+
+// getSession returns the AWS Go SDK session for the configuration, with the account ID and partition.
+// The session is created and decorated by awsbase.GetSessionWithAccountIDAndPartition. aws-sdk-go-base
+// does not support the custom CA bundle or the EC2 Instance Metadata Service endpoint, so the credentials
+// are resolved by getCredentials and the session is switched to them and to an HTTP client trusting the custom CA bundle.
+// If web identity credentials are specified, they are used instead of the other credential sources.
+func (c *Config) getSession(awsbaseConfig *awsbase.Config, webIdentityCreds *credentials.Credentials) (*session.Session, string, string, error) {
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	creds, err := c.getCredentials(awsbaseConfig, webIdentityCreds, httpClient)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	value, err := creds.Get()
+
+	if err != nil {
+		return nil, "", "", fmt.Errorf("Error loading credentials for AWS Provider: %w", err)
+	}
+
+	// aws-sdk-go-base is given the current credentials value, so that it neither resolves credentials
+	// nor makes any requests with its own HTTP client.
+	sessionConfig := *awsbaseConfig
+	sessionConfig.AccessKey = value.AccessKeyID
+	sessionConfig.AssumeRoleARN = ""
+	sessionConfig.SecretKey = value.SecretAccessKey
+	sessionConfig.SkipCredsValidation = true
+	sessionConfig.SkipRequestingAccountId = true
+	sessionConfig.Token = value.SessionToken
+
+	sess, _, partition, err := awsbase.GetSessionWithAccountIDAndPartition(&sessionConfig)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	sess = sess.Copy(&aws.Config{
+		Credentials: creds,
+		HTTPClient:  httpClient,
+	})
+
+	switch {
+	case !awsbaseConfig.SkipCredsValidation:
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(sess))
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	case awsbaseConfig.AssumeRoleARN != "":
+		if v, err := arn.Parse(awsbaseConfig.AssumeRoleARN); err == nil {
+			return sess, v.AccountID, v.Partition, nil
+		}
+	case !awsbaseConfig.SkipRequestingAccountId:
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), sts.New(sess), value.ProviderName)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	return sess, "", partition, nil
+}
+
+// getCredentials returns the validated credentials for the configuration, assuming the configured IAM Role, if any.
+// Credentials are looked up in the same order as awsbase.GetCredentials, with the session used to retrieve
+// credentials from the shared configuration files and the EC2 Instance Metadata Service configured like the provider's session.
+// If web identity credentials are specified, the IAM Role is assumed with them.
+func (c *Config) getCredentials(awsbaseConfig *awsbase.Config, webIdentityCreds *credentials.Credentials, httpClient *http.Client) (*credentials.Credentials, error) {
+	creds := webIdentityCreds
+	envCreds := credentials.NewEnvCredentials()
+	_, envErr := envCreds.Get()
+
+	switch {
+	case webIdentityCreds != nil:
+		if _, err := webIdentityCreds.Get(); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityRoleARN, err)
+		}
+
+		log.Printf("[INFO] AWS Auth provider used: %q", stscreds.WebIdentityProviderName)
+	case awsbaseConfig.AccessKey != "" || awsbaseConfig.SecretKey != "":
+		creds = credentials.NewStaticCredentials(awsbaseConfig.AccessKey, awsbaseConfig.SecretKey, awsbaseConfig.Token)
+
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %w", err)
+		}
+
+		log.Printf("[INFO] AWS Auth provider used: %q", credentials.StaticProviderName)
+	case envErr == nil:
+		creds = envCreds
+
+		log.Printf("[INFO] AWS Auth provider used: %q", credentials.EnvProviderName)
+	default:
+		var err error
+		creds, err = c.getCredentialsFromSession(awsbaseConfig)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if awsbaseConfig.AssumeRoleARN == "" {
+		return creds, nil
+	}
+
+	return c.getAssumeRoleCredentials(awsbaseConfig, creds, httpClient)
+}
+
+// getCredentialsFromSession returns credentials derived from a session, which uses the AWS Go SDK chain of
+// providers, including the shared credentials and configuration files and the EC2 Instance Metadata Service.
+func (c *Config) getCredentialsFromSession(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to use session-derived credentials")
+
+	// The HTTP client is not set so that, unless a custom CA bundle is used,
+	// the EC2 Instance Metadata Service client automatically lowers its timeout to 1 second.
+	options := &session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              awsbaseConfig.EndpointResolver(),
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(awsbaseConfig.Region),
+		},
+		Profile:           awsbaseConfig.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	if awsbaseConfig.CredsFilename != "" {
+		sharedCredentialsFilename, err := homedir.Expand(awsbaseConfig.CredsFilename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding shared credentials filename: %w", err)
+		}
+
+		sharedConfigFilename := defaults.SharedConfigFilename()
+
+		if v := os.Getenv("AWS_CONFIG_FILE"); v != "" {
+			sharedConfigFilename = v
+		}
+
+		// Later files take precedence, as when the AWS Go SDK uses its default files.
+		options.SharedConfigFiles = []string{sharedConfigFilename, sharedCredentialsFilename}
+	}
+
+	pem, err := c.customCABundle()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if pem != nil {
+		options.CustomCABundle = bytes.NewReader(pem)
+	}
+
+	if err := c.setEC2MetadataServiceOptions(options); err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSessionWithOptions(*options)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+
+		return nil, fmt.Errorf("Error creating AWS session: %w", err)
+	}
+
+	cp, err := sess.Config.Credentials.Get()
+
+	if err != nil {
+		return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	log.Printf("[INFO] Successfully derived credentials from session")
+	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+
+	return sess.Config.Credentials, nil
+}
+
+// getAssumeRoleCredentials returns the validated credentials of the configured IAM Role, assumed with the specified credentials.
+func (c *Config) getAssumeRoleCredentials(awsbaseConfig *awsbase.Config, creds *credentials.Credentials, httpClient *http.Client) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)",
+		awsbaseConfig.AssumeRoleARN, awsbaseConfig.AssumeRoleSessionName, awsbaseConfig.AssumeRoleExternalID)
+
+	sess, err := session.NewSession(&aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		Credentials:                   creds,
+		EndpointResolver:              awsbaseConfig.EndpointResolver(),
+		HTTPClient:                    httpClient,
+		MaxRetries:                    aws.Int(awsbaseConfig.MaxRetries),
+		Region:                        aws.String(awsbaseConfig.Region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role session: %w", err)
+	}
+
+	assumeRoleCreds := stscreds.NewCredentials(sess, awsbaseConfig.AssumeRoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if awsbaseConfig.AssumeRoleDurationSeconds > 0 {
+			p.Duration = time.Duration(awsbaseConfig.AssumeRoleDurationSeconds) * time.Second
+		}
+
+		if awsbaseConfig.AssumeRoleExternalID != "" {
+			p.ExternalID = aws.String(awsbaseConfig.AssumeRoleExternalID)
+		}
+
+		if awsbaseConfig.AssumeRolePolicy != "" {
+			p.Policy = aws.String(awsbaseConfig.AssumeRolePolicy)
+		}
+
+		for _, policyARN := range awsbaseConfig.AssumeRolePolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
+		}
+
+		if awsbaseConfig.AssumeRoleSessionName != "" {
+			p.RoleSessionName = awsbaseConfig.AssumeRoleSessionName
+		}
+
+		for k, v := range awsbaseConfig.AssumeRoleTags {
+			p.Tags = append(p.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		if len(awsbaseConfig.AssumeRoleTransitiveTagKeys) > 0 {
+			p.TransitiveTagKeys = aws.StringSlice(awsbaseConfig.AssumeRoleTransitiveTagKeys)
+		}
+	})
+
+	if _, err := assumeRoleCreds.Get(); err != nil {
+		return nil, awsbaseConfig.NewCannotAssumeRoleError(err)
+	}
+
+	return assumeRoleCreds, nil
+}
+
+// httpClient returns a new HTTP client for AWS API requests, trusting the custom CA bundle, if any.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	pem, err := c.customCABundle()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if pem != nil {
+		pool, err := x509.SystemCertPool()
+
+		if err != nil {
+			log.Printf("[WARN] Unable to load system certificate pool, trusting only the custom CA bundle: %s", err)
+			pool = x509.NewCertPool()
+		}
+
+		pool.AppendCertsFromPEM(pem)
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	return client, nil
+}
+
+// setEC2MetadataServiceOptions sets the EC2 Instance Metadata Service endpoint options of a session, which configure
+// the EC2 Instance Metadata Service client of its credential chain.
+func (c *Config) setEC2MetadataServiceOptions(options *session.Options) error {
+	if c.EC2MetadataServiceEndpoint != "" {
+		log.Printf("[DEBUG] Using EC2 Instance Metadata Service endpoint: %s", c.EC2MetadataServiceEndpoint)
+		options.EC2IMDSEndpoint = c.EC2MetadataServiceEndpoint
+	}
+
+	if c.EC2MetadataServiceEndpointMode != "" {
+		log.Printf("[DEBUG] Using EC2 Instance Metadata Service endpoint mode: %s", c.EC2MetadataServiceEndpointMode)
+
+		if err := options.EC2IMDSEndpointMode.SetFromString(c.EC2MetadataServiceEndpointMode); err != nil {
+			return fmt.Errorf("parsing EC2 Instance Metadata Service endpoint mode: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// customCABundle returns the PEM encoded certificates of the custom CA bundle, if any.
+func (c *Config) customCABundle() ([]byte, error) {
+	if c.CustomCABundle == "" {
+		return nil, nil
+	}
+
+	path, err := homedir.Expand(c.CustomCABundle)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding custom CA bundle path (%s): %w", c.CustomCABundle, err)
+	}
+
+	pem, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading custom CA bundle (%s): %w", path, err)
+	}
+
+	if !x509.NewCertPool().AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("reading custom CA bundle (%s): no PEM encoded certificates found", path)
+	}
+
+	log.Printf("[DEBUG] Using custom CA bundle: %s", path)
+
+	return pem, nil
+}
@@ -0,0 +1,211 @@
+package conns
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestConfigClientCustomCABundle(t *testing.T) {
+	t.Setenv(EnvVarCABundle, "")
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+	defer ts.Close()
+
+	testCases := []struct {
+		Name           string
+		CustomCABundle string
+		ExpectedError  string
+	}{
+		{
+			Name:          "no custom CA bundle",
+			ExpectedError: "certificate",
+		},
+		{
+			Name:           "custom CA bundle",
+			CustomCABundle: testCABundle(t, ts),
+		},
+		{
+			Name:           "missing custom CA bundle",
+			CustomCABundle: filepath.Join(t.TempDir(), "missing.pem"),
+			ExpectedError:  "reading custom CA bundle",
+		},
+		{
+			Name:           "invalid custom CA bundle",
+			CustomCABundle: testFile(t, "invalid.pem", "not a certificate"),
+			ExpectedError:  "no PEM encoded certificates found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:            "AKIAEXAMPLE",
+				CustomCABundle:       testCase.CustomCABundle,
+				Endpoints:            map[string]string{STS: ts.URL},
+				MaxRetries:           1,
+				Region:               endpoints.UsEast1RegionID,
+				SecretKey:            "secret-access-key",
+				SkipGetEC2Platforms:  true,
+				SkipMetadataApiCheck: true,
+			}
+
+			client, err := config.Client()
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := client.(*AWSClient).AccountID, "123456789012"; got != expected {
+				t.Errorf("got account ID %s, expected %s", got, expected)
+			}
+
+			// The custom CA bundle is only used by the provider's sessions.
+			if v := os.Getenv(EnvVarCABundle); v != "" {
+				t.Errorf("got %s environment variable %q, expected it to be unset", EnvVarCABundle, v)
+			}
+		})
+	}
+}
+
+func TestConfigClientEC2MetadataServiceEndpoint(t *testing.T) {
+	for _, name := range []string{
+		"AWS_ACCESS_KEY", "AWS_ACCESS_KEY_ID", "AWS_CONTAINER_CREDENTIALS_FULL_URI", "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI",
+		"AWS_DEFAULT_PROFILE", "AWS_EC2_METADATA_DISABLED", "AWS_PROFILE", "AWS_ROLE_ARN", "AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY",
+		"AWS_SESSION_TOKEN", "AWS_WEB_IDENTITY_TOKEN_FILE",
+		EnvVarEC2MetadataServiceEndpoint, EnvVarEC2MetadataServiceEndpointMode,
+	} {
+		t.Setenv(name, "")
+	}
+
+	missingFile := filepath.Join(t.TempDir(), "missing")
+	t.Setenv("AWS_CONFIG_FILE", missingFile)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", missingFile)
+
+	var requests int32
+
+	// The EC2 Instance Metadata Service is only available over HTTP.
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		switch r.URL.Path {
+		case "/latest/api/token":
+			fmt.Fprint(w, "imds-token")
+		case "/latest/meta-data/iam/security-credentials/":
+			fmt.Fprint(w, "test-role")
+		case "/latest/meta-data/iam/security-credentials/test-role":
+			fmt.Fprintf(w, testEC2MetadataCredentialsResponse, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := &Config{
+		CredsFilename:                  missingFile,
+		EC2MetadataServiceEndpoint:     ts.URL,
+		EC2MetadataServiceEndpointMode: "IPv4",
+		MaxRetries:                     1,
+		Region:                         endpoints.UsEast1RegionID,
+		SkipCredsValidation:            true,
+		SkipGetEC2Platforms:            true,
+		SkipRequestingAccountId:        true,
+	}
+
+	client, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	value, err := client.(*AWSClient).session.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error retrieving credentials: %s", err)
+	}
+
+	if got, expected := value.ProviderName, ec2rolecreds.ProviderName; got != expected {
+		t.Errorf("got credentials provider %s, expected %s", got, expected)
+	}
+
+	if got, expected := value.AccessKeyID, "ASIAIMDSEXAMPLE"; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+
+	if atomic.LoadInt32(&requests) == 0 {
+		t.Error("expected requests to the EC2 Instance Metadata Service endpoint, got none")
+	}
+
+	// The EC2 Instance Metadata Service endpoint is only used by the provider's sessions.
+	for _, name := range []string{EnvVarEC2MetadataServiceEndpoint, EnvVarEC2MetadataServiceEndpointMode} {
+		if v := os.Getenv(name); v != "" {
+			t.Errorf("got %s environment variable %q, expected it to be unset", name, v)
+		}
+	}
+}
+
+// testCABundle writes the certificate of the specified TLS server to a PEM encoded file and returns its path.
+func testCABundle(t *testing.T, ts *httptest.Server) string {
+	t.Helper()
+
+	return testFile(t, "ca-bundle.pem", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})))
+}
+
+func testFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// lintignore:AWSAT005
+const testGetCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDAEXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+const testEC2MetadataCredentialsResponse = `{
+  "Code": "Success",
+  "LastUpdated": "2021-01-01T00:00:00Z",
+  "Type": "AWS-HMAC",
+  "AccessKeyId": "ASIAIMDSEXAMPLE",
+  "SecretAccessKey": "secret-access-key",
+  "Token": "session-token",
+  "Expiration": "%s"
+}`
@@ -0,0 +1,25 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+)
+
+// HTTPTransportWrapper wraps the HTTP transport of AWS API requests,
+// e.g. to record and replay the requests of acceptance tests.
+type HTTPTransportWrapper func(http.RoundTripper) http.RoundTripper
+
+type httpTransportWrapperKey struct{}
+
+// ContextWithHTTPTransportWrapper returns a copy of the context carrying the HTTP transport wrapper
+// used by the provider when it is configured with the context.
+func ContextWithHTTPTransportWrapper(ctx context.Context, wrapper HTTPTransportWrapper) context.Context {
+	return context.WithValue(ctx, httpTransportWrapperKey{}, wrapper)
+}
+
+// HTTPTransportWrapperFromContext returns the HTTP transport wrapper carried by the context, if any.
+func HTTPTransportWrapperFromContext(ctx context.Context) HTTPTransportWrapper {
+	wrapper, _ := ctx.Value(httpTransportWrapperKey{}).(HTTPTransportWrapper)
+
+	return wrapper
+}
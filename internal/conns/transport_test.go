@@ -0,0 +1,106 @@
+package conns
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type stubTransport struct {
+	next     http.RoundTripper
+	requests []*http.Request
+}
+
+func (t *stubTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, r)
+
+	//lintignore:AWSAT005
+	body := `<GetCallerIdentityResponse>
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::111122223333:user/test</Arn>
+    <UserId>AIDATEST</UserId>
+    <Account>111122223333</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>00000000-0000-0000-0000-000000000000</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+	return &http.Response{
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Request:    r,
+		StatusCode: http.StatusOK,
+	}, nil
+}
+
+func TestConfigClientHTTPTransportWrapper(t *testing.T) {
+	transport := &stubTransport{}
+
+	config := &Config{
+		AccessKey: "StaticAccessKey",
+		HTTPTransportWrapper: func(next http.RoundTripper) http.RoundTripper {
+			transport.next = next
+			return transport
+		},
+		Region:               "us-west-2", //lintignore:AWSAT003
+		SecretKey:            "StaticSecretKey",
+		SkipGetEC2Platforms:  true,
+		SkipMetadataApiCheck: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, want := client.AccountID, "111122223333"; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+
+	if got, want := client.Partition, "aws"; got != want {
+		t.Errorf("Partition = %q, want %q", got, want)
+	}
+
+	if transport.next == nil {
+		t.Error("wrapped transport is nil")
+	}
+
+	if got, want := len(transport.requests), 1; got != want {
+		t.Fatalf("requests = %d, want %d", got, want)
+	}
+
+	if got, want := transport.requests[0].URL.Host, "sts.amazonaws.com"; got != want {
+		t.Errorf("request host = %q, want %q", got, want)
+	}
+}
+
+func TestHTTPTransportWrapperFromContext(t *testing.T) {
+	if wrapper := HTTPTransportWrapperFromContext(context.Background()); wrapper != nil {
+		t.Error("expected no wrapper")
+	}
+
+	var wrapped bool
+
+	ctx := ContextWithHTTPTransportWrapper(context.Background(), func(next http.RoundTripper) http.RoundTripper {
+		wrapped = true
+		return next
+	})
+
+	wrapper := HTTPTransportWrapperFromContext(ctx)
+
+	if wrapper == nil {
+		t.Fatal("expected wrapper")
+	}
+
+	wrapper(http.DefaultTransport)
+
+	if !wrapped {
+		t.Error("expected wrapper to be called")
+	}
+}
@@ -0,0 +1,147 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+// webIdentityToken is a TokenFetcher for a web identity token configured inline.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// webIdentityRoleProvider retrieves credentials by calling STS AssumeRoleWithWebIdentity.
+// It is equivalent to stscreds.WebIdentityRoleProvider with the addition of an inline session policy.
+type webIdentityRoleProvider struct {
+	credentials.Expiry
+
+	client          stsiface.STSAPI
+	duration        time.Duration
+	policy          string
+	policyARNs      []string
+	roleARN         string
+	roleSessionName string
+	tokenFetcher    stscreds.TokenFetcher
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+func (p *webIdentityRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	token, err := p.tokenFetcher.FetchToken(ctx)
+
+	if err != nil {
+		return credentials.Value{}, awserr.New(stscreds.ErrCodeWebIdentity, "failed fetching WebIdentity token: ", err)
+	}
+
+	sessionName := p.roleSessionName
+
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.roleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(string(token)),
+	}
+
+	if p.duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.duration / time.Second))
+	}
+
+	if p.policy != "" {
+		input.Policy = aws.String(p.policy)
+	}
+
+	for _, policyARN := range p.policyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	req, output := p.client.AssumeRoleWithWebIdentityRequest(input)
+	req.SetContext(ctx)
+
+	// InvalidIdentityToken can be returned transiently by STS when the identity provider is slow to respond.
+	req.RetryErrorCodes = append(req.RetryErrorCodes, sts.ErrCodeInvalidIdentityTokenException)
+
+	if err := req.Send(); err != nil {
+		return credentials.Value{}, awserr.New(stscreds.ErrCodeWebIdentity, "failed to retrieve credentials", err)
+	}
+
+	// Refresh the credentials shortly before they expire to avoid requests being made with expired credentials.
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), 1*time.Minute)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    stscreds.WebIdentityProviderName,
+	}, nil
+}
+
+// webIdentityCredentials returns credentials for the IAM Role configured to be assumed with a web identity token.
+// Returns nil if no such IAM Role is configured.
+func (c *Config) webIdentityCredentials() (*credentials.Credentials, error) {
+	if c.AssumeRoleWithWebIdentityRoleARN == "" {
+		return nil, nil
+	}
+
+	if c.AccessKey != "" || c.SecretKey != "" {
+		return nil, fmt.Errorf("assuming IAM Role (%s) with web identity: access_key and secret_key cannot be set", c.AssumeRoleWithWebIdentityRoleARN)
+	}
+
+	var tokenFetcher stscreds.TokenFetcher
+
+	switch {
+	case c.AssumeRoleWithWebIdentityToken != "":
+		tokenFetcher = webIdentityToken(c.AssumeRoleWithWebIdentityToken)
+	case c.AssumeRoleWithWebIdentityTokenFile != "":
+		tokenFetcher = stscreds.FetchTokenPath(c.AssumeRoleWithWebIdentityTokenFile)
+	default:
+		return nil, fmt.Errorf("assuming IAM Role (%s) with web identity: one of web_identity_token or web_identity_token_file must be set", c.AssumeRoleWithWebIdentityRoleARN)
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", c.AssumeRoleWithWebIdentityRoleARN, c.AssumeRoleWithWebIdentitySessionName)
+
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	sess, err := NewSessionForRegion(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.stsEndpoint()),
+		HTTPClient:  httpClient,
+		MaxRetries:  aws.Int(c.MaxRetries),
+	}, c.Region, c.TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role with web identity session: %w", err)
+	}
+
+	return credentials.NewCredentials(&webIdentityRoleProvider{
+		client:          sts.New(sess),
+		duration:        time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second,
+		policy:          c.AssumeRoleWithWebIdentityPolicy,
+		policyARNs:      c.AssumeRoleWithWebIdentityPolicyARNs,
+		roleARN:         c.AssumeRoleWithWebIdentityRoleARN,
+		roleSessionName: c.AssumeRoleWithWebIdentitySessionName,
+		tokenFetcher:    tokenFetcher,
+	}), nil
+}
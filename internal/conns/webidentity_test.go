@@ -0,0 +1,281 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigWebIdentityCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := os.WriteFile(tokenFile, []byte("file-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name           string
+		Config         *Config
+		ExpectedNil    bool
+		ExpectedError  bool
+		ExpectedParams url.Values
+	}{
+		{
+			Name:        "no role",
+			Config:      &Config{},
+			ExpectedNil: true,
+		},
+		{
+			Name: "no token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "static credentials",
+			Config: &Config{
+				AccessKey:                          "AKIAEXAMPLE",
+				AssumeRoleWithWebIdentityRoleARN:   "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				AssumeRoleWithWebIdentityTokenFile: tokenFile,
+				SecretKey:                          "secret-key",
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				AssumeRoleWithWebIdentitySessionName: "test-session",
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+			},
+			ExpectedParams: url.Values{
+				"RoleArn":          []string{"arn:aws:iam::123456789012:role/test"}, //lintignore:AWSAT005
+				"RoleSessionName":  []string{"test-session"},
+				"WebIdentityToken": []string{"file-token"},
+			},
+		},
+		{
+			Name: "inline token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityDurationSeconds: 1800,
+				AssumeRoleWithWebIdentityPolicy:          `{"Version":"2012-10-17"}`,
+				AssumeRoleWithWebIdentityPolicyARNs:      []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+				AssumeRoleWithWebIdentityRoleARN:         "arn:aws:iam::123456789012:role/test",              //lintignore:AWSAT005
+				AssumeRoleWithWebIdentitySessionName:     "test-session",
+				AssumeRoleWithWebIdentityToken:           "inline-token",
+				AssumeRoleWithWebIdentityTokenFile:       tokenFile,
+			},
+			ExpectedParams: url.Values{
+				"DurationSeconds":         []string{"1800"},
+				"Policy":                  []string{`{"Version":"2012-10-17"}`},
+				"PolicyArns.member.1.arn": []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+				"RoleArn":                 []string{"arn:aws:iam::123456789012:role/test"},    //lintignore:AWSAT005
+				"RoleSessionName":         []string{"test-session"},
+				"WebIdentityToken":        []string{"inline-token"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var params url.Values
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				params = r.PostForm
+
+				if r.Header.Get("Authorization") != "" {
+					t.Errorf("expected unsigned request, got Authorization header")
+				}
+
+				w.Header().Set("Content-Type", "text/xml")
+				fmt.Fprintf(w, testAssumeRoleWithWebIdentityResponse, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+			}))
+			defer ts.Close()
+
+			testCase.Config.Endpoints = map[string]string{STS: ts.URL}
+			testCase.Config.Region = endpoints.UsEast1RegionID
+
+			creds, err := testCase.Config.webIdentityCredentials()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedNil {
+				if creds != nil {
+					t.Fatalf("expected no credentials, got %#v", creds)
+				}
+
+				return
+			}
+
+			value, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error retrieving credentials: %s", err)
+			}
+
+			if got, expected := value.AccessKeyID, "ASIAEXAMPLE"; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+
+			if got, expected := value.SessionToken, "session-token"; got != expected {
+				t.Errorf("got session token %s, expected %s", got, expected)
+			}
+
+			for k, expected := range testCase.ExpectedParams {
+				if got := params.Get(k); got != expected[0] {
+					t.Errorf("got request parameter %s value %q, expected %q", k, got, expected[0])
+				}
+			}
+		})
+	}
+}
+
+func TestConfigGetCredentialsWebIdentityAssumeRole(t *testing.T) {
+	var webIdentityRequests, assumeRoleRequests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		expiration := time.Now().Add(1 * time.Hour).UTC().Format(time.RFC3339)
+
+		switch action := r.PostForm.Get("Action"); action {
+		case "AssumeRoleWithWebIdentity":
+			atomic.AddInt32(&webIdentityRequests, 1)
+			fmt.Fprintf(w, testAssumeRoleWithWebIdentityResponse, expiration)
+		case "AssumeRole":
+			atomic.AddInt32(&assumeRoleRequests, 1)
+
+			// The IAM Role is assumed with the web identity credentials.
+			if got, expected := r.Header.Get("Authorization"), "Credential=ASIAEXAMPLE/"; !strings.Contains(got, expected) {
+				t.Errorf("got Authorization header %q, expected it to contain %q", got, expected)
+			}
+
+			fmt.Fprintf(w, testAssumeRoleWebIdentityChainResponse, expiration)
+		default:
+			t.Errorf("unexpected action %s", action)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c := &Config{
+		AssumeRoleARN:                    "arn:aws:iam::123456789012:role/assumed", //lintignore:AWSAT005
+		AssumeRoleWithWebIdentityRoleARN: "arn:aws:iam::123456789012:role/test",    //lintignore:AWSAT005
+		AssumeRoleWithWebIdentityToken:   "inline-token",
+		Endpoints:                        map[string]string{STS: ts.URL},
+		Region:                           endpoints.UsEast1RegionID,
+	}
+
+	webIdentityCreds, err := c.webIdentityCredentials()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	awsbaseConfig := &awsbase.Config{
+		AssumeRoleARN: c.AssumeRoleARN,
+		Region:        c.Region,
+		StsEndpoint:   c.stsEndpoint(),
+	}
+
+	creds, err := c.getCredentials(awsbaseConfig, webIdentityCreds, httpClient)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	value, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error retrieving credentials: %s", err)
+	}
+
+	if got, expected := value.AccessKeyID, "ASIAASSUMED"; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+
+	// Once expired, the assumed IAM Role's credentials are refreshed using refreshed web identity credentials.
+	webIdentityCreds.Expire()
+	creds.Expire()
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("unexpected error refreshing credentials: %s", err)
+	}
+
+	if got, expected := atomic.LoadInt32(&webIdentityRequests), int32(2); got != expected {
+		t.Errorf("got %d AssumeRoleWithWebIdentity requests, expected %d", got, expected)
+	}
+
+	if got, expected := atomic.LoadInt32(&assumeRoleRequests), int32(2); got != expected {
+		t.Errorf("got %d AssumeRole requests, expected %d", got, expected)
+	}
+}
+
+// lintignore:AWSAT005
+const testAssumeRoleWebIdentityChainResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/assumed/session</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>ASIAASSUMED</AccessKeyId>
+      <SecretAccessKey>assumed-secret-access-key</SecretAccessKey>
+      <SessionToken>assumed-session-token</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>c6104cbe-af31-11e0-8154-cbc7ccf896c7</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+const testAssumeRoleWithWebIdentityResponse = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>secret-access-key</SecretAccessKey>
+      <SessionToken>session-token</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>ad4156e9-bce1-11e2-82e6-6b6efEXAMPLE</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
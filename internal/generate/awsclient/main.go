@@ -0,0 +1,265 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	filename = "awsclient_gen.go"
+
+	sdkModule = "github.com/aws/aws-sdk-go"
+)
+
+type ServiceDatum struct {
+	ClientType        string
+	ImportAlias       bool
+	ImportPath        string
+	Key               string
+	Package           string
+	ProviderNameUpper string
+}
+
+type TemplateData struct {
+	Services []ServiceDatum
+}
+
+func main() {
+	log.SetFlags(0)
+
+	services, err := readServiceData("conns.go")
+
+	if err != nil {
+		log.Fatalf("error reading service data: %s", err)
+	}
+
+	sdkDir, err := moduleDir(sdkModule)
+
+	if err != nil {
+		log.Fatalf("error locating AWS Go SDK: %s", err)
+	}
+
+	for i, service := range services {
+		clientType, err := clientTypeName(filepath.Join(sdkDir, strings.TrimPrefix(service.ImportPath, sdkModule)))
+
+		if err != nil {
+			log.Fatalf("error reading client type of %s: %s", service.ImportPath, err)
+		}
+
+		services[i].ClientType = clientType
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].ProviderNameUpper < services[j].ProviderNameUpper
+	})
+
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("awsclient").Parse(awsClientTemplate))
+
+	if err := tmpl.Execute(&buf, TemplateData{Services: services}); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated source: %s", err)
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// readServiceData returns the services registered in serviceData by the init function in the specified file.
+// The AWS Go SDK package of each service is taken from the package-qualified New call in its NewConn function.
+func readServiceData(filename string) ([]ServiceDatum, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make(map[string]string)
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		imports[name] = path
+	}
+
+	var services []ServiceDatum
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+
+		index, ok := assign.Lhs[0].(*ast.IndexExpr)
+
+		if !ok || !isIdent(index.X, "serviceData") {
+			return true
+		}
+
+		key, ok := index.Index.(*ast.Ident)
+
+		if !ok {
+			return true
+		}
+
+		unary, ok := assign.Rhs[0].(*ast.UnaryExpr)
+
+		if !ok {
+			return true
+		}
+
+		lit, ok := unary.X.(*ast.CompositeLit)
+
+		if !ok {
+			return true
+		}
+
+		service := ServiceDatum{Key: key.Name}
+
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+
+			if !ok {
+				continue
+			}
+
+			switch {
+			case isIdent(kv.Key, "ProviderNameUpper"):
+				if v, ok := kv.Value.(*ast.BasicLit); ok {
+					service.ProviderNameUpper, _ = strconv.Unquote(v.Value)
+				}
+			case isIdent(kv.Key, "NewConn"):
+				service.Package = newConnPackage(kv.Value)
+			}
+		}
+
+		service.ImportPath = imports[service.Package]
+		service.ImportAlias = service.Package != filepath.Base(service.ImportPath)
+
+		services = append(services, service)
+
+		return false
+	})
+
+	for _, service := range services {
+		if service.ProviderNameUpper == "" || service.Package == "" || service.ImportPath == "" {
+			return nil, fmt.Errorf("incomplete service data for %s", service.Key)
+		}
+	}
+
+	return services, nil
+}
+
+// newConnPackage returns the name of the package whose New function is called by a NewConn function literal.
+func newConnPackage(expr ast.Expr) string {
+	var pkg string
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "New" {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				pkg = ident.Name
+			}
+		}
+
+		return false
+	})
+
+	return pkg
+}
+
+// clientTypeName returns the name of the type returned by the New function of the AWS Go SDK service package in dir.
+func clientTypeName(dir string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(dir, "service.go"), nil, 0)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+
+		if !ok || funcDecl.Recv != nil || funcDecl.Name.Name != "New" || funcDecl.Type.Results == nil || len(funcDecl.Type.Results.List) != 1 {
+			continue
+		}
+
+		if star, ok := funcDecl.Type.Results.List[0].Type.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok {
+				return ident.Name, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("New function not found")
+}
+
+// moduleDir returns the directory of the specified module in the module cache.
+func moduleDir(module string) (string, error) {
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", module).Output()
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == name
+}
+
+var awsClientTemplate = `// Code generated by internal/generate/awsclient/main.go; DO NOT EDIT.
+
+package conns
+
+import (
+{{- range .Services }}
+	{{ if .ImportAlias }}{{ .Package }} {{ end }}"{{ .ImportPath }}"
+{{- end }}
+)
+{{ range .Services }}
+func (client *AWSClient) {{ .ProviderNameUpper }}Conn() *{{ .Package }}.{{ .ClientType }} {
+	return client.conn({{ .Key }}).(*{{ .Package }}.{{ .ClientType }})
+}
+{{ end -}}
+`
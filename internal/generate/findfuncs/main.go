@@ -0,0 +1,866 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	op               = flag.String("Op", "", "name of the AWS Go SDK function returning the objects")
+	name             = flag.String("Name", "", "name of the object type, used in the generated function names")
+	outputField      = flag.String("OutputField", "", "name of the output field containing the objects, defaults to <Name>s")
+	inputIDField     = flag.String("InputIDField", "", "name of the input field to set to the ID in the ByID function")
+	idField          = flag.String("IDField", "", "name of the object field to compare to the ID in the ByID function")
+	notFoundErrCodes = flag.String("NotFoundErrCodes", "", "comma-separated list of 'NotFound' error codes or names of constants declared in the service or AWS Go SDK package")
+	paginator        = flag.String("Paginator", "NextToken", "name of the pagination token field")
+	export           = flag.Bool("Export", true, "whether to export the find functions")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *op == "" || *name == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *idField != "" && *inputIDField == "" {
+		log.Fatalf("-IDField requires -InputIDField")
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	awsService, err := awsServiceName(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	g := Generator{
+		tmpl: template.Must(template.New("function").Parse(functionTemplate)),
+	}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+	g.parsePackage(sourcePackage)
+	g.parseDestinationConsts(wd)
+
+	spec := g.funcSpec()
+
+	g.printHeader(HeaderInfo{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: servicePackage,
+		SourcePackage:      sourcePackage,
+		AWS:                spec.InputIDField != "" || (!spec.Pages && spec.Paginator != ""),
+		Resource:           spec.InputIDField != "" || len(spec.NotFoundErrCodes) > 0,
+		TFAWSErr:           len(spec.NotFoundErrCodes) > 0,
+	})
+
+	err = g.tmpl.Execute(&g.buf, spec)
+
+	if err != nil {
+		log.Fatalf("error writing functions: %s", err)
+	}
+
+	src := g.format()
+	filename := fmt.Sprintf("find_%s_gen.go", toSnakeCase(*name))
+
+	err = os.WriteFile(filename, src, 0644)
+	if err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+type HeaderInfo struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+
+	AWS      bool
+	Resource bool
+	TFAWSErr bool
+}
+
+type Generator struct {
+	buf        bytes.Buffer
+	pkg        *Package
+	destConsts map[string]bool
+	tmpl       *template.Template
+}
+
+type Package struct {
+	name   string
+	consts map[string]bool
+	funcs  map[string]*ast.FuncDecl
+	types  map[string]*ast.StructType
+}
+
+func (g *Generator) printHeader(headerInfo HeaderInfo) {
+	header := template.Must(template.New("header").Parse(headerTemplate))
+	err := header.Execute(&g.buf, headerInfo)
+	if err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+}
+
+func (g *Generator) parsePackage(sourcePackage string) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+	g.addPackage(pkgs[0])
+}
+
+// parseDestinationConsts records the names of the constants declared in the non-test files of the destination package.
+func (g *Generator) parseDestinationConsts(dir string) {
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, filter, 0)
+
+	if err != nil {
+		log.Fatalf("error parsing destination package: %s", err)
+	}
+
+	g.destConsts = make(map[string]bool)
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			addConsts(g.destConsts, file)
+		}
+	}
+}
+
+func addConsts(consts map[string]bool, file *ast.File) {
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.CONST {
+			for _, spec := range decl.Specs {
+				for _, ident := range spec.(*ast.ValueSpec).Names {
+					consts[ident.Name] = true
+				}
+			}
+		}
+	}
+}
+
+func (g *Generator) addPackage(pkg *packages.Package) {
+	g.pkg = &Package{
+		name:   pkg.Name,
+		consts: make(map[string]bool),
+		funcs:  make(map[string]*ast.FuncDecl),
+		types:  make(map[string]*ast.StructType),
+	}
+
+	for _, file := range pkg.Syntax {
+		addConsts(g.pkg.consts, file)
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				// Only client methods, e.g. (*EC2).DescribeSecurityGroups.
+				if decl.Recv != nil {
+					g.pkg.funcs[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+						if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+							g.pkg.types[typeSpec.Name.Name] = structType
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+type FuncSpec struct {
+	Name       string
+	PluralName string
+	TypeName   string
+
+	AWSName    string
+	RecvType   string
+	InputType  string
+	ResultType string
+	ElemType   string
+
+	OutputField      string
+	Pages            bool
+	Paginator        string
+	InputIDField     string
+	InputIDSlice     bool
+	IDField          string
+	NotFoundErrCodes []string
+}
+
+func (g *Generator) funcSpec() FuncSpec {
+	function, ok := g.pkg.funcs[*op]
+
+	if !ok {
+		log.Fatalf("function \"%s\" not found", *op)
+	}
+
+	funcName := fmt.Sprintf("Find%s", *name)
+
+	if !*export {
+		funcName = fmt.Sprintf("find%s", *name)
+	}
+
+	spec := FuncSpec{
+		Name:         funcName,
+		PluralName:   fmt.Sprintf("%ss", funcName),
+		TypeName:     *name,
+		AWSName:      *op,
+		RecvType:     g.expandTypeField(function.Recv),
+		InputType:    g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
+		ResultType:   g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
+		OutputField:  *outputField,
+		InputIDField: *inputIDField,
+		IDField:      *idField,
+	}
+
+	if spec.OutputField == "" {
+		spec.OutputField = fmt.Sprintf("%ss", *name)
+	}
+
+	if *notFoundErrCodes != "" {
+		for _, code := range strings.Split(*notFoundErrCodes, ",") {
+			spec.NotFoundErrCodes = append(spec.NotFoundErrCodes, g.errCodeExpr(code))
+		}
+	}
+
+	output := g.structType(spec.ResultType)
+	outputFieldType := g.fieldType(output, spec.OutputField)
+
+	if outputFieldType == nil {
+		log.Fatalf("output field \"%s\" not found", spec.OutputField)
+	}
+
+	arrayType, ok := outputFieldType.(*ast.ArrayType)
+
+	if !ok {
+		log.Fatalf("output field \"%s\" is not a slice", spec.OutputField)
+	}
+
+	spec.ElemType = g.expandType(arrayType.Elt)
+
+	// Prefer the SDK's paginated function, otherwise paginate using the token field when the output has one.
+	if _, ok := g.pkg.funcs[fmt.Sprintf("%sPagesWithContext", *op)]; ok {
+		spec.Pages = true
+	} else if g.fieldType(output, *paginator) != nil {
+		spec.Paginator = *paginator
+	}
+
+	if spec.InputIDField != "" {
+		inputIDFieldType := g.fieldType(g.structType(spec.InputType), spec.InputIDField)
+
+		if inputIDFieldType == nil {
+			log.Fatalf("input field \"%s\" not found", spec.InputIDField)
+		}
+
+		_, spec.InputIDSlice = inputIDFieldType.(*ast.ArrayType)
+	}
+
+	spec.InputType = strings.TrimPrefix(spec.InputType, "*")
+
+	return spec
+}
+
+// errCodeExpr returns the Go expression for an error code, which is either the name of a constant
+// declared in the destination package, e.g. InvalidGroupNotFound, the name of a constant declared
+// in the AWS Go SDK package, e.g. s3.ErrCodeNoSuchBucket, or else the error code itself.
+func (g *Generator) errCodeExpr(code string) string {
+	if g.destConsts[code] {
+		return code
+	}
+
+	if name := strings.TrimPrefix(code, g.pkg.name+"."); name != code && g.pkg.consts[name] {
+		return code
+	}
+
+	return strconv.Quote(code)
+}
+
+func (g *Generator) structType(typeName string) *ast.StructType {
+	typeName = strings.TrimPrefix(typeName, fmt.Sprintf("*%s.", g.pkg.name))
+	structType, ok := g.pkg.types[typeName]
+
+	if !ok {
+		log.Fatalf("type \"%s\" not found", typeName)
+	}
+
+	return structType
+}
+
+func (g *Generator) fieldType(structType *ast.StructType, fieldName string) ast.Expr {
+	for _, field := range structType.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == fieldName {
+				return field.Type
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) expandTypeField(field *ast.FieldList) string {
+	return g.expandType(field.List[0].Type)
+}
+
+func (g *Generator) expandType(typeValue ast.Expr) string {
+	if star, ok := typeValue.(*ast.StarExpr); ok {
+		return fmt.Sprintf("*%s", g.expandTypeExpr(star.X))
+	}
+
+	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", typeValue)
+	return ""
+}
+
+func (g *Generator) expandTypeExpr(expr ast.Expr) string {
+	if ident, ok := expr.(*ast.Ident); ok {
+		return fmt.Sprintf("%s.%s", g.pkg.name, ident.Name)
+	}
+
+	log.Fatalf("Unexpected expression: (%[1]T) %[1]v", expr)
+	return ""
+}
+
+const headerTemplate = `// Code generated by "internal/generate/findfuncs/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"context"
+{{ if .AWS }}
+	"github.com/aws/aws-sdk-go/aws"
+	{{- end }}
+	"{{ .SourcePackage }}"
+	{{- if .TFAWSErr }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	{{- end }}
+	{{- if .Resource }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	{{- end }}
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+`
+
+const functionTemplate = `
+{{- define "notFound" }}
+{{- if .NotFoundErrCodes }}
+
+	if {{ range $i, $code := .NotFoundErrCodes }}{{ if $i }} || {{ end }}tfawserr.ErrCodeEquals(err, {{ $code }}){{ end }} {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{- end }}
+{{- end }}
+
+{{- if .InputIDField }}
+
+// {{ .Name }}ByID looks up a {{ .TypeName }} by ID. Returns a resource.NotFoundError if not found.
+func {{ .Name }}ByID(conn {{ .RecvType }}, id string) ({{ .ElemType }}, error) {
+	return {{ .Name }}ByIDWithContext(context.Background(), conn, id)
+}
+
+// {{ .Name }}ByIDWithContext is the same as {{ .Name }}ByID with the addition of the ability to pass a context.
+func {{ .Name }}ByIDWithContext(ctx context.Context, conn {{ .RecvType }}, id string) ({{ .ElemType }}, error) {
+	input := &{{ .InputType }}{
+		{{ .InputIDField }}: {{ if .InputIDSlice }}aws.StringSlice([]string{id}){{ else }}aws.String(id){{ end }},
+	}
+
+	output, err := {{ .Name }}WithContext(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+{{- if .IDField }}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.{{ .IDField }}) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+{{- end }}
+
+	return output, nil
+}
+{{- end }}
+
+// {{ .Name }} looks up a {{ .TypeName }} using the specified {{ .InputType }}. Returns a resource.NotFoundError if not found.
+func {{ .Name }}(conn {{ .RecvType }}, input *{{ .InputType }}) ({{ .ElemType }}, error) {
+	return {{ .Name }}WithContext(context.Background(), conn, input)
+}
+
+// {{ .Name }}WithContext is the same as {{ .Name }} with the addition of the ability to pass a context.
+func {{ .Name }}WithContext(ctx context.Context, conn {{ .RecvType }}, input *{{ .InputType }}) ({{ .ElemType }}, error) {
+	output, err := {{ .PluralName }}WithContext(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// {{ .PluralName }} returns all {{ .TypeName }}s matching the specified {{ .InputType }}.
+func {{ .PluralName }}(conn {{ .RecvType }}, input *{{ .InputType }}) ([]{{ .ElemType }}, error) {
+	return {{ .PluralName }}WithContext(context.Background(), conn, input)
+}
+
+// {{ .PluralName }}WithContext is the same as {{ .PluralName }} with the addition of the ability to pass a context.
+func {{ .PluralName }}WithContext(ctx context.Context, conn {{ .RecvType }}, input *{{ .InputType }}) ([]{{ .ElemType }}, error) {
+	var output []{{ .ElemType }}
+{{- if .Pages }}
+
+	err := conn.{{ .AWSName }}PagesWithContext(ctx, input, func(page {{ .ResultType }}, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .OutputField }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+{{- template "notFound" . }}
+
+	if err != nil {
+		return nil, err
+	}
+{{- else if .Paginator }}
+
+	for {
+		page, err := conn.{{ .AWSName }}WithContext(ctx, input)
+{{- template "notFound" . }}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.{{ .OutputField }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.{{ .Paginator }}) == "" {
+			break
+		}
+
+		input.{{ .Paginator }} = page.{{ .Paginator }}
+	}
+{{- else }}
+
+	page, err := conn.{{ .AWSName }}WithContext(ctx, input)
+{{- template "notFound" . }}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if page == nil {
+		return output, nil
+	}
+
+	for _, v := range page.{{ .OutputField }} {
+		if v != nil {
+			output = append(output, v)
+		}
+	}
+{{- end }}
+
+	return output, nil
+}
+`
+
+func (g *Generator) format() []byte {
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		return g.buf.Bytes()
+	}
+	return src
+}
+
+func toSnakeCase(str string) string {
+	result := regexp.MustCompile("(.)([A-Z][a-z]+)").ReplaceAllString(str, "${1}_${2}")
+	result = regexp.MustCompile("([a-z0-9])([A-Z])").ReplaceAllString(result, "${1}_${2}")
+	return strings.ToLower(result)
+}
+
+func awsServiceName(s string) (string, error) {
+	s = strings.ToLower(s)
+
+	if _, ok := awsServiceNames[s]; ok {
+		return s, nil
+	}
+
+	switch s {
+	case "cloudcontrol":
+		return "cloudcontrolapi", nil
+	case "cognitoidp":
+		return "cognitoidentityprovider", nil
+	case "dms":
+		return "databasemigrationservice", nil
+	case "ds":
+		return "directoryservice", nil
+	case "resourcegroupstagging":
+		return "resourcegroupstaggingapi", nil
+	case "serverlessapprepo":
+		return "serverlessapplicationrepository", nil
+	}
+
+	if _, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
+		return fmt.Sprintf("%sservice", s), nil
+	}
+
+	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+}
+
+// awsServiceNames provides correct names and capitalization as used by AWS in client var
+var awsServiceNames map[string]string
+
+func init() {
+	awsServiceNames = make(map[string]string)
+
+	awsServiceNames["accessanalyzer"] = "AccessAnalyzer"
+	awsServiceNames["acm"] = "ACM"
+	awsServiceNames["acmpca"] = "ACMPCA"
+	awsServiceNames["alexaforbusiness"] = "AlexaForBusiness"
+	awsServiceNames["amplify"] = "Amplify"
+	awsServiceNames["amplifybackend"] = "AmplifyBackend"
+	awsServiceNames["apigateway"] = "APIGateway"
+	awsServiceNames["apigatewaymanagement"] = "APIGatewayManagement"
+	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
+	awsServiceNames["appconfig"] = "AppConfig"
+	awsServiceNames["appflow"] = "AppFlow"
+	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
+	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
+	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
+	awsServiceNames["applicationinsights"] = "ApplicationInsights"
+	awsServiceNames["appmesh"] = "AppMesh"
+	awsServiceNames["appregistry"] = "AppRegistry"
+	awsServiceNames["apprunner"] = "AppRunner"
+	awsServiceNames["appstream"] = "AppStream"
+	awsServiceNames["appsync"] = "AppSync"
+	awsServiceNames["athena"] = "Athena"
+	awsServiceNames["auditmanager"] = "AuditManager"
+	awsServiceNames["augmentedairuntime"] = "AugmentedAiruntime"
+	awsServiceNames["autoscaling"] = "AutoScaling"
+	awsServiceNames["autoscalingplans"] = "AutoScalingPlans"
+	awsServiceNames["backup"] = "Backup"
+	awsServiceNames["batch"] = "Batch"
+	awsServiceNames["braket"] = "Braket"
+	awsServiceNames["budgets"] = "Budgets"
+	awsServiceNames["chime"] = "Chime"
+	awsServiceNames["cloud9"] = "Cloud9"
+	awsServiceNames["cloudcontrolapi"] = "CloudControlApi"
+	awsServiceNames["clouddirectory"] = "CloudDirectory"
+	awsServiceNames["cloudformation"] = "CloudFormation"
+	awsServiceNames["cloudfront"] = "CloudFront"
+	awsServiceNames["cloudhsm"] = "CloudHSM"
+	awsServiceNames["cloudhsmv2"] = "CloudHSMV2"
+	awsServiceNames["cloudsearch"] = "CloudSearch"
+	awsServiceNames["cloudsearchdomain"] = "CloudSearchDomain"
+	awsServiceNames["cloudtrail"] = "CloudTrail"
+	awsServiceNames["cloudwatch"] = "CloudWatch"
+	awsServiceNames["cloudwatchevents"] = "CloudWatchEvents"
+	awsServiceNames["cloudwatchlogs"] = "CloudWatchLogs"
+	awsServiceNames["codeartifact"] = "CodeArtifact"
+	awsServiceNames["codebuild"] = "CodeBuild"
+	awsServiceNames["codecommit"] = "CodeCommit"
+	awsServiceNames["codedeploy"] = "CodeDeploy"
+	awsServiceNames["codeguruprofiler"] = "CodeGuruProfiler"
+	awsServiceNames["codegurureviewer"] = "CodeGuruReviewer"
+	awsServiceNames["codepipeline"] = "CodePipeline"
+	awsServiceNames["codestar"] = "CodeStar"
+	awsServiceNames["codestarconnections"] = "CodeStarConnections"
+	awsServiceNames["codestarnotifications"] = "CodeStarNotifications"
+	awsServiceNames["cognitoidentity"] = "CognitoIdentity"
+	awsServiceNames["cognitoidentityprovider"] = "CognitoIdentityProvider"
+	awsServiceNames["cognitosync"] = "CognitoSync"
+	awsServiceNames["comprehend"] = "Comprehend"
+	awsServiceNames["comprehendmedical"] = "ComprehendMedical"
+	awsServiceNames["computeoptimizer"] = "ComputeOptimizer"
+	awsServiceNames["configservice"] = "ConfigService"
+	awsServiceNames["connect"] = "Connect"
+	awsServiceNames["connectcontactlens"] = "ConnectContactLens"
+	awsServiceNames["connectparticipant"] = "ConnectParticipant"
+	awsServiceNames["costexplorer"] = "CostExplorer"
+	awsServiceNames["cur"] = "CUR"
+	awsServiceNames["customerprofiles"] = "CustomerProfiles"
+	awsServiceNames["databasemigrationservice"] = "DatabaseMigrationService"
+	awsServiceNames["dataexchange"] = "DataExchange"
+	awsServiceNames["datapipeline"] = "DataPipeline"
+	awsServiceNames["datasync"] = "DataSync"
+	awsServiceNames["dax"] = "DAX"
+	awsServiceNames["detective"] = "Detective"
+	awsServiceNames["devicefarm"] = "DeviceFarm"
+	awsServiceNames["devopsguru"] = "DevOpsGuru"
+	awsServiceNames["directconnect"] = "DirectConnect"
+	awsServiceNames["directoryservice"] = "DirectoryService"
+	awsServiceNames["dlm"] = "DLM"
+	awsServiceNames["docdb"] = "DocDB"
+	awsServiceNames["dynamodb"] = "DynamoDB"
+	awsServiceNames["dynamodbattribute"] = "DynamoDBAttribute"
+	awsServiceNames["dynamodbstreams"] = "DynamoDBStreams"
+	awsServiceNames["ec2"] = "EC2"
+	awsServiceNames["ec2instanceconnect"] = "EC2InstanceConnect"
+	awsServiceNames["ecr"] = "ECR"
+	awsServiceNames["ecrpublic"] = "ECRPublic"
+	awsServiceNames["ecs"] = "ECS"
+	awsServiceNames["efs"] = "EFS"
+	awsServiceNames["eks"] = "EKS"
+	awsServiceNames["elasticache"] = "ElastiCache"
+	awsServiceNames["elasticbeanstalk"] = "ElasticBeanstalk"
+	awsServiceNames["elasticinference"] = "ElasticInference"
+	awsServiceNames["elasticsearchservice"] = "ElasticsearchService"
+	awsServiceNames["elastictranscoder"] = "ElasticTranscoder"
+	awsServiceNames["elb"] = "ELB"
+	awsServiceNames["elbv2"] = "ELBV2"
+	awsServiceNames["emr"] = "EMR"
+	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["eventbridge"] = "EventBridge"
+	awsServiceNames["expression"] = "Expression"
+	awsServiceNames["finspace"] = "FinSpace"
+	awsServiceNames["finspacedata"] = "FinSpaceData"
+	awsServiceNames["firehose"] = "Firehose"
+	awsServiceNames["fis"] = "FIS"
+	awsServiceNames["fms"] = "FMS"
+	awsServiceNames["forecast"] = "Forecast"
+	awsServiceNames["forecastquery"] = "ForecastQuery"
+	awsServiceNames["frauddetector"] = "FraudDetector"
+	awsServiceNames["fsx"] = "FSx"
+	awsServiceNames["gamelift"] = "GameLift"
+	awsServiceNames["glacier"] = "Glacier"
+	awsServiceNames["globalaccelerator"] = "GlobalAccelerator"
+	awsServiceNames["glue"] = "Glue"
+	awsServiceNames["gluedatabrew"] = "GlueDataBrew"
+	awsServiceNames["greengrass"] = "Greengrass"
+	awsServiceNames["greengrassv2"] = "GreengrassV2"
+	awsServiceNames["groundstation"] = "GroundStation"
+	awsServiceNames["guardduty"] = "GuardDuty"
+	awsServiceNames["health"] = "Health"
+	awsServiceNames["healthlake"] = "HealthLake"
+	awsServiceNames["honeycode"] = "HoneyCode"
+	awsServiceNames["iam"] = "IAM"
+	awsServiceNames["identitystore"] = "IdentityStore"
+	awsServiceNames["imagebuilder"] = "ImageBuilder"
+	awsServiceNames["imagebuilder"] = "Imagebuilder"
+	awsServiceNames["inspector"] = "Inspector"
+	awsServiceNames["iot"] = "IoT"
+	awsServiceNames["iot1clickdevices"] = "IoT1ClickDevices"
+	awsServiceNames["iot1clickprojects"] = "IoT1ClickProjects"
+	awsServiceNames["iotanalytics"] = "IoTAnalytics"
+	awsServiceNames["iotdataplane"] = "IoTDataPlane"
+	awsServiceNames["iotdeviceadvisor"] = "IoTDeviceAdvisor"
+	awsServiceNames["iotevents"] = "IoTEvents"
+	awsServiceNames["ioteventsdata"] = "IoTEventsData"
+	awsServiceNames["iotfleethub"] = "IoTFleetHub"
+	awsServiceNames["iotjobsdataplane"] = "IoTJobsDataPlane"
+	awsServiceNames["iotsecuretunneling"] = "IoTSecureTunneling"
+	awsServiceNames["iotsitewise"] = "IoTSiteWise"
+	awsServiceNames["iotthingsgraph"] = "IoTThingsGraph"
+	awsServiceNames["iotwireless"] = "IoTWireless"
+	awsServiceNames["ivs"] = "IVS"
+	awsServiceNames["kafka"] = "Kafka"
+	awsServiceNames["kendra"] = "Kendra"
+	awsServiceNames["kinesis"] = "Kinesis"
+	awsServiceNames["kinesisanalytics"] = "KinesisAnalytics"
+	awsServiceNames["kinesisanalyticsv2"] = "KinesisAnalyticsV2"
+	awsServiceNames["kinesisvideo"] = "KinesisVideo"
+	awsServiceNames["kinesisvideoarchivedmedia"] = "KinesisVideoArchivedMedia"
+	awsServiceNames["kinesisvideomedia"] = "KinesisVideoMedia"
+	awsServiceNames["kinesisvideosignalingchannels"] = "KinesisVideoSignalingChannels"
+	awsServiceNames["kms"] = "KMS"
+	awsServiceNames["lakeformation"] = "LakeFormation"
+	awsServiceNames["lambda"] = "Lambda"
+	awsServiceNames["lexmodelbuilding"] = "LexModelBuilding"
+	awsServiceNames["lexmodelsv2"] = "LexModelsV2"
+	awsServiceNames["lexruntime"] = "LexRuntime"
+	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
+	awsServiceNames["licensemanager"] = "LicenseManager"
+	awsServiceNames["lightsail"] = "Lightsail"
+	awsServiceNames["location"] = "Location"
+	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
+	awsServiceNames["lookoutforvision"] = "LookoutForVision"
+	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
+	awsServiceNames["machinelearning"] = "MachineLearning"
+	awsServiceNames["macie"] = "Macie"
+	awsServiceNames["macie2"] = "Macie2"
+	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
+	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
+	awsServiceNames["marketplacecommerceanalytics"] = "MarketplaceCommerceAnalytics"
+	awsServiceNames["marketplaceentitlement"] = "MarketplaceEntitlement"
+	awsServiceNames["marketplacemetering"] = "MarketplaceMetering"
+	awsServiceNames["mediaconnect"] = "MediaConnect"
+	awsServiceNames["mediaconvert"] = "MediaConvert"
+	awsServiceNames["medialive"] = "MediaLive"
+	awsServiceNames["mediapackage"] = "MediaPackage"
+	awsServiceNames["mediapackagevod"] = "MediaPackageVOD"
+	awsServiceNames["mediastore"] = "MediaStore"
+	awsServiceNames["mediastoredata"] = "MediaStoreData"
+	awsServiceNames["mediatailor"] = "MediaTailor"
+	awsServiceNames["memorydb"] = "MemoryDB"
+	awsServiceNames["mgn"] = "Mgn"
+	awsServiceNames["migrationhub"] = "MigrationHub"
+	awsServiceNames["migrationhubconfig"] = "MigrationHubConfig"
+	awsServiceNames["mobile"] = "Mobile"
+	awsServiceNames["mobileanalytics"] = "MobileAnalytics"
+	awsServiceNames["mq"] = "MQ"
+	awsServiceNames["mturk"] = "MTurk"
+	awsServiceNames["mwaa"] = "MWAA"
+	awsServiceNames["neptune"] = "Neptune"
+	awsServiceNames["networkfirewall"] = "NetworkFirewall"
+	awsServiceNames["networkmanager"] = "NetworkManager"
+	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opsworks"] = "OpsWorks"
+	awsServiceNames["opsworkscm"] = "OpsWorksCM"
+	awsServiceNames["organizations"] = "Organizations"
+	awsServiceNames["outposts"] = "Outposts"
+	awsServiceNames["personalize"] = "Personalize"
+	awsServiceNames["personalizeevents"] = "PersonalizeEvents"
+	awsServiceNames["personalizeruntime"] = "PersonalizeRuntime"
+	awsServiceNames["pi"] = "PI"
+	awsServiceNames["pinpoint"] = "Pinpoint"
+	awsServiceNames["pinpointemail"] = "PinpointEmail"
+	awsServiceNames["pinpointsmsvoice"] = "PinpointSMSVoice"
+	awsServiceNames["polly"] = "Polly"
+	awsServiceNames["pricing"] = "Pricing"
+	awsServiceNames["prometheus"] = "Prometheus"
+	awsServiceNames["proton"] = "Proton"
+	awsServiceNames["qldb"] = "QLDB"
+	awsServiceNames["qldbsession"] = "QLDBSession"
+	awsServiceNames["quicksight"] = "QuickSight"
+	awsServiceNames["ram"] = "RAM"
+	awsServiceNames["rds"] = "RDS"
+	awsServiceNames["rdsdata"] = "RDSData"
+	awsServiceNames["rdsutils"] = "RDSUtils"
+	awsServiceNames["redshift"] = "Redshift"
+	awsServiceNames["redshiftdata"] = "RedshiftData"
+	awsServiceNames["rekognition"] = "Rekognition"
+	awsServiceNames["resourcegroups"] = "ResourceGroups"
+	awsServiceNames["resourcegroupstaggingapi"] = "ResourceGroupsTaggingAPI"
+	awsServiceNames["robomaker"] = "RoboMaker"
+	awsServiceNames["route53"] = "Route53"
+	awsServiceNames["route53domains"] = "Route53Domains"
+	awsServiceNames["route53recoverycontrolconfig"] = "Route53RecoveryControlConfig"
+	awsServiceNames["route53recoveryreadiness"] = "Route53RecoveryReadiness"
+	awsServiceNames["route53resolver"] = "Route53Resolver"
+	awsServiceNames["s3"] = "S3"
+	awsServiceNames["s3control"] = "S3Control"
+	awsServiceNames["s3crypto"] = "S3Crypto"
+	awsServiceNames["s3manager"] = "S3Manager"
+	awsServiceNames["s3outposts"] = "S3Outposts"
+	awsServiceNames["sagemaker"] = "SageMaker"
+	awsServiceNames["sagemakeredgemanager"] = "SageMakerEdgeManager"
+	awsServiceNames["sagemakerfeaturestoreruntime"] = "SageMakerFeatureStoreRuntime"
+	awsServiceNames["sagemakerruntime"] = "SageMakerRuntime"
+	awsServiceNames["savingsplans"] = "SavingsPlans"
+	awsServiceNames["schemas"] = "Schemas"
+	awsServiceNames["secretsmanager"] = "SecretsManager"
+	awsServiceNames["securityhub"] = "SecurityHub"
+	awsServiceNames["serverlessapplicationrepository"] = "ServerlessApplicationRepository"
+	awsServiceNames["servicecatalog"] = "ServiceCatalog"
+	awsServiceNames["servicediscovery"] = "ServiceDiscovery"
+	awsServiceNames["servicequotas"] = "ServiceQuotas"
+	awsServiceNames["ses"] = "SES"
+	awsServiceNames["sesv2"] = "SESV2"
+	awsServiceNames["sfn"] = "SFN"
+	awsServiceNames["shield"] = "Shield"
+	awsServiceNames["sign"] = "Sign"
+	awsServiceNames["signer"] = "Signer"
+	awsServiceNames["simpledb"] = "SimpleDB"
+	awsServiceNames["sms"] = "SMS"
+	awsServiceNames["snowball"] = "Snowball"
+	awsServiceNames["sns"] = "SNS"
+	awsServiceNames["sqs"] = "SQS"
+	awsServiceNames["ssm"] = "SSM"
+	awsServiceNames["ssmcontacts"] = "SSMContacts"
+	awsServiceNames["ssmincidents"] = "SSMIncidents"
+	awsServiceNames["sso"] = "SSO"
+	awsServiceNames["ssoadmin"] = "SSOAdmin"
+	awsServiceNames["ssooidc"] = "SSOOIDC"
+	awsServiceNames["storagegateway"] = "StorageGateway"
+	awsServiceNames["sts"] = "STS"
+	awsServiceNames["support"] = "Support"
+	awsServiceNames["swf"] = "SWF"
+	awsServiceNames["synthetics"] = "Synthetics"
+	awsServiceNames["textract"] = "Textract"
+	awsServiceNames["timestreamquery"] = "TimestreamQuery"
+	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
+	awsServiceNames["transcribe"] = "Transcribe"
+	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
+	awsServiceNames["transfer"] = "Transfer"
+	awsServiceNames["translate"] = "Translate"
+	awsServiceNames["waf"] = "WAF"
+	awsServiceNames["wafregional"] = "WAFRegional"
+	awsServiceNames["wafv2"] = "WAFV2"
+	awsServiceNames["wellarchitected"] = "WellArchitected"
+	awsServiceNames["workdocs"] = "WorkDocs"
+	awsServiceNames["worklink"] = "WorkLink"
+	awsServiceNames["workmail"] = "WorkMail"
+	awsServiceNames["workmailmessageflow"] = "WorkMailMessageFlow"
+	awsServiceNames["workspaces"] = "WorkSpaces"
+	awsServiceNames["xray"] = "XRay"
+}
@@ -7,15 +7,27 @@ import (
 	"bytes"
 	"flag"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"log"
 	"os"
+	"path"
+	"strconv"
 	"strings"
 	"text/template"
 )
 
+const (
+	awsClientFilename = "../../conns/awsclient_gen.go"
+	tagsFilename      = "tags_gen.go"
+)
+
 var (
-	idAttribName = flag.String("IDAttribName", "resource_arn", "idAttribName")
+	idAttribName   = flag.String("IDAttribName", "resource_arn", "idAttribName")
+	resourcePrefix = flag.String("ResourcePrefix", "", "resource type name prefix, defaults to aws_<service package>")
+	testPreCheck   = flag.String("TestPreCheck", "", "name of an additional PreCheck function called by the acceptance tests")
 )
 
 func usage() {
@@ -30,7 +42,9 @@ type TemplateData struct {
 	AWSServiceUpper string
 	ServicePackage  string
 
-	IDAttribName string
+	IDAttribName     string
+	ResourceTypeName string
+	TestPreCheck     string
 }
 
 func main() {
@@ -39,23 +53,29 @@ func main() {
 	flag.Parse()
 
 	servicePackage := os.Getenv("GOPACKAGE")
-	awsService, err := awsServiceName(servicePackage)
+	importPath, typeName, err := updateTagsConnType(tagsFilename)
 
 	if err != nil {
 		log.Fatalf("encountered: %s", err)
 	}
 
-	awsServiceUpper, err := awsServiceNameUpper(servicePackage)
+	awsServiceUpper, err := awsClientConnName(awsClientFilename, importPath, typeName)
 
 	if err != nil {
 		log.Fatalf("encountered: %s", err)
 	}
 
 	templateData := TemplateData{
-		AWSService:      awsService,
-		AWSServiceUpper: awsServiceUpper,
-		ServicePackage:  servicePackage,
-		IDAttribName:    *idAttribName,
+		AWSService:       path.Base(importPath),
+		AWSServiceUpper:  awsServiceUpper,
+		ServicePackage:   servicePackage,
+		IDAttribName:     *idAttribName,
+		ResourceTypeName: fmt.Sprintf("%s_tag", *resourcePrefix),
+		TestPreCheck:     *testPreCheck,
+	}
+
+	if *resourcePrefix == "" {
+		templateData.ResourceTypeName = fmt.Sprintf("aws_%s_tag", servicePackage)
 	}
 
 	resourceFilename := "tag_gen.go"
@@ -114,10 +134,11 @@ const (
 package {{ .ServicePackage }}
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -126,12 +147,12 @@ import (
 
 func ResourceTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTagCreate,
-		Read:   resourceTagRead,
-		Update: resourceTagUpdate,
-		Delete: resourceTagDelete,
+		CreateContext: resourceTagCreate,
+		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
+		DeleteContext: resourceTagDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -153,44 +174,44 @@ func ResourceTag() *schema.Resource {
 	}
 }
 
-func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 	identifier := d.Get("{{ .IDAttribName }}").(string)
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 
 	{{ if eq .ServicePackage "ec2" }}
-	if err := CreateTags(conn, identifier, map[string]string{key: value}); err != nil {
+	if err := CreateTagsWithContext(ctx, conn, identifier, map[string]string{key: value}); err != nil {
 	{{- else }}
-	if err := UpdateTags(conn, identifier, nil, map[string]string{key: value}); err != nil {
+	if err := UpdateTagsWithContext(ctx, conn, identifier, nil, map[string]string{key: value}); err != nil {
 	{{- end }}
-		return fmt.Errorf("error creating %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+		return diag.Errorf("error creating %s resource (%s) tag (%s): %s", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
 	d.SetId(tftags.SetResourceID(identifier, key))
 
-	return resourceTagRead(d, meta)
+	return resourceTagRead(ctx, d, meta)
 }
 
-func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	value, err := GetTag(conn, identifier, key)
+	value, err := GetTagWithContext(ctx, conn, identifier, key)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] %s resource (%s) tag (%s) not found, removing from state", {{ .ServicePackage }}.ServiceID, identifier, key)
+		log.Printf("[WARN] %s resource (%s) tag (%s) not found, removing from state", {{ .AWSService }}.ServiceID, identifier, key)
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+		return diag.Errorf("error reading %s resource (%s) tag (%s): %s", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
 	d.Set("{{ .IDAttribName }}", identifier)
@@ -200,31 +221,31 @@ func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if err := UpdateTags(conn, identifier, nil, map[string]string{key: d.Get("value").(string)}); err != nil {
-		return fmt.Errorf("error updating %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+	if err := UpdateTagsWithContext(ctx, conn, identifier, nil, map[string]string{key: d.Get("value").(string)}); err != nil {
+		return diag.Errorf("error updating %s resource (%s) tag (%s): %s", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
-	return resourceTagRead(d, meta)
+	return resourceTagRead(ctx, d, meta)
 }
 
-func resourceTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if err := UpdateTags(conn, identifier, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
-		return fmt.Errorf("error deleting %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+	if err := UpdateTagsWithContext(ctx, conn, identifier, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
+		return diag.Errorf("error deleting %s resource (%s) tag (%s): %s", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
 	return nil
@@ -237,6 +258,7 @@ package {{ .ServicePackage }}_test
 
 import (
 	"fmt"
+	"testing"
 
 	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -248,11 +270,114 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestAcc{{ .AWSServiceUpper }}Tag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "{{ .ResourceTypeName }}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService({{ .AWSService }}.EndpointsID, t)
+			{{- if .TestPreCheck }}
+			{{ .TestPreCheck }}(t)
+			{{- end }}
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, {{ .AWSService }}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "{{ .IDAttribName }}"),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc{{ .AWSServiceUpper }}Tag_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "{{ .ResourceTypeName }}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService({{ .AWSService }}.EndpointsID, t)
+			{{- if .TestPreCheck }}
+			{{ .TestPreCheck }}(t)
+			{{- end }}
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, {{ .AWSService }}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tf{{ .ServicePackage }}.ResourceTag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAcc{{ .AWSServiceUpper }}Tag_value(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "{{ .ResourceTypeName }}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService({{ .AWSService }}.EndpointsID, t)
+			{{- if .TestPreCheck }}
+			{{ .TestPreCheck }}(t)
+			{{- end }}
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, {{ .AWSService }}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTagConfig(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTagDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_{{ .ServicePackage }}_tag" {
+		if rs.Type != "{{ .ResourceTypeName }}" {
 			continue
 		}
 
@@ -272,7 +397,7 @@ func testAccCheckTagDestroy(s *terraform.State) error {
 			return err
 		}
 
-		return fmt.Errorf("%s resource (%s) tag (%s) still exists", {{ .ServicePackage }}.ServiceID, identifier, key)
+		return fmt.Errorf("%s resource (%s) tag (%s) still exists", {{ .AWSService }}.ServiceID, identifier, key)
 	}
 
 	return nil
@@ -295,7 +420,7 @@ func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 		_, err = tf{{ .ServicePackage }}.GetTag(conn, identifier, key)
 
@@ -309,32 +434,101 @@ func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
 `
 )
 
-var awsServiceNames map[string]string
+// updateTagsConnType returns the AWS Go SDK package import path and client type name of the
+// conn parameter of the UpdateTags function generated by the tags generator in the file.
+func updateTagsConnType(filename string) (string, string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
 
-func init() {
-	awsServiceNames = make(map[string]string)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing %s (run the tags generator with -UpdateTags first): %w", filename, err)
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+
+		if !ok || funcDecl.Recv != nil || funcDecl.Name.Name != "UpdateTags" {
+			continue
+		}
+
+		if params := funcDecl.Type.Params.List; len(params) > 0 {
+			if importPath, typeName, ok := selectorType(file, params[0].Type); ok {
+				return importPath, typeName, nil
+			}
+		}
 
-	awsServiceNames["dynamodb"] = "DynamoDB"
-	awsServiceNames["ec2"] = "EC2"
-	awsServiceNames["ecs"] = "ECS"
+		return "", "", fmt.Errorf("unable to find AWS Go SDK client type of UpdateTags in %s", filename)
+	}
+
+	return "", "", fmt.Errorf("unable to find UpdateTags in %s (run the tags generator with -UpdateTags first)", filename)
 }
 
-func awsServiceName(s string) (string, error) {
-	s = strings.ToLower(s)
+// awsClientConnName returns the name, less the Conn suffix, of the conns.AWSClient method in the file
+// returning the specified AWS Go SDK client type.
+func awsClientConnName(filename, importPath, typeName string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing %s: %w", filename, err)
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+
+		if !ok || funcDecl.Recv == nil || !strings.HasSuffix(funcDecl.Name.Name, "Conn") {
+			continue
+		}
+
+		results := funcDecl.Type.Results
+
+		if results == nil || len(results.List) != 1 {
+			continue
+		}
 
-	if _, ok := awsServiceNames[s]; ok {
-		return s, nil
+		if v1, v2, ok := selectorType(file, results.List[0].Type); ok && v1 == importPath && v2 == typeName {
+			return strings.TrimSuffix(funcDecl.Name.Name, "Conn"), nil
+		}
 	}
 
-	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+	return "", fmt.Errorf("unable to find AWSClient method returning *%s.%s in %s", path.Base(importPath), typeName, filename)
 }
 
-func awsServiceNameUpper(s string) (string, error) {
-	s = strings.ToLower(s)
+// selectorType returns the import path and type name of a pointer to a type of an imported package, e.g. *acm.ACM.
+func selectorType(file *ast.File, expr ast.Expr) (string, string, bool) {
+	starExpr, ok := expr.(*ast.StarExpr)
+
+	if !ok {
+		return "", "", false
+	}
 
-	if v, ok := awsServiceNames[s]; ok {
-		return v, nil
+	selectorExpr, ok := starExpr.X.(*ast.SelectorExpr)
+
+	if !ok {
+		return "", "", false
+	}
+
+	ident, ok := selectorExpr.X.(*ast.Ident)
+
+	if !ok {
+		return "", "", false
+	}
+
+	for _, importSpec := range file.Imports {
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+
+		if err != nil {
+			continue
+		}
+
+		name := path.Base(importPath)
+
+		if importSpec.Name != nil {
+			name = importSpec.Name.Name
+		}
+
+		if name == ident.Name {
+			return importPath, selectorExpr.Sel.Name, true
+		}
 	}
 
-	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+	return "", "", false
 }
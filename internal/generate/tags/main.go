@@ -97,6 +97,7 @@ type TemplateData struct {
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
+	ContextPkg      bool
 	FmtPkg          bool
 	HelperSchemaPkg bool
 	StrConvPkg      bool
@@ -134,6 +135,7 @@ func main() {
 		ClientType:     clientType,
 		ServicePackage: servicePackage,
 
+		ContextPkg:      *getTag || *listTags || *updateTags,
 		FmtPkg:          *updateTags,
 		HelperSchemaPkg: awsService == "autoscaling",
 		StrConvPkg:      awsService == "autoscaling",
@@ -237,6 +239,9 @@ var headerBody = `
 package {{ .ServicePackage }}
 
 import (
+	{{- if .ContextPkg }}
+	"context"
+	{{- end }}
 	{{- if .FmtPkg }}
 	"fmt"
 	{{- end }}
@@ -276,6 +281,15 @@ var gettagBody = `
 func GetTag(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, key string) (*tftags.TagData, error) {
 {{- else }}
 func GetTag(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, key string) (*string, error) {
+{{- end }}
+	return GetTagWithContext(context.Background(), conn, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}, key)
+}
+
+// GetTagWithContext is the same as GetTag with the addition of the ability to pass a context.
+{{- if or ( .TagTypeIDElem ) ( .TagTypeAddBoolElem ) }}
+func GetTagWithContext(ctx context.Context, conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, key string) (*tftags.TagData, error) {
+{{- else }}
+func GetTagWithContext(ctx context.Context, conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, key string) (*string, error) {
 {{- end }}
 	{{- if .ListTagsInFiltIDName }}
 	input := &{{ .AWSService  }}.{{ .ListTagsOp }}Input{
@@ -291,7 +305,7 @@ func GetTag(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, r
 		},
 	}
 
-	output, err := conn.{{ .ListTagsOp }}(input)
+	output, err := conn.{{ .ListTagsOp }}WithContext(ctx, input)
 
 	if err != nil {
 		return nil, err
@@ -299,7 +313,7 @@ func GetTag(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, r
 
 	listTags := KeyValueTags(output.{{ .ListTagsOutTagsElem }}{{ if .TagTypeIDElem }}, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}{{ end }})
 	{{- else }}
-	listTags, err := ListTags(conn, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
+	listTags, err := ListTagsWithContext(ctx, conn, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
 
 	if err != nil {
 		return nil, err
@@ -323,6 +337,11 @@ var listtagsBody = `
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func ListTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
+}
+
+// ListTagsWithContext is the same as ListTags with the addition of the ability to pass a context.
+func ListTagsWithContext(ctx context.Context, conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}) (tftags.KeyValueTags, error) {
 	input := &{{ .TagPackage  }}.{{ .ListTagsOp }}Input{
 		{{- if .ListTagsInFiltIDName }}
 		Filters: []*{{ .AWSService  }}.Filter{
@@ -343,7 +362,7 @@ func ListTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }},
 		{{- end }}
 	}
 
-	output, err := conn.{{ .ListTagsOp }}(input)
+	output, err := conn.{{ .ListTagsOp }}WithContext(ctx, input)
 
 	{{ if and ( .ParentNotFoundErrCode ) ( .ParentNotFoundErrMsg ) }}
 			if tfawserr.ErrMessageContains(err, "{{ .ParentNotFoundErrCode }}", "{{ .ParentNotFoundErrMsg }}") {
@@ -651,10 +670,20 @@ var updatetagsBody = `
 // it may also be a different identifier depending on the service.
 {{- if  .TagTypeAddBoolElem }}
 func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsSet interface{}, newTagsSet interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}, oldTagsSet, newTagsSet)
+}
+
+// UpdateTagsWithContext is the same as UpdateTags with the addition of the ability to pass a context.
+func UpdateTagsWithContext(ctx context.Context, conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsSet interface{}, newTagsSet interface{}) error {
 	oldTags := KeyValueTags(oldTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
 	newTags := KeyValueTags(newTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
 {{- else }}
 func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}, oldTagsMap, newTagsMap)
+}
+
+// UpdateTagsWithContext is the same as UpdateTags with the addition of the ability to pass a context.
+func UpdateTagsWithContext(ctx context.Context, conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 {{- end }}
@@ -696,7 +725,7 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 		{{- end }}
 	}
 
-	_, err := conn.{{ .TagOp }}(input)
+	_, err := conn.{{ .TagOp }}WithContext(ctx, input)
 
 	if err != nil {
 		return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
@@ -730,7 +759,7 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 			{{- end }}
 		}
 
-		_, err := conn.{{ .UntagOp }}(input)
+		_, err := conn.{{ .UntagOp }}WithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
@@ -762,7 +791,7 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 			{{- end }}
 		}
 
-		_, err := conn.{{ .TagOp }}(input)
+		_, err := conn.{{ .TagOp }}WithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
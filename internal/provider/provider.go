@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -47,6 +51,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/config"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
@@ -77,12 +82,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
@@ -96,7 +104,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
@@ -104,6 +114,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -188,6 +199,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -220,6 +233,23 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarRetryMode, nil),
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
+
+			"rate_limit": rateLimitSchema(),
+
+			"eventual_consistency_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["eventual_consistency_timeout"],
+				ValidateFunc: verify.ValidDuration,
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -236,6 +266,22 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"allowed_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"forbidden_regions"},
+				Set:           schema.HashString,
+			},
+
+			"forbidden_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"allowed_regions"},
+				Set:           schema.HashString,
+			},
+
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -243,6 +289,33 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"resource_type_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with resource tags to default across resources of selected types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, which may contain `*` wildcards, to which the tags are not defaulted",
+									},
+									"include_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, which may contain `*` wildcards, to which the tags are defaulted. Defaults to all resource types",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across resources of the selected types",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -259,8 +332,66 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarCABundle, nil),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpoint, nil),
+				Description:  descriptions["ec2_metadata_service_endpoint"],
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpointMode, nil),
+				Description:  descriptions["ec2_metadata_service_endpoint_mode"],
+				ValidateFunc: validation.StringInSlice([]string{"IPv4", "IPv6"}, false),
+			},
+
 			"endpoints": endpointsSchema(),
 
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, which may contain `*` wildcards, that do not require the tags",
+						},
+						"include_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, which may contain `*` wildcards, that require the tags. Defaults to all resource types",
+						},
+						"keys": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that are required",
+						},
+						"value_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "Regular expression that values of the required tags must match",
+						},
+					},
+				},
+			},
+
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -334,6 +465,20 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarUseDualStackEndpoint, false),
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarUseFIPSEndpoint, false),
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -643,6 +788,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket":         s3.DataSourceBucket(),
 			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),
 			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(),
+			"aws_s3_object":         s3.DataSourceObject(),
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
@@ -710,18 +856,22 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_tag":      accessanalyzer.ResourceTag(),
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
+			"aws_acm_tag":                    acm.ResourceTag(),
 
 			"aws_acmpca_certificate":                       acmpca.ResourceCertificate(),
 			"aws_acmpca_certificate_authority":             acmpca.ResourceCertificateAuthority(),
 			"aws_acmpca_certificate_authority_certificate": acmpca.ResourceCertificateAuthorityCertificate(),
+			"aws_acmpca_tag":                               acmpca.ResourceTag(),
 
 			"aws_amplify_app":                 amplify.ResourceApp(),
 			"aws_amplify_backend_environment": amplify.ResourceBackendEnvironment(),
 			"aws_amplify_branch":              amplify.ResourceBranch(),
 			"aws_amplify_domain_association":  amplify.ResourceDomainAssociation(),
+			"aws_amplify_tag":                 amplify.ResourceTag(),
 			"aws_amplify_webhook":             amplify.ResourceWebhook(),
 
 			"aws_api_gateway_account":               apigateway.ResourceAccount(),
@@ -745,6 +895,7 @@ func Provider() *schema.Provider {
 			"aws_api_gateway_rest_api":              apigateway.ResourceRestAPI(),
 			"aws_api_gateway_rest_api_policy":       apigateway.ResourceRestAPIPolicy(),
 			"aws_api_gateway_stage":                 apigateway.ResourceStage(),
+			"aws_api_gateway_tag":                   apigateway.ResourceTag(),
 			"aws_api_gateway_usage_plan":            apigateway.ResourceUsagePlan(),
 			"aws_api_gateway_usage_plan_key":        apigateway.ResourceUsagePlanKey(),
 			"aws_api_gateway_vpc_link":              apigateway.ResourceVPCLink(),
@@ -760,6 +911,7 @@ func Provider() *schema.Provider {
 			"aws_apigatewayv2_route":                apigatewayv2.ResourceRoute(),
 			"aws_apigatewayv2_route_response":       apigatewayv2.ResourceRouteResponse(),
 			"aws_apigatewayv2_stage":                apigatewayv2.ResourceStage(),
+			"aws_apigatewayv2_tag":                  apigatewayv2.ResourceTag(),
 			"aws_apigatewayv2_vpc_link":             apigatewayv2.ResourceVPCLink(),
 
 			"aws_appconfig_application":                  appconfig.ResourceApplication(),
@@ -768,6 +920,7 @@ func Provider() *schema.Provider {
 			"aws_appconfig_deployment_strategy":          appconfig.ResourceDeploymentStrategy(),
 			"aws_appconfig_environment":                  appconfig.ResourceEnvironment(),
 			"aws_appconfig_hosted_configuration_version": appconfig.ResourceHostedConfigurationVersion(),
+			"aws_appconfig_tag":                          appconfig.ResourceTag(),
 
 			"aws_appautoscaling_policy":           appautoscaling.ResourcePolicy(),
 			"aws_appautoscaling_scheduled_action": appautoscaling.ResourceScheduledAction(),
@@ -776,6 +929,7 @@ func Provider() *schema.Provider {
 			"aws_appmesh_gateway_route":   appmesh.ResourceGatewayRoute(),
 			"aws_appmesh_mesh":            appmesh.ResourceMesh(),
 			"aws_appmesh_route":           appmesh.ResourceRoute(),
+			"aws_appmesh_tag":             appmesh.ResourceTag(),
 			"aws_appmesh_virtual_gateway": appmesh.ResourceVirtualGateway(),
 			"aws_appmesh_virtual_node":    appmesh.ResourceVirtualNode(),
 			"aws_appmesh_virtual_router":  appmesh.ResourceVirtualRouter(),
@@ -785,19 +939,23 @@ func Provider() *schema.Provider {
 			"aws_apprunner_connection":                         apprunner.ResourceConnection(),
 			"aws_apprunner_custom_domain_association":          apprunner.ResourceCustomDomainAssociation(),
 			"aws_apprunner_service":                            apprunner.ResourceService(),
+			"aws_apprunner_tag":                                apprunner.ResourceTag(),
 
 			"aws_appstream_fleet":         appstream.ResourceFleet(),
 			"aws_appstream_image_builder": appstream.ResourceImageBuilder(),
 			"aws_appstream_stack":         appstream.ResourceStack(),
+			"aws_appstream_tag":           appstream.ResourceTag(),
 
 			"aws_appsync_api_key":     appsync.ResourceAPIKey(),
 			"aws_appsync_datasource":  appsync.ResourceDataSource(),
 			"aws_appsync_function":    appsync.ResourceFunction(),
 			"aws_appsync_graphql_api": appsync.ResourceGraphQLAPI(),
 			"aws_appsync_resolver":    appsync.ResourceResolver(),
+			"aws_appsync_tag":         appsync.ResourceTag(),
 
 			"aws_athena_database":    athena.ResourceDatabase(),
 			"aws_athena_named_query": athena.ResourceNamedQuery(),
+			"aws_athena_tag":         athena.ResourceTag(),
 			"aws_athena_workgroup":   athena.ResourceWorkGroup(),
 
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
@@ -815,6 +973,7 @@ func Provider() *schema.Provider {
 			"aws_backup_plan":                     backup.ResourcePlan(),
 			"aws_backup_region_settings":          backup.ResourceRegionSettings(),
 			"aws_backup_selection":                backup.ResourceSelection(),
+			"aws_backup_tag":                      backup.ResourceTag(),
 			"aws_backup_vault":                    backup.ResourceVault(),
 			"aws_backup_vault_lock_configuration": backup.ResourceVaultLockConfiguration(),
 			"aws_backup_vault_notifications":      backup.ResourceVaultNotifications(),
@@ -823,6 +982,7 @@ func Provider() *schema.Provider {
 			"aws_batch_compute_environment": batch.ResourceComputeEnvironment(),
 			"aws_batch_job_definition":      batch.ResourceJobDefinition(),
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
+			"aws_batch_tag":                 batch.ResourceTag(),
 
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
@@ -836,6 +996,7 @@ func Provider() *schema.Provider {
 			"aws_chime_voice_connector_termination_credentials": chime.ResourceVoiceConnectorTerminationCredentials(),
 
 			"aws_cloud9_environment_ec2": cloud9.ResourceEnvironmentEC2(),
+			"aws_cloud9_tag":             cloud9.ResourceTag(),
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.ResourceResource(),
 
@@ -854,16 +1015,19 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_public_key":              cloudfront.ResourcePublicKey(),
 			"aws_cloudfront_realtime_log_config":     cloudfront.ResourceRealtimeLogConfig(),
 			"aws_cloudfront_response_headers_policy": cloudfront.ResourceResponseHeadersPolicy(),
+			"aws_cloudfront_tag":                     cloudfront.ResourceTag(),
 
 			"aws_cloudhsm_v2_cluster": cloudhsmv2.ResourceCluster(),
 			"aws_cloudhsm_v2_hsm":     cloudhsmv2.ResourceHSM(),
 
-			"aws_cloudtrail": cloudtrail.ResourceCloudTrail(),
+			"aws_cloudtrail":     cloudtrail.ResourceCloudTrail(),
+			"aws_cloudtrail_tag": cloudtrail.ResourceTag(),
 
 			"aws_cloudwatch_composite_alarm": cloudwatch.ResourceCompositeAlarm(),
 			"aws_cloudwatch_dashboard":       cloudwatch.ResourceDashboard(),
 			"aws_cloudwatch_metric_alarm":    cloudwatch.ResourceMetricAlarm(),
 			"aws_cloudwatch_metric_stream":   cloudwatch.ResourceMetricStream(),
+			"aws_cloudwatch_tag":             cloudwatch.ResourceTag(),
 
 			"aws_cloudwatch_event_api_destination": cloudwatchevents.ResourceAPIDestination(),
 			"aws_cloudwatch_event_archive":         cloudwatchevents.ResourceArchive(),
@@ -873,6 +1037,7 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_event_permission":      cloudwatchevents.ResourcePermission(),
 			"aws_cloudwatch_event_rule":            cloudwatchevents.ResourceRule(),
 			"aws_cloudwatch_event_target":          cloudwatchevents.ResourceTarget(),
+			"aws_cloudwatch_event_tag":             cloudwatchevents.ResourceTag(),
 
 			"aws_cloudwatch_log_destination":         cloudwatchlogs.ResourceDestination(),
 			"aws_cloudwatch_log_destination_policy":  cloudwatchlogs.ResourceDestinationPolicy(),
@@ -887,6 +1052,7 @@ func Provider() *schema.Provider {
 			"aws_codeartifact_domain_permissions_policy":     codeartifact.ResourceDomainPermissionsPolicy(),
 			"aws_codeartifact_repository":                    codeartifact.ResourceRepository(),
 			"aws_codeartifact_repository_permissions_policy": codeartifact.ResourceRepositoryPermissionsPolicy(),
+			"aws_codeartifact_tag":                           codeartifact.ResourceTag(),
 
 			"aws_codebuild_project":           codebuild.ResourceProject(),
 			"aws_codebuild_report_group":      codebuild.ResourceReportGroup(),
@@ -894,22 +1060,28 @@ func Provider() *schema.Provider {
 			"aws_codebuild_webhook":           codebuild.ResourceWebhook(),
 
 			"aws_codecommit_repository": codecommit.ResourceRepository(),
+			"aws_codecommit_tag":        codecommit.ResourceTag(),
 			"aws_codecommit_trigger":    codecommit.ResourceTrigger(),
 
 			"aws_codedeploy_app":               codedeploy.ResourceApp(),
 			"aws_codedeploy_deployment_config": codedeploy.ResourceDeploymentConfig(),
 			"aws_codedeploy_deployment_group":  codedeploy.ResourceDeploymentGroup(),
+			"aws_codedeploy_tag":               codedeploy.ResourceTag(),
 
 			"aws_codepipeline":         codepipeline.ResourceCodePipeline(),
+			"aws_codepipeline_tag":     codepipeline.ResourceTag(),
 			"aws_codepipeline_webhook": codepipeline.ResourceWebhook(),
 
 			"aws_codestarconnections_connection": codestarconnections.ResourceConnection(),
 			"aws_codestarconnections_host":       codestarconnections.ResourceHost(),
+			"aws_codestarconnections_tag":        codestarconnections.ResourceTag(),
 
 			"aws_codestarnotifications_notification_rule": codestarnotifications.ResourceNotificationRule(),
+			"aws_codestarnotifications_tag":               codestarnotifications.ResourceTag(),
 
 			"aws_cognito_identity_pool":                  cognitoidentity.ResourcePool(),
 			"aws_cognito_identity_pool_roles_attachment": cognitoidentity.ResourcePoolRolesAttachment(),
+			"aws_cognito_identity_pool_tag":              cognitoidentity.ResourceTag(),
 
 			"aws_cognito_identity_provider":          cognitoidp.ResourceIdentityProvider(),
 			"aws_cognito_resource_server":            cognitoidp.ResourceResourceServer(),
@@ -918,6 +1090,7 @@ func Provider() *schema.Provider {
 			"aws_cognito_user_pool_client":           cognitoidp.ResourceUserPoolClient(),
 			"aws_cognito_user_pool_domain":           cognitoidp.ResourceUserPoolDomain(),
 			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(),
+			"aws_cognito_user_pool_tag":              cognitoidp.ResourceTag(),
 
 			"aws_config_aggregate_authorization":       config.ResourceAggregateAuthorization(),
 			"aws_config_config_rule":                   config.ResourceConfigRule(),
@@ -930,12 +1103,16 @@ func Provider() *schema.Provider {
 			"aws_config_organization_custom_rule":      config.ResourceOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":     config.ResourceOrganizationManagedRule(),
 			"aws_config_remediation_configuration":     config.ResourceRemediationConfiguration(),
+			"aws_config_tag":                           config.ResourceTag(),
 
 			"aws_connect_contact_flow": connect.ResourceContactFlow(),
 			"aws_connect_instance":     connect.ResourceInstance(),
+			"aws_connect_tag":          connect.ResourceTag(),
 
 			"aws_cur_report_definition": cur.ResourceReportDefinition(),
 
+			"aws_dataexchange_tag": dataexchange.ResourceTag(),
+
 			"aws_datapipeline_pipeline": datapipeline.ResourcePipeline(),
 
 			"aws_datasync_agent":                            datasync.ResourceAgent(),
@@ -944,14 +1121,18 @@ func Provider() *schema.Provider {
 			"aws_datasync_location_nfs":                     datasync.ResourceLocationNFS(),
 			"aws_datasync_location_s3":                      datasync.ResourceLocationS3(),
 			"aws_datasync_location_smb":                     datasync.ResourceLocationSMB(),
+			"aws_datasync_tag":                              datasync.ResourceTag(),
 			"aws_datasync_task":                             datasync.ResourceTask(),
 
 			"aws_dax_cluster":         dax.ResourceCluster(),
 			"aws_dax_parameter_group": dax.ResourceParameterGroup(),
 			"aws_dax_subnet_group":    dax.ResourceSubnetGroup(),
+			"aws_dax_tag":             dax.ResourceTag(),
 
 			"aws_devicefarm_project": devicefarm.ResourceProject(),
+			"aws_devicefarm_tag":     devicefarm.ResourceTag(),
 
+			"aws_dx_tag":                                       directconnect.ResourceTag(),
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
 			"aws_dx_connection":                                directconnect.ResourceConnection(),
 			"aws_dx_connection_association":                    directconnect.ResourceConnectionAssociation(),
@@ -966,12 +1147,13 @@ func Provider() *schema.Provider {
 			"aws_dx_hosted_public_virtual_interface_accepter":  directconnect.ResourceHostedPublicVirtualInterfaceAccepter(),
 			"aws_dx_hosted_transit_virtual_interface":          directconnect.ResourceHostedTransitVirtualInterface(),
 			"aws_dx_hosted_transit_virtual_interface_accepter": directconnect.ResourceHostedTransitVirtualInterfaceAccepter(),
-			"aws_dx_lag":                       directconnect.ResourceLag(),
-			"aws_dx_private_virtual_interface": directconnect.ResourcePrivateVirtualInterface(),
-			"aws_dx_public_virtual_interface":  directconnect.ResourcePublicVirtualInterface(),
-			"aws_dx_transit_virtual_interface": directconnect.ResourceTransitVirtualInterface(),
+			"aws_dx_lag":                                       directconnect.ResourceLag(),
+			"aws_dx_private_virtual_interface":                 directconnect.ResourcePrivateVirtualInterface(),
+			"aws_dx_public_virtual_interface":                  directconnect.ResourcePublicVirtualInterface(),
+			"aws_dx_transit_virtual_interface":                 directconnect.ResourceTransitVirtualInterface(),
 
 			"aws_dlm_lifecycle_policy": dlm.ResourceLifecyclePolicy(),
+			"aws_dlm_tag":              dlm.ResourceTag(),
 
 			"aws_dms_certificate":              dms.ResourceCertificate(),
 			"aws_dms_endpoint":                 dms.ResourceEndpoint(),
@@ -979,12 +1161,14 @@ func Provider() *schema.Provider {
 			"aws_dms_replication_instance":     dms.ResourceReplicationInstance(),
 			"aws_dms_replication_subnet_group": dms.ResourceReplicationSubnetGroup(),
 			"aws_dms_replication_task":         dms.ResourceReplicationTask(),
+			"aws_dms_tag":                      dms.ResourceTag(),
 
 			"aws_docdb_cluster":                 docdb.ResourceCluster(),
 			"aws_docdb_cluster_instance":        docdb.ResourceClusterInstance(),
 			"aws_docdb_cluster_parameter_group": docdb.ResourceClusterParameterGroup(),
 			"aws_docdb_cluster_snapshot":        docdb.ResourceClusterSnapshot(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
+			"aws_docdb_tag":                     docdb.ResourceTag(),
 
 			"aws_directory_service_conditional_forwarder": ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
@@ -1081,6 +1265,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                  ec2.ResourceVPCSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                 ec2.ResourceVPCSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
@@ -1092,6 +1278,7 @@ func Provider() *schema.Provider {
 			"aws_ecr_replication_configuration": ecr.ResourceReplicationConfiguration(),
 			"aws_ecr_repository":                ecr.ResourceRepository(),
 			"aws_ecr_repository_policy":         ecr.ResourceRepositoryPolicy(),
+			"aws_ecr_tag":                       ecr.ResourceTag(),
 
 			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
 
@@ -1112,6 +1299,7 @@ func Provider() *schema.Provider {
 			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
 			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
 			"aws_eks_node_group":               eks.ResourceNodeGroup(),
+			"aws_eks_tag":                      eks.ResourceTag(),
 
 			"aws_elasticache_cluster":                  elasticache.ResourceCluster(),
 			"aws_elasticache_global_replication_group": elasticache.ResourceGlobalReplicationGroup(),
@@ -1119,6 +1307,7 @@ func Provider() *schema.Provider {
 			"aws_elasticache_replication_group":        elasticache.ResourceReplicationGroup(),
 			"aws_elasticache_security_group":           elasticache.ResourceSecurityGroup(),
 			"aws_elasticache_subnet_group":             elasticache.ResourceSubnetGroup(),
+			"aws_elasticache_tag":                      elasticache.ResourceTag(),
 			"aws_elasticache_user":                     elasticache.ResourceUser(),
 			"aws_elasticache_user_group":               elasticache.ResourceUserGroup(),
 
@@ -1126,10 +1315,12 @@ func Provider() *schema.Provider {
 			"aws_elastic_beanstalk_application_version":    elasticbeanstalk.ResourceApplicationVersion(),
 			"aws_elastic_beanstalk_configuration_template": elasticbeanstalk.ResourceConfigurationTemplate(),
 			"aws_elastic_beanstalk_environment":            elasticbeanstalk.ResourceEnvironment(),
+			"aws_elastic_beanstalk_tag":                    elasticbeanstalk.ResourceTag(),
 
 			"aws_elasticsearch_domain":              elasticsearch.ResourceDomain(),
 			"aws_elasticsearch_domain_policy":       elasticsearch.ResourceDomainPolicy(),
 			"aws_elasticsearch_domain_saml_options": elasticsearch.ResourceDomainSAMLOptions(),
+			"aws_elasticsearch_tag":                 elasticsearch.ResourceTag(),
 
 			"aws_elastictranscoder_pipeline": elastictranscoder.ResourcePipeline(),
 			"aws_elastictranscoder_preset":   elastictranscoder.ResourcePreset(),
@@ -1150,6 +1341,7 @@ func Provider() *schema.Provider {
 			"aws_alb_listener_rule":           elbv2.ResourceListenerRule(),
 			"aws_alb_target_group":            elbv2.ResourceTargetGroup(),
 			"aws_alb_target_group_attachment": elbv2.ResourceTargetGroupAttachment(),
+			"aws_lb_tag":                      elbv2.ResourceTag(),
 			"aws_lb":                          elbv2.ResourceLoadBalancer(),
 			"aws_lb_listener":                 elbv2.ResourceListener(),
 			"aws_lb_listener_certificate":     elbv2.ResourceListenerCertificate(),
@@ -1171,12 +1363,14 @@ func Provider() *schema.Provider {
 			"aws_fsx_backup":              fsx.ResourceBackup(),
 			"aws_fsx_lustre_file_system":  fsx.ResourceLustreFileSystem(),
 			"aws_fsx_ontap_file_system":   fsx.ResourceOntapFileSystem(),
+			"aws_fsx_tag":                 fsx.ResourceTag(),
 			"aws_fsx_windows_file_system": fsx.ResourceWindowsFileSystem(),
 
 			"aws_gamelift_alias":              gamelift.ResourceAlias(),
 			"aws_gamelift_build":              gamelift.ResourceBuild(),
 			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
 			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
+			"aws_gamelift_tag":                gamelift.ResourceTag(),
 
 			"aws_glacier_vault":      glacier.ResourceVault(),
 			"aws_glacier_vault_lock": glacier.ResourceVaultLock(),
@@ -1184,6 +1378,7 @@ func Provider() *schema.Provider {
 			"aws_globalaccelerator_accelerator":    globalaccelerator.ResourceAccelerator(),
 			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(),
 			"aws_globalaccelerator_listener":       globalaccelerator.ResourceListener(),
+			"aws_globalaccelerator_tag":            globalaccelerator.ResourceTag(),
 
 			"aws_glue_catalog_database":                 glue.ResourceCatalogDatabase(),
 			"aws_glue_catalog_table":                    glue.ResourceCatalogTable(),
@@ -1200,10 +1395,13 @@ func Provider() *schema.Provider {
 			"aws_glue_resource_policy":                  glue.ResourceResourcePolicy(),
 			"aws_glue_schema":                           glue.ResourceSchema(),
 			"aws_glue_security_configuration":           glue.ResourceSecurityConfiguration(),
+			"aws_glue_tag":                              glue.ResourceTag(),
 			"aws_glue_trigger":                          glue.ResourceTrigger(),
 			"aws_glue_user_defined_function":            glue.ResourceUserDefinedFunction(),
 			"aws_glue_workflow":                         glue.ResourceWorkflow(),
 
+			"aws_greengrass_tag": greengrass.ResourceTag(),
+
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
@@ -1212,6 +1410,7 @@ func Provider() *schema.Provider {
 			"aws_guardduty_organization_admin_account": guardduty.ResourceOrganizationAdminAccount(),
 			"aws_guardduty_organization_configuration": guardduty.ResourceOrganizationConfiguration(),
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
+			"aws_guardduty_tag":                        guardduty.ResourceTag(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
 			"aws_iam_access_key":              iam.ResourceAccessKey(),
@@ -1244,6 +1443,7 @@ func Provider() *schema.Provider {
 			"aws_imagebuilder_image_pipeline":               imagebuilder.ResourceImagePipeline(),
 			"aws_imagebuilder_image_recipe":                 imagebuilder.ResourceImageRecipe(),
 			"aws_imagebuilder_infrastructure_configuration": imagebuilder.ResourceInfrastructureConfiguration(),
+			"aws_imagebuilder_tag":                          imagebuilder.ResourceTag(),
 
 			"aws_inspector_assessment_target":   inspector.ResourceAssessmentTarget(),
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
@@ -1254,11 +1454,17 @@ func Provider() *schema.Provider {
 			"aws_iot_policy":                     iot.ResourcePolicy(),
 			"aws_iot_policy_attachment":          iot.ResourcePolicyAttachment(),
 			"aws_iot_role_alias":                 iot.ResourceRoleAlias(),
+			"aws_iot_tag":                        iot.ResourceTag(),
 			"aws_iot_thing":                      iot.ResourceThing(),
 			"aws_iot_thing_principal_attachment": iot.ResourceThingPrincipalAttachment(),
 			"aws_iot_thing_type":                 iot.ResourceThingType(),
 			"aws_iot_topic_rule":                 iot.ResourceTopicRule(),
 
+			"aws_iotanalytics_tag": iotanalytics.ResourceTag(),
+
+			"aws_iotevents_tag": iotevents.ResourceTag(),
+
+			"aws_msk_tag":                      kafka.ResourceTag(),
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
@@ -1267,10 +1473,13 @@ func Provider() *schema.Provider {
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
 
 			"aws_kinesis_analytics_application":           kinesisanalytics.ResourceApplication(),
+			"aws_kinesis_analytics_tag":                   kinesisanalytics.ResourceTag(),
 			"aws_kinesisanalyticsv2_application":          kinesisanalyticsv2.ResourceApplication(),
 			"aws_kinesisanalyticsv2_application_snapshot": kinesisanalyticsv2.ResourceApplicationSnapshot(),
+			"aws_kinesisanalyticsv2_tag":                  kinesisanalyticsv2.ResourceTag(),
 
 			"aws_kinesis_video_stream": kinesisvideo.ResourceStream(),
+			"aws_kinesis_video_tag":    kinesisvideo.ResourceTag(),
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
@@ -1279,6 +1488,7 @@ func Provider() *schema.Provider {
 			"aws_kms_key":                  kms.ResourceKey(),
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
+			"aws_kms_tag":                  kms.ResourceTag(),
 
 			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
 			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
@@ -1292,6 +1502,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
 			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(),
+			"aws_lambda_tag":                            lambda.ResourceTag(),
 
 			"aws_lex_bot":       lexmodelbuilding.ResourceBot(),
 			"aws_lex_bot_alias": lexmodelbuilding.ResourceBotAlias(),
@@ -1300,6 +1511,7 @@ func Provider() *schema.Provider {
 
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
+			"aws_licensemanager_tag":                   licensemanager.ResourceTag(),
 
 			"aws_lightsail_domain":                lightsail.ResourceDomain(),
 			"aws_lightsail_instance":              lightsail.ResourceInstance(),
@@ -1319,17 +1531,26 @@ func Provider() *schema.Provider {
 			"aws_macie2_member":                     macie2.ResourceMember(),
 			"aws_macie2_organization_admin_account": macie2.ResourceOrganizationAdminAccount(),
 
+			"aws_mediaconnect_tag": mediaconnect.ResourceTag(),
+
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
+			"aws_media_convert_tag":   mediaconvert.ResourceTag(),
+
+			"aws_medialive_tag": medialive.ResourceTag(),
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
+			"aws_media_package_tag":     mediapackage.ResourceTag(),
 
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
+			"aws_media_store_tag":              mediastore.ResourceTag(),
 
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
+			"aws_mq_tag":           mq.ResourceTag(),
 
 			"aws_mwaa_environment": mwaa.ResourceEnvironment(),
+			"aws_mwaa_tag":         mwaa.ResourceTag(),
 
 			"aws_neptune_cluster":                 neptune.ResourceCluster(),
 			"aws_neptune_cluster_endpoint":        neptune.ResourceClusterEndpoint(),
@@ -1339,12 +1560,16 @@ func Provider() *schema.Provider {
 			"aws_neptune_event_subscription":      neptune.ResourceEventSubscription(),
 			"aws_neptune_parameter_group":         neptune.ResourceParameterGroup(),
 			"aws_neptune_subnet_group":            neptune.ResourceSubnetGroup(),
+			"aws_neptune_tag":                     neptune.ResourceTag(),
 
 			"aws_networkfirewall_firewall":              networkfirewall.ResourceFirewall(),
 			"aws_networkfirewall_firewall_policy":       networkfirewall.ResourceFirewallPolicy(),
 			"aws_networkfirewall_logging_configuration": networkfirewall.ResourceLoggingConfiguration(),
 			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
+			"aws_networkfirewall_tag":                   networkfirewall.ResourceTag(),
+
+			"aws_networkmanager_tag": networkmanager.ResourceTag(),
 
 			"aws_opsworks_application":      opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":     opsworks.ResourceCustomLayer(),
@@ -1361,6 +1586,7 @@ func Provider() *schema.Provider {
 			"aws_opsworks_rds_db_instance":  opsworks.ResourceRDSDBInstance(),
 			"aws_opsworks_stack":            opsworks.ResourceStack(),
 			"aws_opsworks_static_web_layer": opsworks.ResourceStaticWebLayer(),
+			"aws_opsworks_tag":              opsworks.ResourceTag(),
 			"aws_opsworks_user_profile":     opsworks.ResourceUserProfile(),
 
 			"aws_organizations_account":                 organizations.ResourceAccount(),
@@ -1381,16 +1607,19 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_event_stream":              pinpoint.ResourceEventStream(),
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
+			"aws_pinpoint_tag":                       pinpoint.ResourceTag(),
 
 			"aws_prometheus_workspace":                prometheus.ResourceWorkspace(),
 			"aws_prometheus_alert_manager_definition": prometheus.ResourceAlertManagerDefinition(),
 			"aws_prometheus_rule_group_namespace":     prometheus.ResourceRuleGroupNamespace(),
 
 			"aws_qldb_ledger": qldb.ResourceLedger(),
+			"aws_qldb_tag":    qldb.ResourceTag(),
 
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
 			"aws_quicksight_group":            quicksight.ResourceGroup(),
 			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
+			"aws_quicksight_tag":              quicksight.ResourceTag(),
 			"aws_quicksight_user":             quicksight.ResourceUser(),
 
 			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
@@ -1417,6 +1646,7 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(),
 			"aws_rds_global_cluster":            rds.ResourceGlobalCluster(),
+			"aws_rds_tag":                       rds.ResourceTag(),
 
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
 			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
@@ -1429,6 +1659,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
 
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
+			"aws_resourcegroups_tag":   resourcegroups.ResourceTag(),
 
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
@@ -1449,6 +1680,7 @@ func Provider() *schema.Provider {
 			"aws_route53recoveryreadiness_readiness_check": route53recoveryreadiness.ResourceReadinessCheck(),
 			"aws_route53recoveryreadiness_recovery_group":  route53recoveryreadiness.ResourceRecoveryGroup(),
 			"aws_route53recoveryreadiness_resource_set":    route53recoveryreadiness.ResourceResourceSet(),
+			"aws_route53recoveryreadiness_tag":             route53recoveryreadiness.ResourceTag(),
 
 			"aws_route53_resolver_dnssec_config":                   route53resolver.ResourceDNSSECConfig(),
 			"aws_route53_resolver_endpoint":                        route53resolver.ResourceEndpoint(),
@@ -1461,17 +1693,32 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_query_log_config_association":    route53resolver.ResourceQueryLogConfigAssociation(),
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
-
-			"aws_s3_bucket":                         s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration": s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_inventory":               s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                  s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":            s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                  s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":      s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                  s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":     s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_object_copy":                    s3.ResourceObjectCopy(),
+			"aws_route53_resolver_tag":                             route53resolver.ResourceTag(),
+
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_accelerate_configuration":             s3.ResourceBucketAccelerateConfiguration(),
+			"aws_s3_bucket_acl":                                  s3.ResourceBucketACL(),
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCORSConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":        s3.ResourceBucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_sync":                              s3.ResourceDirectorySync(),
+			"aws_s3_object":                                      s3.ResourceObject(),
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                          s3control.ResourceAccessPoint(),
 			"aws_s3_account_public_access_block":           s3control.ResourceAccountPublicAccessBlock(),
@@ -1499,6 +1746,7 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
 			"aws_sagemaker_studio_lifecycle_config":                   sagemaker.ResourceStudioLifecycleConfig(),
+			"aws_sagemaker_tag":                                       sagemaker.ResourceTag(),
 			"aws_sagemaker_user_profile":                              sagemaker.ResourceUserProfile(),
 			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
 			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
@@ -1506,11 +1754,13 @@ func Provider() *schema.Provider {
 			"aws_schemas_discoverer": schemas.ResourceDiscoverer(),
 			"aws_schemas_registry":   schemas.ResourceRegistry(),
 			"aws_schemas_schema":     schemas.ResourceSchema(),
+			"aws_schemas_tag":        schemas.ResourceTag(),
 
 			"aws_secretsmanager_secret":          secretsmanager.ResourceSecret(),
 			"aws_secretsmanager_secret_policy":   secretsmanager.ResourceSecretPolicy(),
 			"aws_secretsmanager_secret_rotation": secretsmanager.ResourceSecretRotation(),
 			"aws_secretsmanager_secret_version":  secretsmanager.ResourceSecretVersion(),
+			"aws_secretsmanager_tag":             secretsmanager.ResourceTag(),
 
 			"aws_securityhub_account":                    securityhub.ResourceAccount(),
 			"aws_securityhub_action_target":              securityhub.ResourceActionTarget(),
@@ -1522,6 +1772,7 @@ func Provider() *schema.Provider {
 			"aws_securityhub_product_subscription":       securityhub.ResourceProductSubscription(),
 			"aws_securityhub_standards_control":          securityhub.ResourceStandardsControl(),
 			"aws_securityhub_standards_subscription":     securityhub.ResourceStandardsSubscription(),
+			"aws_securityhub_tag":                        securityhub.ResourceTag(),
 
 			"aws_serverlessapplicationrepository_cloudformation_stack": serverlessapprepo.ResourceCloudFormationStack(),
 
@@ -1544,6 +1795,7 @@ func Provider() *schema.Provider {
 			"aws_service_discovery_private_dns_namespace": servicediscovery.ResourcePrivateDNSNamespace(),
 			"aws_service_discovery_public_dns_namespace":  servicediscovery.ResourcePublicDNSNamespace(),
 			"aws_service_discovery_service":               servicediscovery.ResourceService(),
+			"aws_service_discovery_tag":                   servicediscovery.ResourceTag(),
 
 			"aws_servicequotas_service_quota": servicequotas.ResourceServiceQuota(),
 
@@ -1564,18 +1816,22 @@ func Provider() *schema.Provider {
 
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
+			"aws_sfn_tag":           sfn.ResourceTag(),
 
 			"aws_shield_protection":       shield.ResourceProtection(),
 			"aws_shield_protection_group": shield.ResourceProtectionGroup(),
+			"aws_shield_tag":              shield.ResourceTag(),
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
 			"aws_signer_signing_profile_permission": signer.ResourceSigningProfilePermission(),
+			"aws_signer_tag":                        signer.ResourceTag(),
 
 			"aws_simpledb_domain": simpledb.ResourceDomain(),
 
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
 			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
+			"aws_sns_tag":                  sns.ResourceTag(),
 			"aws_sns_topic":                sns.ResourceTopic(),
 			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
 			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
@@ -1606,20 +1862,25 @@ func Provider() *schema.Provider {
 			"aws_storagegateway_nfs_file_share":          storagegateway.ResourceNFSFileShare(),
 			"aws_storagegateway_smb_file_share":          storagegateway.ResourceSMBFileShare(),
 			"aws_storagegateway_stored_iscsi_volume":     storagegateway.ResourceStorediSCSIVolume(),
+			"aws_storagegateway_tag":                     storagegateway.ResourceTag(),
 			"aws_storagegateway_tape_pool":               storagegateway.ResourceTapePool(),
 			"aws_storagegateway_upload_buffer":           storagegateway.ResourceUploadBuffer(),
 			"aws_storagegateway_working_storage":         storagegateway.ResourceWorkingStorage(),
 
 			"aws_swf_domain": swf.ResourceDomain(),
+			"aws_swf_tag":    swf.ResourceTag(),
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
+			"aws_synthetics_tag":    synthetics.ResourceTag(),
 
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
+			"aws_timestreamwrite_tag":      timestreamwrite.ResourceTag(),
 
 			"aws_transfer_access":  transfer.ResourceAccess(),
 			"aws_transfer_server":  transfer.ResourceServer(),
 			"aws_transfer_ssh_key": transfer.ResourceSSHKey(),
+			"aws_transfer_tag":     transfer.ResourceTag(),
 			"aws_transfer_user":    transfer.ResourceUser(),
 
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
@@ -1632,6 +1893,7 @@ func Provider() *schema.Provider {
 			"aws_waf_rule_group":              waf.ResourceRuleGroup(),
 			"aws_waf_size_constraint_set":     waf.ResourceSizeConstraintSet(),
 			"aws_waf_sql_injection_match_set": waf.ResourceSQLInjectionMatchSet(),
+			"aws_waf_tag":                     waf.ResourceTag(),
 			"aws_waf_web_acl":                 waf.ResourceWebACL(),
 			"aws_waf_xss_match_set":           waf.ResourceXSSMatchSet(),
 
@@ -1645,6 +1907,7 @@ func Provider() *schema.Provider {
 			"aws_wafregional_rule_group":              wafregional.ResourceRuleGroup(),
 			"aws_wafregional_size_constraint_set":     wafregional.ResourceSizeConstraintSet(),
 			"aws_wafregional_sql_injection_match_set": wafregional.ResourceSQLInjectionMatchSet(),
+			"aws_wafregional_tag":                     wafregional.ResourceTag(),
 			"aws_wafregional_web_acl":                 wafregional.ResourceWebACL(),
 			"aws_wafregional_web_acl_association":     wafregional.ResourceWebACLAssociation(),
 			"aws_wafregional_xss_match_set":           wafregional.ResourceXSSMatchSet(),
@@ -1652,11 +1915,13 @@ func Provider() *schema.Provider {
 			"aws_wafv2_ip_set":                        wafv2.ResourceIPSet(),
 			"aws_wafv2_regex_pattern_set":             wafv2.ResourceRegexPatternSet(),
 			"aws_wafv2_rule_group":                    wafv2.ResourceRuleGroup(),
+			"aws_wafv2_tag":                           wafv2.ResourceTag(),
 			"aws_wafv2_web_acl":                       wafv2.ResourceWebACL(),
 			"aws_wafv2_web_acl_association":           wafv2.ResourceWebACLAssociation(),
 			"aws_wafv2_web_acl_logging_configuration": wafv2.ResourceWebACLLoggingConfiguration(),
 
 			"aws_worklink_fleet": worklink.ResourceFleet(),
+			"aws_worklink_tag":   worklink.ResourceTag(),
 			"aws_worklink_website_certificate_authority_association": worklink.ResourceWebsiteCertificateAuthorityAssociation(),
 
 			"aws_workspaces_directory": workspaces.ResourceDirectory(),
@@ -1666,17 +1931,40 @@ func Provider() *schema.Provider {
 			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(),
 			"aws_xray_group":             xray.ResourceGroup(),
 			"aws_xray_sampling_rule":     xray.ResourceSamplingRule(),
+			"aws_xray_tag":               xray.ResourceTag(),
 		},
 	}
 
-	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	sensitiveAttributes := make(map[string][]string)
+
+	for resourceType, r := range provider.DataSourcesMap {
+		wrapResourceContext(resourceType, r)
+
+		sensitiveAttributes[resourceType] = append(sensitiveAttributes[resourceType], sensitiveAttributeNames(r.Schema)...)
+	}
+
+	for resourceType, r := range provider.ResourcesMap {
+		wrapResourceContext(resourceType, r)
+		wrapResourceMeta(resourceType, r)
+
+		sensitiveAttributes[resourceType] = append(sensitiveAttributes[resourceType], sensitiveAttributeNames(r.Schema)...)
+	}
+
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+
+		client, err := providerConfigure(ctx, d, terraformVersion, sensitiveAttributes)
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return client, nil
 	}
 
 	return provider
@@ -1713,6 +2001,21 @@ func init() {
 
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"ec2_metadata_service_endpoint": "Address of the EC2 metadata service endpoint to use. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with EC2 metadata service endpoint. " +
+			"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
+		"eventual_consistency_timeout": "How long to retry reads of new resources that are not yet visible because of eventual consistency, " +
+			"e.g. `5m`. Overrides the default timeout of every service. `0s` disables the retries.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
@@ -1735,30 +2038,46 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability. " +
+			"Explicitly configured endpoints take precedence.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability. " +
+			"Explicitly configured endpoints take precedence.",
 	}
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string, sensitiveAttributes map[string][]string) (interface{}, error) {
 	config := conns.Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		HTTPProxy:               d.Get("http_proxy").(string),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		TerraformVersion:        terraformVersion,
+		AccessKey:                      d.Get("access_key").(string),
+		SecretKey:                      d.Get("secret_key").(string),
+		Profile:                        d.Get("profile").(string),
+		Token:                          d.Get("token").(string),
+		Region:                         d.Get("region").(string),
+		CredsFilename:                  d.Get("shared_credentials_file").(string),
+		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Endpoints:                      make(map[string]string),
+		MaxRetries:                     d.Get("max_retries").(int),
+		RateLimits:                     make(map[string]conns.RateLimit),
+		RequiredTagsConfig:             expandProviderRequiredTags(d.Get("required_tags").([]interface{})),
+		RetryMode:                      d.Get("retry_mode").(string),
+		SensitiveAttributes:            sensitiveAttributes,
+		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		Insecure:                       d.Get("insecure").(bool),
+		HTTPProxy:                      d.Get("http_proxy").(string),
+		HTTPTransportWrapper:           conns.HTTPTransportWrapperFromContext(ctx),
+		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
+		TerraformVersion:               terraformVersion,
+		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -1825,6 +2144,48 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityPolicy = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityRoleARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, TokenFile: %q)", config.AssumeRoleWithWebIdentityRoleARN, config.AssumeRoleWithWebIdentitySessionName, config.AssumeRoleWithWebIdentityTokenFile)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1843,6 +2204,28 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	for _, tfMapRaw := range d.Get("rate_limit").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		hclKey := tfMap["service"].(string)
+
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit (%s): %w", hclKey, err)
+		}
+
+		config.RateLimits[serviceKey] = conns.RateLimit{
+			Burst:             tfMap["burst"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	if v, ok := d.GetOk("eventual_consistency_timeout"); ok {
+		// The duration has already been validated.
+		timeout, _ := time.ParseDuration(v.(string))
+		config.EventualConsistencyTimeout = &timeout
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1855,6 +2238,18 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("allowed_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			config.AllowedRegions = append(config.AllowedRegions, regionRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("forbidden_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			config.ForbiddenRegions = append(config.ForbiddenRegions, regionRaw.(string))
+		}
+	}
+
 	return config.Client()
 }
 
@@ -1918,6 +2313,96 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarRoleARN, nil),
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarRoleSessionName, nil),
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "The OAuth 2.0 access token or OpenID Connect ID token that is provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc(conns.EnvVarWebIdentityTokenFile, nil),
+					Description:   "File containing the OAuth 2.0 access token or OpenID Connect ID token that is provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration block with client-side limits on the rate of requests to a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Number of requests that can be made before the rate is limited. Defaults to `requests_per_second`, rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					Description:  "Sustained rate of requests.",
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Service, using the same names as the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1950,9 +2435,85 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
+
+	if v, ok := m["resource_type_tags"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rc := &tftags.ResourceTypeDefaultConfig{}
+
+			if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+				for _, resourceTypeRaw := range v.List() {
+					rc.ExcludeResourceTypes = append(rc.ExcludeResourceTypes, resourceTypeRaw.(string))
+				}
+			}
+
+			if v, ok := tfMap["include_resource_types"].(*schema.Set); ok {
+				for _, resourceTypeRaw := range v.List() {
+					rc.IncludeResourceTypes = append(rc.IncludeResourceTypes, resourceTypeRaw.(string))
+				}
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				rc.Tags = tftags.New(v)
+			}
+
+			defaultConfig.ResourceTypeConfigs = append(defaultConfig.ResourceTypeConfigs, rc)
+		}
+	}
+
 	return defaultConfig
 }
 
+func expandProviderRequiredTags(l []interface{}) *tftags.RequiredConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := &tftags.RequiredRule{}
+
+		if v, ok := m["exclude_resource_types"].(*schema.Set); ok {
+			for _, resourceTypeRaw := range v.List() {
+				rule.ExcludeResourceTypes = append(rule.ExcludeResourceTypes, resourceTypeRaw.(string))
+			}
+		}
+
+		if v, ok := m["include_resource_types"].(*schema.Set); ok {
+			for _, resourceTypeRaw := range v.List() {
+				rule.IncludeResourceTypes = append(rule.IncludeResourceTypes, resourceTypeRaw.(string))
+			}
+		}
+
+		if v, ok := m["keys"].(*schema.Set); ok {
+			for _, keyRaw := range v.List() {
+				rule.Keys = append(rule.Keys, keyRaw.(string))
+			}
+		}
+
+		// The pattern has already been validated.
+		if v, ok := m["value_pattern"].(string); ok && v != "" {
+			rule.ValuePattern = regexp.MustCompile(v)
+		}
+
+		requiredConfig.Rules = append(requiredConfig.Rules, rule)
+	}
+
+	return requiredConfig
+}
+
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1971,3 +2532,119 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 
 	return ignoreConfig
 }
+
+// wrapResourceContext wraps the CRUD functions of the specified resource
+// so that the API calls they make are attributed to the resource in request logs.
+// Functions that do not pass a context to the AWS Go SDK, such as the legacy CRUD functions,
+// are passed a provider client whose service clients attribute their API calls to the resource.
+func wrapResourceContext(resourceType string, r *schema.Resource) {
+	forResource := func(d *schema.ResourceData, meta interface{}) interface{} {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			return client.ForResource(resourceType, d.Id())
+		}
+
+		return meta
+	}
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, forResource(d, meta))
+		}
+	}
+
+	wrapContext := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(conns.NewResourceContext(ctx, resourceType, d.Id()), d, forResource(d, meta))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+	r.CreateContext = wrapContext(r.CreateContext)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout)
+	r.ReadContext = wrapContext(r.ReadContext)
+	r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout)
+	r.UpdateContext = wrapContext(r.UpdateContext)
+	r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout)
+	r.DeleteContext = wrapContext(r.DeleteContext)
+	r.DeleteWithoutTimeout = wrapContext(r.DeleteWithoutTimeout)
+}
+
+// wrapResourceMeta wraps the CRUD and CustomizeDiff functions of the specified resource
+// so that they are passed the provider client for the resource type, e.g. with default tags resolved for it.
+func wrapResourceMeta(resourceType string, r *schema.Resource) {
+	forResourceType := func(meta interface{}) interface{} {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			return client.ForResourceType(resourceType)
+		}
+
+		return meta
+	}
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, forResourceType(meta))
+		}
+	}
+
+	wrapContext := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, forResourceType(meta))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+	r.CreateContext = wrapContext(r.CreateContext)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout)
+	r.ReadContext = wrapContext(r.ReadContext)
+	r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout)
+	r.UpdateContext = wrapContext(r.UpdateContext)
+	r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout)
+	r.DeleteContext = wrapContext(r.DeleteContext)
+	r.DeleteWithoutTimeout = wrapContext(r.DeleteWithoutTimeout)
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return f(ctx, diff, forResourceType(meta))
+		}
+	}
+}
+
+// sensitiveAttributeNames returns the names of the sensitive attributes in the specified schema, including nested blocks.
+func sensitiveAttributeNames(m map[string]*schema.Schema) []string {
+	var names []string
+
+	for name, s := range m {
+		if s.Sensitive {
+			names = append(names, name)
+			continue
+		}
+
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			names = append(names, sensitiveAttributeNames(elem.Schema)...)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,173 @@
+// Code generated by internal/generate/tagresource/main.go; DO NOT EDIT.
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAccessAnalyzerTag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(accessanalyzer.EndpointsID, t)
+			testAccPreCheckTag(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_arn"),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerTag_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(accessanalyzer.EndpointsID, t)
+			testAccPreCheckTag(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfaccessanalyzer.ResourceTag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerTag_value(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(accessanalyzer.EndpointsID, t)
+			testAccPreCheckTag(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTagConfig(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_accessanalyzer_tag" {
+			continue
+		}
+
+		identifier, key, err := tftags.GetResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfaccessanalyzer.GetTag(conn, identifier, key)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("%s resource (%s) tag (%s) still exists", accessanalyzer.ServiceID, identifier, key)
+	}
+
+	return nil
+}
+
+func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("%s: missing resource ID", resourceName)
+		}
+
+		identifier, key, err := tftags.GetResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn()
+
+		_, err = tfaccessanalyzer.GetTag(conn, identifier, key)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
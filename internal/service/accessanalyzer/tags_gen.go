@@ -2,22 +2,53 @@
 package accessanalyzer
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// GetTag fetches an individual accessanalyzer service tag for a resource.
+// Returns whether the key value and any errors. A NotFoundError is used to signal that no value was found.
+// This function will optimise the handling over ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GetTag(conn *accessanalyzer.AccessAnalyzer, identifier string, key string) (*string, error) {
+	return GetTagWithContext(context.Background(), conn, identifier, key)
+}
+
+// GetTagWithContext is the same as GetTag with the addition of the ability to pass a context.
+func GetTagWithContext(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, identifier string, key string) (*string, error) {
+	listTags, err := ListTagsWithContext(ctx, conn, identifier)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !listTags.KeyExists(key) {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	return listTags.KeyValue(key), nil
+}
+
 // ListTags lists accessanalyzer service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func ListTags(conn *accessanalyzer.AccessAnalyzer, identifier string) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier)
+}
+
+// ListTagsWithContext is the same as ListTags with the addition of the ability to pass a context.
+func ListTagsWithContext(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, identifier string) (tftags.KeyValueTags, error) {
 	input := &accessanalyzer.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(ctx, input)
 
 	if err != nil {
 		return tftags.New(nil), err
@@ -42,6 +73,11 @@ func KeyValueTags(tags map[string]*string) tftags.KeyValueTags {
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func UpdateTags(conn *accessanalyzer.AccessAnalyzer, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, oldTagsMap, newTagsMap)
+}
+
+// UpdateTagsWithContext is the same as UpdateTags with the addition of the ability to pass a context.
+func UpdateTagsWithContext(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -51,7 +87,7 @@ func UpdateTags(conn *accessanalyzer.AccessAnalyzer, identifier string, oldTagsM
 			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
 		}
 
-		_, err := conn.UntagResource(input)
+		_, err := conn.UntagResourceWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
@@ -64,7 +100,7 @@ func UpdateTags(conn *accessanalyzer.AccessAnalyzer, identifier string, oldTagsM
 			Tags:        Tags(updatedTags.IgnoreAWS()),
 		}
 
-		_, err := conn.TagResource(input)
+		_, err := conn.TagResourceWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
@@ -0,0 +1,38 @@
+package acm_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "%[1]s.com"
+    }
+  }
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name               = "test.%[1]s.com"
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_acm_tag" "test" {
+  resource_arn = aws_acm_certificate.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -2,22 +2,53 @@
 package acm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// GetTag fetches an individual acm service tag for a resource.
+// Returns whether the key value and any errors. A NotFoundError is used to signal that no value was found.
+// This function will optimise the handling over ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GetTag(conn *acm.ACM, identifier string, key string) (*string, error) {
+	return GetTagWithContext(context.Background(), conn, identifier, key)
+}
+
+// GetTagWithContext is the same as GetTag with the addition of the ability to pass a context.
+func GetTagWithContext(ctx context.Context, conn *acm.ACM, identifier string, key string) (*string, error) {
+	listTags, err := ListTagsWithContext(ctx, conn, identifier)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !listTags.KeyExists(key) {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	return listTags.KeyValue(key), nil
+}
+
 // ListTags lists acm service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func ListTags(conn *acm.ACM, identifier string) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier)
+}
+
+// ListTagsWithContext is the same as ListTags with the addition of the ability to pass a context.
+func ListTagsWithContext(ctx context.Context, conn *acm.ACM, identifier string) (tftags.KeyValueTags, error) {
 	input := &acm.ListTagsForCertificateInput{
 		CertificateArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForCertificate(input)
+	output, err := conn.ListTagsForCertificateWithContext(ctx, input)
 
 	if err != nil {
 		return tftags.New(nil), err
@@ -59,6 +90,11 @@ func KeyValueTags(tags []*acm.Tag) tftags.KeyValueTags {
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func UpdateTags(conn *acm.ACM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, oldTagsMap, newTagsMap)
+}
+
+// UpdateTagsWithContext is the same as UpdateTags with the addition of the ability to pass a context.
+func UpdateTagsWithContext(ctx context.Context, conn *acm.ACM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -68,7 +104,7 @@ func UpdateTags(conn *acm.ACM, identifier string, oldTagsMap interface{}, newTag
 			Tags:           Tags(removedTags.IgnoreAWS()),
 		}
 
-		_, err := conn.RemoveTagsFromCertificate(input)
+		_, err := conn.RemoveTagsFromCertificateWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
@@ -81,7 +117,7 @@ func UpdateTags(conn *acm.ACM, identifier string, oldTagsMap interface{}, newTag
 			Tags:           Tags(updatedTags.IgnoreAWS()),
 		}
 
-		_, err := conn.AddTagsToCertificate(input)
+		_, err := conn.AddTagsToCertificateWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
@@ -0,0 +1,170 @@
+// Code generated by internal/generate/tagresource/main.go; DO NOT EDIT.
+
+package acmpca_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfacmpca "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccACMPCATag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_acmpca_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(acmpca.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, acmpca.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_arn"),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccACMPCATag_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_acmpca_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(acmpca.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, acmpca.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfacmpca.ResourceTag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccACMPCATag_value(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_acmpca_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(acmpca.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, acmpca.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTagConfig(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_acmpca_tag" {
+			continue
+		}
+
+		identifier, key, err := tftags.GetResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfacmpca.GetTag(conn, identifier, key)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("%s resource (%s) tag (%s) still exists", acmpca.ServiceID, identifier, key)
+	}
+
+	return nil
+}
+
+func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("%s: missing resource ID", resourceName)
+		}
+
+		identifier, key, err := tftags.GetResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn()
+
+		_, err = tfacmpca.GetTag(conn, identifier, key)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
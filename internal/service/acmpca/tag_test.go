@@ -0,0 +1,32 @@
+package acmpca_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "%[1]s.com"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_acmpca_tag" "test" {
+  resource_arn = aws_acmpca_certificate_authority.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -2,22 +2,53 @@
 package acmpca
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// GetTag fetches an individual acmpca service tag for a resource.
+// Returns whether the key value and any errors. A NotFoundError is used to signal that no value was found.
+// This function will optimise the handling over ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GetTag(conn *acmpca.ACMPCA, identifier string, key string) (*string, error) {
+	return GetTagWithContext(context.Background(), conn, identifier, key)
+}
+
+// GetTagWithContext is the same as GetTag with the addition of the ability to pass a context.
+func GetTagWithContext(ctx context.Context, conn *acmpca.ACMPCA, identifier string, key string) (*string, error) {
+	listTags, err := ListTagsWithContext(ctx, conn, identifier)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !listTags.KeyExists(key) {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	return listTags.KeyValue(key), nil
+}
+
 // ListTags lists acmpca service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func ListTags(conn *acmpca.ACMPCA, identifier string) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier)
+}
+
+// ListTagsWithContext is the same as ListTags with the addition of the ability to pass a context.
+func ListTagsWithContext(ctx context.Context, conn *acmpca.ACMPCA, identifier string) (tftags.KeyValueTags, error) {
 	input := &acmpca.ListTagsInput{
 		CertificateAuthorityArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(ctx, input)
 
 	if err != nil {
 		return tftags.New(nil), err
@@ -59,6 +90,11 @@ func KeyValueTags(tags []*acmpca.Tag) tftags.KeyValueTags {
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func UpdateTags(conn *acmpca.ACMPCA, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, oldTagsMap, newTagsMap)
+}
+
+// UpdateTagsWithContext is the same as UpdateTags with the addition of the ability to pass a context.
+func UpdateTagsWithContext(ctx context.Context, conn *acmpca.ACMPCA, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -68,7 +104,7 @@ func UpdateTags(conn *acmpca.ACMPCA, identifier string, oldTagsMap interface{},
 			Tags:                    Tags(removedTags.IgnoreAWS()),
 		}
 
-		_, err := conn.UntagCertificateAuthority(input)
+		_, err := conn.UntagCertificateAuthorityWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
@@ -81,7 +117,7 @@ func UpdateTags(conn *acmpca.ACMPCA, identifier string, oldTagsMap interface{},
 			Tags:                    Tags(updatedTags.IgnoreAWS()),
 		}
 
-		_, err := conn.TagCertificateAuthority(input)
+		_, err := conn.TagCertificateAuthorityWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
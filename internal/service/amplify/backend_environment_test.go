@@ -17,7 +17,7 @@ import (
 
 func testAccBackendEnvironment_basic(t *testing.T) {
 	var env amplify.BackendEnvironment
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_amplify_backend_environment.test"
 
 	environmentName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
@@ -49,7 +49,7 @@ func testAccBackendEnvironment_basic(t *testing.T) {
 
 func testAccBackendEnvironment_disappears(t *testing.T) {
 	var env amplify.BackendEnvironment
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_amplify_backend_environment.test"
 
 	environmentName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
@@ -74,7 +74,7 @@ func testAccBackendEnvironment_disappears(t *testing.T) {
 
 func testAccBackendEnvironment_DeploymentArtifacts_StackName(t *testing.T) {
 	var env amplify.BackendEnvironment
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_amplify_backend_environment.test"
 
 	environmentName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
@@ -121,7 +121,7 @@ func testAccCheckBackendEnvironmentExists(resourceName string, v *amplify.Backen
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AmplifyConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AmplifyConn()
 
 		backendEnvironment, err := tfamplify.FindBackendEnvironmentByAppIDAndEnvironmentName(conn, appID, environmentName)
 
@@ -136,7 +136,7 @@ func testAccCheckBackendEnvironmentExists(resourceName string, v *amplify.Backen
 }
 
 func testAccCheckBackendEnvironmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AmplifyConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AmplifyConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_amplify_backend_environment" {
@@ -1,5 +1,6 @@
 //go:generate go run ../../generate/listpages/main.go -ListOps=ListApps
-//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -TestPreCheck=testAccPreCheck
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package amplify
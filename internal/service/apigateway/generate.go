@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsOp=GetTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -ResourcePrefix=aws_api_gateway
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package apigateway
@@ -0,0 +1,23 @@
+package apigateway_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_api_gateway_tag" "test" {
+  resource_arn = aws_api_gateway_rest_api.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ import (
 // These tests need to be serialized, else resources get orphaned after "TooManyRequests" errors.
 func TestAccAPIGatewayV2APIMapping_basic(t *testing.T) {
 	var certificateArn string
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	// Create an ACM certificate to be used by all the tests.
 	// It is created outside the Terraform configurations because deletion
@@ -160,7 +159,7 @@ func testAccCheckAPIMappingCreateCertificate(rName string, certificateArn *strin
 		privateKey := acctest.TLSRSAPrivateKeyPEM(2048)
 		certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(privateKey, fmt.Sprintf("%s.example.com", rName))
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn()
 
 		output, err := conn.ImportCertificate(&acm.ImportCertificateInput{
 			Certificate: []byte(certificate),
@@ -180,7 +179,7 @@ func testAccCheckAPIMappingCreateCertificate(rName string, certificateArn *strin
 }
 
 func testAccCheckAPIMappingDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_api_mapping" {
@@ -206,7 +205,7 @@ func testAccCheckAPIMappingDestroy(s *terraform.State) error {
 
 func testAccCheckAPIMappingDisappears(domainName *string, v *apigatewayv2.GetApiMappingOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
 
 		_, err := conn.DeleteApiMapping(&apigatewayv2.DeleteApiMappingInput{
 			ApiMappingId: v.ApiMappingId,
@@ -228,7 +227,7 @@ func testAccCheckAPIMappingExists(n string, vDomainName *string, v *apigatewayv2
 			return fmt.Errorf("No API Gateway v2 API mapping ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
 
 		domainName := aws.String(rs.Primary.Attributes["domain_name"])
 		resp, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
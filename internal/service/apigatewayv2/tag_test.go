@@ -0,0 +1,24 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_apigatewayv2_tag" "test" {
+  resource_arn = aws_apigatewayv2_api.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -TagType=TagRef -UpdateTags
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsSlice -TagType=TagRef -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package appmesh
@@ -0,0 +1,23 @@
+package appmesh_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_appmesh_mesh" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_appmesh_tag" "test" {
+  resource_arn = aws_appmesh_mesh.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
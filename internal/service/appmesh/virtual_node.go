@@ -1059,7 +1059,7 @@ func appmeshVirtualNodeClientPolicySchema() *schema.Schema {
 }
 
 func resourceVirtualNodeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppMeshConn
+	conn := meta.(*conns.AWSClient).AppMeshConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -1086,7 +1086,7 @@ func resourceVirtualNodeCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVirtualNodeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppMeshConn
+	conn := meta.(*conns.AWSClient).AppMeshConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1100,7 +1100,7 @@ func resourceVirtualNodeRead(d *schema.ResourceData, meta interface{}) error {
 
 	var resp *appmesh.DescribeVirtualNodeOutput
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.AppMesh).Timeout, func() *resource.RetryError {
 		var err error
 
 		resp, err = conn.DescribeVirtualNode(req)
@@ -1178,7 +1178,7 @@ func resourceVirtualNodeRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVirtualNodeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppMeshConn
+	conn := meta.(*conns.AWSClient).AppMeshConn()
 
 	if d.HasChange("spec") {
 		_, v := d.GetChange("spec")
@@ -1212,7 +1212,7 @@ func resourceVirtualNodeUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVirtualNodeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppMeshConn
+	conn := meta.(*conns.AWSClient).AppMeshConn()
 
 	log.Printf("[DEBUG] Deleting App Mesh virtual node: %s", d.Id())
 	_, err := conn.DeleteVirtualNode(&appmesh.DeleteVirtualNodeInput{
@@ -1241,7 +1241,7 @@ func resourceVirtualNodeImport(d *schema.ResourceData, meta interface{}) ([]*sch
 	name := parts[1]
 	log.Printf("[DEBUG] Importing App Mesh virtual node %s from mesh %s", name, mesh)
 
-	conn := meta.(*conns.AWSClient).AppMeshConn
+	conn := meta.(*conns.AWSClient).AppMeshConn()
 
 	resp, err := conn.DescribeVirtualNode(&appmesh.DescribeVirtualNodeInput{
 		MeshName:        aws.String(mesh),
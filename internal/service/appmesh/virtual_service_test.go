@@ -17,9 +17,9 @@ import (
 func testAccVirtualService_virtualNode(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
 
 	resource.Test(t, resource.TestCase{
@@ -71,9 +71,9 @@ func testAccVirtualService_virtualNode(t *testing.T) {
 func testAccVirtualService_virtualRouter(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vrName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vrName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
 
 	resource.Test(t, resource.TestCase{
@@ -118,9 +118,9 @@ func testAccVirtualService_virtualRouter(t *testing.T) {
 func testAccVirtualService_tags(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
 
 	resource.Test(t, resource.TestCase{
@@ -172,7 +172,7 @@ func testAccVirtualService_tags(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_service" {
@@ -197,7 +197,7 @@ func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualServiceExists(name string, v *appmesh.VirtualServiceData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
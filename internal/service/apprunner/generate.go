@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -TestPreCheck=testAccPreCheckAppRunner
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package apprunner
@@ -17,19 +17,19 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
 		Name:         "aws_apprunner_auto_scaling_configuration_version",
 		F:            sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_apprunner_connection", &resource.Sweeper{
 		Name:         "aws_apprunner_connection",
 		F:            sweepConnections,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_apprunner_service", &resource.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
@@ -42,7 +42,7 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppRunnerConn
+	conn := client.(*conns.AWSClient).AppRunnerConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	ctx := context.Background()
 	var errs *multierror.Error
@@ -107,7 +107,7 @@ func sweepConnections(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppRunnerConn
+	conn := client.(*conns.AWSClient).AppRunnerConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	ctx := context.Background()
 
@@ -168,7 +168,7 @@ func sweepServices(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppRunnerConn
+	conn := client.(*conns.AWSClient).AppRunnerConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	ctx := context.Background()
 	var errs *multierror.Error
@@ -0,0 +1,24 @@
+package apprunner_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_connection" "test" {
+  connection_name = %[1]q
+  provider_type   = "GITHUB"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_apprunner_tag" "test" {
+  resource_arn = aws_apprunner_connection.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
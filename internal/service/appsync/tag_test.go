@@ -0,0 +1,24 @@
+package appsync_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_appsync_tag" "test" {
+  resource_arn = aws_appsync_graphql_api.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
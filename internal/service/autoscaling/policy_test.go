@@ -110,7 +110,7 @@ func TestAccAutoScalingPolicy_predictiveScaling(t *testing.T) {
 
 	resourceSimpleName := "aws_autoscaling_policy.test"
 
-	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+	name := acctest.RandomWithPrefix(t, "terraform-testacc-asp")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -148,7 +148,7 @@ func TestAccAutoScalingPolicy_predictiveScalingRemoved(t *testing.T) {
 
 	resourceSimpleName := "aws_autoscaling_policy.test"
 
-	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+	name := acctest.RandomWithPrefix(t, "terraform-testacc-asp")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -185,7 +185,7 @@ func TestAccAutoScalingPolicy_predictiveScalingUpdated(t *testing.T) {
 
 	resourceSimpleName := "aws_autoscaling_policy.test"
 
-	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+	name := acctest.RandomWithPrefix(t, "terraform-testacc-asp")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -260,7 +260,7 @@ func TestAccAutoScalingPolicy_disappears(t *testing.T) {
 
 func testAccCheckScalingPolicyDisappears(conf *autoscaling.ScalingPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 		params := &autoscaling.DeletePolicyInput{
 			AutoScalingGroupName: conf.AutoScalingGroupName,
@@ -427,7 +427,7 @@ func testAccCheckScalingPolicyExists(n string, policy *autoscaling.ScalingPolicy
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 		params := &autoscaling.DescribePoliciesInput{
 			AutoScalingGroupName: aws.String(rs.Primary.Attributes["autoscaling_group_name"]),
 			PolicyNames:          []*string{aws.String(rs.Primary.ID)},
@@ -447,7 +447,7 @@ func testAccCheckScalingPolicyExists(n string, policy *autoscaling.ScalingPolicy
 }
 
 func testAccCheckPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_group" {
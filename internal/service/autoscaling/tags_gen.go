@@ -2,6 +2,7 @@
 package autoscaling
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -18,6 +19,11 @@ import (
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func GetTag(conn *autoscaling.AutoScaling, identifier string, resourceType string, key string) (*tftags.TagData, error) {
+	return GetTagWithContext(context.Background(), conn, identifier, resourceType, key)
+}
+
+// GetTagWithContext is the same as GetTag with the addition of the ability to pass a context.
+func GetTagWithContext(ctx context.Context, conn *autoscaling.AutoScaling, identifier string, resourceType string, key string) (*tftags.TagData, error) {
 	input := &autoscaling.DescribeTagsInput{
 		Filters: []*autoscaling.Filter{
 			{
@@ -31,7 +37,7 @@ func GetTag(conn *autoscaling.AutoScaling, identifier string, resourceType strin
 		},
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(ctx, input)
 
 	if err != nil {
 		return nil, err
@@ -50,6 +56,11 @@ func GetTag(conn *autoscaling.AutoScaling, identifier string, resourceType strin
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func ListTags(conn *autoscaling.AutoScaling, identifier string, resourceType string) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier, resourceType)
+}
+
+// ListTagsWithContext is the same as ListTags with the addition of the ability to pass a context.
+func ListTagsWithContext(ctx context.Context, conn *autoscaling.AutoScaling, identifier string, resourceType string) (tftags.KeyValueTags, error) {
 	input := &autoscaling.DescribeTagsInput{
 		Filters: []*autoscaling.Filter{
 			{
@@ -59,7 +70,7 @@ func ListTags(conn *autoscaling.AutoScaling, identifier string, resourceType str
 		},
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(ctx, input)
 
 	if err != nil {
 		return tftags.New(nil), err
@@ -230,6 +241,11 @@ func KeyValueTags(tags interface{}, identifier string, resourceType string) tfta
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func UpdateTags(conn *autoscaling.AutoScaling, identifier string, resourceType string, oldTagsSet interface{}, newTagsSet interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, resourceType, oldTagsSet, newTagsSet)
+}
+
+// UpdateTagsWithContext is the same as UpdateTags with the addition of the ability to pass a context.
+func UpdateTagsWithContext(ctx context.Context, conn *autoscaling.AutoScaling, identifier string, resourceType string, oldTagsSet interface{}, newTagsSet interface{}) error {
 	oldTags := KeyValueTags(oldTagsSet, identifier, resourceType)
 	newTags := KeyValueTags(newTagsSet, identifier, resourceType)
 
@@ -238,7 +254,7 @@ func UpdateTags(conn *autoscaling.AutoScaling, identifier string, resourceType s
 			Tags: Tags(removedTags.IgnoreAWS()),
 		}
 
-		_, err := conn.DeleteTags(input)
+		_, err := conn.DeleteTagsWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
@@ -250,7 +266,7 @@ func UpdateTags(conn *autoscaling.AutoScaling, identifier string, resourceType s
 			Tags: Tags(updatedTags.IgnoreAWS()),
 		}
 
-		_, err := conn.CreateOrUpdateTags(input)
+		_, err := conn.CreateOrUpdateTagsWithContext(ctx, input)
 
 		if err != nil {
 			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
 		Name: "aws_autoscalingplans_scaling_plan",
 		F:    sweepScalingPlans,
 	})
@@ -26,7 +26,7 @@ func sweepScalingPlans(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AutoScalingPlansConn
+	conn := client.(*conns.AWSClient).AutoScalingPlansConn()
 	input := &autoscalingplans.DescribeScalingPlansInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListTags -ServiceTagsMap -UntagInTagsElem=TagKeyList -UpdateTags
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsOp=ListTags -ServiceTagsMap -UntagInTagsElem=TagKeyList -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -TestPreCheck=testAccPreCheck
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package backup
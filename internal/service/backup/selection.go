@@ -87,7 +87,7 @@ func ResourceSelection() *schema.Resource {
 }
 
 func resourceSelectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	selection := &backup.Selection{
 		IamRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
@@ -142,7 +142,7 @@ func resourceSelectionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSelectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	input := &backup.GetBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -151,7 +151,7 @@ func resourceSelectionRead(d *schema.ResourceData, meta interface{}) error {
 
 	var resp *backup.GetBackupSelectionOutput
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.Backup).Timeout, func() *resource.RetryError {
 		var err error
 
 		resp, err = conn.GetBackupSelection(input)
@@ -226,7 +226,7 @@ func resourceSelectionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSelectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	input := &backup.DeleteBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
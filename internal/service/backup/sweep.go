@@ -16,22 +16,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfiguration,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -49,7 +49,7 @@ func sweepVaultLockConfiguration(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -98,7 +98,7 @@ func sweepVaultNotifications(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -145,7 +145,7 @@ func sweepVaultPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -188,7 +188,7 @@ func sweepVaults(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
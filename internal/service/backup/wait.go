@@ -1,10 +0,0 @@
-package backup
-
-import (
-	"time"
-)
-
-const (
-	// Maximum amount of time to wait for Backup changes to propagate
-	propagationTimeout = 2 * time.Minute
-)
@@ -0,0 +1,31 @@
+package batch_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "busybox"
+    memory  = 128
+    vcpus   = 1
+  })
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_batch_tag" "test" {
+  resource_arn = aws_batch_job_definition.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
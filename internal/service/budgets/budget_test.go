@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/budgets"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func TestAccBudgetsBudget_basic(t *testing.T) {
 	var budget budgets.Budget
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_budgets_budget.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -141,7 +140,7 @@ func TestAccBudgetsBudget_namePrefix(t *testing.T) {
 
 func TestAccBudgetsBudget_disappears(t *testing.T) {
 	var budget budgets.Budget
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_budgets_budget.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -164,7 +163,7 @@ func TestAccBudgetsBudget_disappears(t *testing.T) {
 
 func TestAccBudgetsBudget_costTypes(t *testing.T) {
 	var budget budgets.Budget
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_budgets_budget.test"
 
 	now := time.Now().UTC()
@@ -264,7 +263,7 @@ func TestAccBudgetsBudget_costTypes(t *testing.T) {
 
 func TestAccBudgetsBudget_notifications(t *testing.T) {
 	var budget budgets.Budget
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_budgets_budget.test"
 	snsTopicResourceName := "aws_sns_topic.test"
 
@@ -355,7 +354,7 @@ func testAccBudgetExists(resourceName string, v *budgets.Budget) resource.TestCh
 			return fmt.Errorf("No Budget ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).BudgetsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BudgetsConn()
 
 		accountID, budgetName, err := tfbudgets.BudgetParseResourceID(rs.Primary.ID)
 
@@ -376,7 +375,7 @@ func testAccBudgetExists(resourceName string, v *budgets.Budget) resource.TestCh
 }
 
 func testAccBudgetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).BudgetsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BudgetsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_budgets_budget" {
@@ -0,0 +1,29 @@
+package cloud9_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccEnvironmentEC2BaseConfig(), fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  depends_on = [aws_route.test]
+
+  instance_type = "t2.micro"
+  name          = %[1]q
+  subnet_id     = aws_subnet.test.id
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_cloud9_tag" "test" {
+  resource_arn = aws_cloud9_environment_ec2.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
@@ -77,7 +77,7 @@ func ResourceResource() *schema.Resource {
 }
 
 func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	typeName := d.Get("type_name").(string)
 	input := &cloudcontrolapi.CreateResourceInput{
@@ -122,7 +122,7 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	resourceDescription, err := FindResourceByID(ctx, conn,
 		d.Id(),
@@ -147,7 +147,7 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
@@ -198,7 +198,7 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	input := &cloudcontrolapi.DeleteResourceInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -238,7 +238,7 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 
 	resourceSchema := diff.Get("schema").(string)
 
@@ -156,7 +156,7 @@ func ResourceCachePolicy() *schema.Resource {
 }
 
 func resourceCachePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	request := &cloudfront.CreateCachePolicyInput{
 		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
@@ -174,7 +174,7 @@ func resourceCachePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	request := &cloudfront.GetCachePolicyInput{
 		Id: aws.String(d.Id()),
 	}
@@ -198,7 +198,7 @@ func resourceCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCachePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	request := &cloudfront.UpdateCachePolicyInput{
 		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
@@ -215,7 +215,7 @@ func resourceCachePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCachePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	request := &cloudfront.DeleteCachePolicyInput{
 		Id:      aws.String(d.Id()),
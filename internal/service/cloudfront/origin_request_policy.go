@@ -125,7 +125,7 @@ func ResourceOriginRequestPolicy() *schema.Resource {
 }
 
 func resourceOriginRequestPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	request := &cloudfront.CreateOriginRequestPolicyInput{
 		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
@@ -143,7 +143,7 @@ func resourceOriginRequestPolicyCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	request := &cloudfront.GetOriginRequestPolicyInput{
 		Id: aws.String(d.Id()),
 	}
@@ -176,7 +176,7 @@ func resourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceOriginRequestPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	request := &cloudfront.UpdateOriginRequestPolicyInput{
 		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
@@ -193,7 +193,7 @@ func resourceOriginRequestPolicyUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceOriginRequestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	request := &cloudfront.DeleteOriginRequestPolicyInput{
 		Id:      aws.String(d.Id()),
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudfront"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,8 +14,8 @@ import (
 )
 
 func TestAccAWSCloudFrontResponseHeadersPolicy_CorsConfig(t *testing.T) {
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_response_headers_policy.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -91,7 +90,7 @@ func TestAccAWSCloudFrontResponseHeadersPolicy_CorsConfig(t *testing.T) {
 }
 
 func TestAccAWSCloudFrontResponseHeadersPolicy_CustomHeadersConfig(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_response_headers_policy.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -134,7 +133,7 @@ func TestAccAWSCloudFrontResponseHeadersPolicy_CustomHeadersConfig(t *testing.T)
 }
 
 func TestAccAWSCloudFrontResponseHeadersPolicy_SecurityHeadersConfig(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_response_headers_policy.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -211,7 +210,7 @@ func TestAccAWSCloudFrontResponseHeadersPolicy_SecurityHeadersConfig(t *testing.
 }
 
 func TestAccAWSCloudFrontResponseHeadersPolicy_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudfront_response_headers_policy.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -233,7 +232,7 @@ func TestAccAWSCloudFrontResponseHeadersPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckCloudFrontResponseHeadersPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_response_headers_policy" {
@@ -267,7 +266,7 @@ func testAccCheckCloudFrontResponseHeadersPolicyExists(n string) resource.TestCh
 			return fmt.Errorf("No CloudFront Response Headers Policy ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
 
 		_, err := tfcloudfront.FindResponseHeadersPolicyByID(conn, rs.Primary.ID)
 
@@ -15,13 +15,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
 		Name:         "aws_cloudhsm_v2_cluster",
 		F:            sweepCloudhsmv2Clusters,
 		Dependencies: []string{"aws_cloudhsm_v2_hsm"},
 	})
 
-	resource.AddTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
 		Name: "aws_cloudhsm_v2_hsm",
 		F:    sweepCloudhsmv2HSMs,
 	})
@@ -32,7 +32,7 @@ func sweepCloudhsmv2Clusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudHSMV2Conn
+	conn := client.(*conns.AWSClient).CloudHSMV2Conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -78,7 +78,7 @@ func sweepCloudhsmv2HSMs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudHSMV2Conn
+	conn := client.(*conns.AWSClient).CloudHSMV2Conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
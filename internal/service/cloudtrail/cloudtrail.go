@@ -237,7 +237,7 @@ func ResourceCloudTrail() *schema.Resource {
 }
 
 func resourceCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -335,7 +335,7 @@ func resourceCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -443,7 +443,7 @@ func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 
 	input := cloudtrail.UpdateTrailInput{
 		Name: aws.String(d.Id()),
@@ -546,7 +546,7 @@ func resourceCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 
 	log.Printf("[DEBUG] Deleting CloudTrail: %q", d.Id())
 	_, err := conn.DeleteTrail(&cloudtrail.DeleteTrailInput{
@@ -0,0 +1,26 @@
+package cloudtrail_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_cloudtrail_tag" "test" {
+  resource_arn = aws_cloudtrail.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
 		Name: "aws_cloudwatch_composite_alarm",
 		F:    sweepCompositeAlarms,
 	})
@@ -30,7 +30,7 @@ func sweepCompositeAlarms(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CloudWatchConn
+	conn := client.(*conns.AWSClient).CloudWatchConn()
 	ctx := context.Background()
 
 	input := &cloudwatch.DescribeAlarmsInput{
@@ -21,7 +21,7 @@ import (
 func TestAccCloudWatchEventsPermission_basic(t *testing.T) {
 	principal1 := "111111111111"
 	principal2 := "*"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_permission.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -91,8 +91,8 @@ func TestAccCloudWatchEventsPermission_basic(t *testing.T) {
 
 func TestAccCloudWatchEventsPermission_eventBusName(t *testing.T) {
 	principal1 := "111111111111"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	busName := sdkacctest.RandomWithPrefix("tf-acc-test-bus")
+	statementID := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	busName := acctest.RandomWithPrefix(t, "tf-acc-test-bus")
 
 	resourceName := "aws_cloudwatch_event_permission.test"
 
@@ -124,7 +124,7 @@ func TestAccCloudWatchEventsPermission_eventBusName(t *testing.T) {
 
 func TestAccCloudWatchEventsPermission_action(t *testing.T) {
 	principal := "111111111111"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_permission.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -166,7 +166,7 @@ func TestAccCloudWatchEventsPermission_action(t *testing.T) {
 }
 
 func TestAccCloudWatchEventsPermission_condition(t *testing.T) {
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_permission.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -207,8 +207,8 @@ func TestAccCloudWatchEventsPermission_condition(t *testing.T) {
 func TestAccCloudWatchEventsPermission_multiple(t *testing.T) {
 	principal1 := "111111111111"
 	principal2 := "222222222222"
-	statementID1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	statementID2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	statementID2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName1 := "aws_cloudwatch_event_permission.test"
 	resourceName2 := "aws_cloudwatch_event_permission.test2"
 
@@ -244,7 +244,7 @@ func TestAccCloudWatchEventsPermission_multiple(t *testing.T) {
 func TestAccCloudWatchEventsPermission_disappears(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_permission.test"
 	principal := "111111111111"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -266,7 +266,7 @@ func TestAccCloudWatchEventsPermission_disappears(t *testing.T) {
 
 func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn()
 		rs, ok := s.RootModule().Resources[pr]
 		if !ok {
 			return fmt.Errorf("Not found: %s", pr)
@@ -304,7 +304,7 @@ func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFu
 }
 
 func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_permission" {
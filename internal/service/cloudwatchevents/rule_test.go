@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -32,8 +31,8 @@ func testAccErrorCheckSkipEvents(t *testing.T) resource.ErrorCheckFunc {
 
 func TestAccCloudWatchEventsRule_basic(t *testing.T) {
 	var v1, v2, v3 events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -101,10 +100,10 @@ func TestAccCloudWatchEventsRule_basic(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_eventBusName(t *testing.T) {
 	var v1, v2, v3 events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
-	rName2 := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
-	busName := sdkacctest.RandomWithPrefix("tf-acc-test-bus")
-	busName2 := sdkacctest.RandomWithPrefix("tf-acc-test-bus")
+	rName := acctest.RandomWithPrefix(t, "tf-acc-test-rule")
+	rName2 := acctest.RandomWithPrefix(t, "tf-acc-test-rule")
+	busName := acctest.RandomWithPrefix(t, "tf-acc-test-bus")
+	busName2 := acctest.RandomWithPrefix(t, "tf-acc-test-bus")
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -152,7 +151,7 @@ func TestAccCloudWatchEventsRule_eventBusName(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_role(t *testing.T) {
 	var v events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudwatch_event_rule.test"
 	iamRoleResourceName := "aws_iam_role.test"
@@ -182,7 +181,7 @@ func TestAccCloudWatchEventsRule_role(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_description(t *testing.T) {
 	var v1, v2 events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -218,7 +217,7 @@ func TestAccCloudWatchEventsRule_description(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_pattern(t *testing.T) {
 	var v1, v2 events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -255,7 +254,7 @@ func TestAccCloudWatchEventsRule_pattern(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_scheduleAndPattern(t *testing.T) {
 	var v events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -339,7 +338,7 @@ func TestAccCloudWatchEventsRule_Name_generated(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_tags(t *testing.T) {
 	var v1, v2, v3 events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -391,7 +390,7 @@ func TestAccCloudWatchEventsRule_tags(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_isEnabled(t *testing.T) {
 	var v1, v2, v3 events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -441,7 +440,7 @@ func TestAccCloudWatchEventsRule_partnerEventBus(t *testing.T) {
 	}
 
 	var v events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
+	rName := acctest.RandomWithPrefix(t, "tf-acc-test-rule")
 	resourceName := "aws_cloudwatch_event_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -476,9 +475,9 @@ func TestAccCloudWatchEventsRule_partnerEventBus(t *testing.T) {
 
 func TestAccCloudWatchEventsRule_eventBusARN(t *testing.T) {
 	var v events.DescribeRuleOutput
-	rName := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
+	rName := acctest.RandomWithPrefix(t, "tf-acc-test-rule")
 	resourceName := "aws_cloudwatch_event_rule.test"
-	eventBusName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	eventBusName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -521,7 +520,7 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 			return fmt.Errorf("No CloudWatch Events Rule ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn()
 
 		resp, err := tfcloudwatchevents.FindRuleByResourceID(conn, rs.Primary.ID)
 
@@ -542,7 +541,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string) resource.T
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn()
 
 		resp, err := tfcloudwatchevents.FindRuleByResourceID(conn, rs.Primary.ID)
 
@@ -559,7 +558,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string) resource.T
 }
 
 func testAccCheckRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchEventsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_rule" {
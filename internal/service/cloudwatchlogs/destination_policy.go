@@ -40,7 +40,7 @@ func ResourceDestinationPolicy() *schema.Resource {
 }
 
 func resourceDestinationPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	destination_name := d.Get("destination_name").(string)
 	access_policy := d.Get("access_policy").(string)
@@ -61,7 +61,7 @@ func resourceDestinationPolicyPut(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDestinationPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 	destination_name := d.Get("destination_name").(string)
 	destination, exists, err := LookupDestination(conn, destination_name, nil)
 	if err != nil {
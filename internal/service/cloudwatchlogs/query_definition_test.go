@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ import (
 func TestAccCloudWatchLogsQueryDefinition_basic(t *testing.T) {
 	var v cloudwatchlogs.QueryDefinition
 	resourceName := "aws_cloudwatch_query_definition.test"
-	queryName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	expectedQueryString := `fields @timestamp, @message
 | sort @timestamp desc
@@ -71,7 +70,7 @@ func testAccQueryDefinitionImportStateID(v *cloudwatchlogs.QueryDefinition) reso
 func TestAccCloudWatchLogsQueryDefinition_disappears(t *testing.T) {
 	var v cloudwatchlogs.QueryDefinition
 	resourceName := "aws_cloudwatch_query_definition.test"
-	queryName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -94,8 +93,8 @@ func TestAccCloudWatchLogsQueryDefinition_disappears(t *testing.T) {
 func TestAccCloudWatchLogsQueryDefinition_rename(t *testing.T) {
 	var v1, v2 cloudwatchlogs.QueryDefinition
 	resourceName := "aws_cloudwatch_query_definition.test"
-	queryName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	updatedQueryName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	updatedQueryName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -130,7 +129,7 @@ func TestAccCloudWatchLogsQueryDefinition_rename(t *testing.T) {
 func TestAccCloudWatchLogsQueryDefinition_logGroups(t *testing.T) {
 	var v1, v2 cloudwatchlogs.QueryDefinition
 	resourceName := "aws_cloudwatch_query_definition.test"
-	queryName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -173,7 +172,7 @@ func testAccCheckQueryDefinitionExists(rName string, v *cloudwatchlogs.QueryDefi
 		if !ok {
 			return fmt.Errorf("Not found: %s", rName)
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn()
 
 		result, err := tfcloudwatchlogs.FindQueryDefinition(context.Background(), conn, "", rs.Primary.ID)
 
@@ -192,7 +191,7 @@ func testAccCheckQueryDefinitionExists(rName string, v *cloudwatchlogs.QueryDefi
 }
 
 func testAccCheckQueryDefinitionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_query_definition" {
@@ -60,7 +60,7 @@ func ResourceRepositoryPermissionsPolicy() *schema.Resource {
 }
 
 func resourceRepositoryPermissionsPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeArtifactConn
+	conn := meta.(*conns.AWSClient).CodeArtifactConn()
 	log.Print("[DEBUG] Creating CodeArtifact Repository Permissions Policy")
 
 	params := &codeartifact.PutRepositoryPermissionsPolicyInput{
@@ -88,7 +88,7 @@ func resourceRepositoryPermissionsPolicyPut(d *schema.ResourceData, meta interfa
 }
 
 func resourceRepositoryPermissionsPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeArtifactConn
+	conn := meta.(*conns.AWSClient).CodeArtifactConn()
 	log.Printf("[DEBUG] Reading CodeArtifact Repository Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, repoName, err := DecodeRepositoryID(d.Id())
@@ -121,7 +121,7 @@ func resourceRepositoryPermissionsPolicyRead(d *schema.ResourceData, meta interf
 }
 
 func resourceRepositoryPermissionsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeArtifactConn
+	conn := meta.(*conns.AWSClient).CodeArtifactConn()
 	log.Printf("[DEBUG] Deleting CodeArtifact Repository Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, repoName, err := DecodeRepositoryID(d.Id())
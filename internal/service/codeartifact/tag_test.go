@@ -0,0 +1,23 @@
+package codeartifact_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_codeartifact_domain" "test" {
+  domain = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_codeartifact_tag" "test" {
+  resource_arn = aws_codeartifact_domain.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
 		Name: "aws_codebuild_report_group",
 		F:    sweepReportGroups,
 	})
@@ -29,7 +29,7 @@ func sweepReportGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeBuildConn
+	conn := client.(*conns.AWSClient).CodeBuildConn()
 	input := &codebuild.ListReportGroupsInput{}
 	var sweeperErrs *multierror.Error
 
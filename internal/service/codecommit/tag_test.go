@@ -0,0 +1,23 @@
+package codecommit_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_codecommit_repository" "test" {
+  repository_name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_codecommit_tag" "test" {
+  resource_arn = aws_codecommit_repository.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_codedeploy_app", &resource.Sweeper{
 		Name: "aws_codedeploy_app",
 		F:    sweepApps,
 	})
@@ -29,7 +29,7 @@ func sweepApps(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeDeployConn
+	conn := client.(*conns.AWSClient).CodeDeployConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -0,0 +1,23 @@
+package codedeploy_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_app" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_codedeploy_tag" "test" {
+  resource_arn = aws_codedeploy_app.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
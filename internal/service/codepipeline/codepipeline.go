@@ -183,7 +183,7 @@ func ResourceCodePipeline() *schema.Resource {
 }
 
 func resourceCodePipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -502,7 +502,7 @@ func flattenActionsInputArtifacts(artifacts []*codepipeline.InputArtifact) []str
 }
 
 func resourceCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -563,7 +563,7 @@ func resourceCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCodePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 
 	pipeline, err := expand(d)
 	if err != nil {
@@ -591,7 +591,7 @@ func resourceCodePipelineUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCodePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 
 	_, err := conn.DeletePipeline(&codepipeline.DeletePipelineInput{
 		Name: aws.String(d.Id()),
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codepipeline", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_codepipeline", &resource.Sweeper{
 		Name: "aws_codepipeline",
 		F:    sweepPipelines,
 	})
@@ -29,7 +29,7 @@ func sweepPipelines(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodePipelineConn
+	conn := client.(*conns.AWSClient).CodePipelineConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -81,7 +81,7 @@ func ResourceHost() *schema.Resource {
 }
 
 func resourceHostCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn()
 
 	params := &codestarconnections.CreateHostInput{
 		Name:             aws.String(d.Get("name").(string)),
@@ -106,7 +106,7 @@ func resourceHostCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceHostRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn()
 
 	input := &codestarconnections.GetHostInput{
 		HostArn: aws.String(d.Id()),
@@ -139,7 +139,7 @@ func resourceHostRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceHostUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn()
 
 	if d.HasChanges("provider_endpoint", "vpc_configuration") {
 		input := codestarconnections.UpdateHostInput{
@@ -163,7 +163,7 @@ func resourceHostUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceHostDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn()
 
 	input := &codestarconnections.DeleteHostInput{
 		HostArn: aws.String(d.Id()),
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 func TestAccCodeStarConnectionsHost_basic(t *testing.T) {
 	var v codestarconnections.GetHostOutput
 	resourceName := "aws_codestarconnections_host.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
@@ -51,7 +50,7 @@ func TestAccCodeStarConnectionsHost_basic(t *testing.T) {
 func TestAccCodeStarConnectionsHost_disappears(t *testing.T) {
 	var v codestarconnections.GetHostOutput
 	resourceName := "aws_codestarconnections_host.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
@@ -74,7 +73,7 @@ func TestAccCodeStarConnectionsHost_disappears(t *testing.T) {
 func TestAccCodeStarConnectionsHost_vpc(t *testing.T) {
 	var v codestarconnections.GetHostOutput
 	resourceName := "aws_codestarconnections_host.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
@@ -118,7 +117,7 @@ func testAccCheckHostExists(n string, v *codestarconnections.GetHostOutput) reso
 			return errors.New("No CodeStar host ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 		resp, err := conn.GetHost(&codestarconnections.GetHostInput{
 			HostArn: aws.String(rs.Primary.ID),
@@ -134,7 +133,7 @@ func testAccCheckHostExists(n string, v *codestarconnections.GetHostOutput) reso
 }
 
 func testAccCheckHostDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch rs.Type {
@@ -0,0 +1,24 @@
+package codestarconnections_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_codestarconnections_tag" "test" {
+  resource_arn = aws_codestarconnections_connection.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
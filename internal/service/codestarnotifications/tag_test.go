@@ -0,0 +1,32 @@
+package codestarnotifications_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccNotificationRuleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_codestarnotifications_notification_rule" "test" {
+  detail_type    = "BASIC"
+  event_type_ids = ["codecommit-repository-comments-on-commits"]
+  name           = %[1]q
+  resource       = aws_codecommit_repository.test.arn
+
+  target {
+    address = aws_sns_topic.test.arn
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_codestarnotifications_tag" "test" {
+  resource_arn = aws_codestarnotifications_notification_rule.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -ResourcePrefix=aws_cognito_identity_pool -TestPreCheck=testAccPreCheck
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package cognitoidentity
@@ -0,0 +1,24 @@
+package cognitoidentity_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_identity_pool" "test" {
+  identity_pool_name               = "identity pool %[1]s"
+  allow_unauthenticated_identities = false
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_cognito_identity_pool_tag" "test" {
+  resource_arn = aws_cognito_identity_pool.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
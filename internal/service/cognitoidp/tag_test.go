@@ -0,0 +1,23 @@
+package cognitoidp_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_cognito_user_pool_tag" "test" {
+  resource_arn = aws_cognito_user_pool.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -62,7 +62,7 @@ func ResourceUserPoolDomain() *schema.Resource {
 }
 
 func resourceUserPoolDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	domain := d.Get("domain").(string)
 
@@ -98,7 +98,7 @@ func resourceUserPoolDomainCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceUserPoolDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 	log.Printf("[DEBUG] Reading Cognito User Pool Domain: %s", d.Id())
 
 	domain, err := conn.DescribeUserPoolDomain(&cognitoidentityprovider.DescribeUserPoolDomainInput{
@@ -136,7 +136,7 @@ func resourceUserPoolDomainRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceUserPoolDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 	log.Printf("[DEBUG] Deleting Cognito User Pool Domain: %s", d.Id())
 
 	_, err := conn.DeleteUserPoolDomain(&cognitoidentityprovider.DeleteUserPoolDomainInput{
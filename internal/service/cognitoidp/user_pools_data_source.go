@@ -33,7 +33,7 @@ func DataSourceUserPools() *schema.Resource {
 }
 
 func dataSourceUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 	name := d.Get("name").(string)
 	var ids []string
 	var arns []string
@@ -125,7 +125,7 @@ func ResourceOrganizationCustomRule() *schema.Resource {
 }
 
 func resourceOrganizationCustomRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 	name := d.Get("name").(string)
 
 	input := &configservice.PutOrganizationConfigRuleInput{
@@ -184,7 +184,7 @@ func resourceOrganizationCustomRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceOrganizationCustomRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 
 	rule, err := DescribeOrganizationConfigRule(conn, d.Id())
 
@@ -240,7 +240,7 @@ func resourceOrganizationCustomRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceOrganizationCustomRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 
 	input := &configservice.PutOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
@@ -296,7 +296,7 @@ func resourceOrganizationCustomRuleUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceOrganizationCustomRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 
 	input := &configservice.DeleteOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
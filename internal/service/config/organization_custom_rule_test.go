@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func testAccConfigOrganizationCustomRule_basic(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	lambdaFunctionResourceName := "aws_lambda_function.test"
 	resourceName := "aws_config_organization_custom_rule.test"
 
@@ -56,7 +55,7 @@ func testAccConfigOrganizationCustomRule_basic(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_disappears(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -78,7 +77,7 @@ func testAccConfigOrganizationCustomRule_disappears(t *testing.T) {
 }
 
 func testAccConfigOrganizationCustomRule_errorHandling(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
@@ -96,7 +95,7 @@ func testAccConfigOrganizationCustomRule_errorHandling(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_Description(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -130,7 +129,7 @@ func testAccConfigOrganizationCustomRule_Description(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_ExcludedAccounts(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -164,7 +163,7 @@ func testAccConfigOrganizationCustomRule_ExcludedAccounts(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_InputParameters(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	inputParameters1 := `{"tag1Key":"CostCenter", "tag2Key":"Owner"}`
@@ -201,7 +200,7 @@ func testAccConfigOrganizationCustomRule_InputParameters(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_LambdaFunctionArn(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	lambdaFunctionResourceName1 := "aws_lambda_function.test"
 	lambdaFunctionResourceName2 := "aws_lambda_function.test2"
 	resourceName := "aws_config_organization_custom_rule.test"
@@ -237,7 +236,7 @@ func testAccConfigOrganizationCustomRule_LambdaFunctionArn(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_MaximumExecutionFrequency(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -271,7 +270,7 @@ func testAccConfigOrganizationCustomRule_MaximumExecutionFrequency(t *testing.T)
 
 func testAccConfigOrganizationCustomRule_ResourceIdScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -305,7 +304,7 @@ func testAccConfigOrganizationCustomRule_ResourceIdScope(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_ResourceTypesScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -339,7 +338,7 @@ func testAccConfigOrganizationCustomRule_ResourceTypesScope(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_TagKeyScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -373,7 +372,7 @@ func testAccConfigOrganizationCustomRule_TagKeyScope(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_TagValueScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -407,7 +406,7 @@ func testAccConfigOrganizationCustomRule_TagValueScope(t *testing.T) {
 
 func testAccConfigOrganizationCustomRule_TriggerTypes(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_custom_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -446,7 +445,7 @@ func testAccCheckConfigOrganizationCustomRuleExists(resourceName string, ocr *co
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 		rule, err := tfconfig.DescribeOrganizationConfigRule(conn, rs.Primary.ID)
 
@@ -465,7 +464,7 @@ func testAccCheckConfigOrganizationCustomRuleExists(resourceName string, ocr *co
 }
 
 func testAccCheckConfigOrganizationCustomRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_organization_custom_rule" {
@@ -111,7 +111,7 @@ func ResourceOrganizationManagedRule() *schema.Resource {
 }
 
 func resourceOrganizationManagedRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 	name := d.Get("name").(string)
 
 	input := &configservice.PutOrganizationConfigRuleInput{
@@ -169,7 +169,7 @@ func resourceOrganizationManagedRuleCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceOrganizationManagedRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 
 	rule, err := DescribeOrganizationConfigRule(conn, d.Id())
 
@@ -221,7 +221,7 @@ func resourceOrganizationManagedRuleRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceOrganizationManagedRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 
 	input := &configservice.PutOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
@@ -276,7 +276,7 @@ func resourceOrganizationManagedRuleUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceOrganizationManagedRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigConn
+	conn := meta.(*conns.AWSClient).ConfigConn()
 
 	input := &configservice.DeleteOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
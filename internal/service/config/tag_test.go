@@ -0,0 +1,30 @@
+package config_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_config_configuration_aggregator" "test" {
+  name = %[1]q
+
+  account_aggregation_source {
+    account_ids = [data.aws_caller_identity.current.account_id]
+    all_regions = true
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_config_tag" "test" {
+  resource_arn = aws_config_configuration_aggregator.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
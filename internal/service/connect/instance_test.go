@@ -8,14 +8,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/connect"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
-//Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
 func TestAccConnectInstance_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		"basic":     testAccInstance_basic,
@@ -33,7 +32,7 @@ func TestAccConnectInstance_serial(t *testing.T) {
 
 func testAccInstance_basic(t *testing.T) {
 	var v connect.DescribeInstanceOutput
-	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName := acctest.RandomWithPrefix(t, "resource-test-terraform")
 	resourceName := "aws_connect_instance.test"
 
 	resource.Test(t, resource.TestCase{
@@ -87,7 +86,7 @@ func testAccInstance_basic(t *testing.T) {
 
 func testAccInstance_directory(t *testing.T) {
 	var v connect.DescribeInstanceOutput
-	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName := acctest.RandomWithPrefix(t, "resource-test-terraform")
 	resourceName := "aws_connect_instance.test"
 
 	resource.Test(t, resource.TestCase{
@@ -116,7 +115,7 @@ func testAccInstance_directory(t *testing.T) {
 
 func testAccInstance_saml(t *testing.T) {
 	var v connect.DescribeInstanceOutput
-	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName := acctest.RandomWithPrefix(t, "resource-test-terraform")
 	resourceName := "aws_connect_instance.test"
 
 	resource.Test(t, resource.TestCase{
@@ -152,7 +151,7 @@ func testAccCheckInstanceExists(resourceName string, instance *connect.DescribeI
 			return fmt.Errorf("Connect instance ID not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
 
 		input := &connect.DescribeInstanceInput{
 			InstanceId: aws.String(rs.Primary.ID),
@@ -178,7 +177,7 @@ func testAccCheckInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
 
 		instanceID := rs.Primary.ID
 
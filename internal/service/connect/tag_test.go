@@ -0,0 +1,22 @@
+package connect_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_connect_tag" "test" {
+  resource_arn = aws_connect_instance.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
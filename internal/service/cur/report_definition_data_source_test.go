@@ -15,7 +15,7 @@ func TestAccCURReportDefinitionDataSource_basic(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	datasourceName := "data.aws_cur_report_definition.test"
 
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +46,7 @@ func TestAccCURReportDefinitionDataSource_additional(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	datasourceName := "data.aws_cur_report_definition.test"
 
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,7 +17,7 @@ import (
 func testAccReportDefinition_basic(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -70,7 +70,7 @@ func testAccReportDefinition_basic(t *testing.T) {
 func testAccReportDefinition_textOrCSV(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 	bucketPrefix := ""
 	format := "textORcsv"
@@ -114,7 +114,7 @@ func testAccReportDefinition_textOrCSV(t *testing.T) {
 func testAccReportDefinition_parquet(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 	bucketPrefix := ""
 	format := "Parquet"
@@ -157,7 +157,7 @@ func testAccReportDefinition_parquet(t *testing.T) {
 func testAccReportDefinition_athena(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 	bucketPrefix := "data"
 	format := "Parquet"
@@ -201,7 +201,7 @@ func testAccReportDefinition_athena(t *testing.T) {
 func testAccReportDefinition_refresh(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 	bucketPrefix := ""
 	format := "textORcsv"
@@ -245,7 +245,7 @@ func testAccReportDefinition_refresh(t *testing.T) {
 func testAccReportDefinition_overwrite(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 	bucketPrefix := ""
 	format := "textORcsv"
@@ -288,7 +288,7 @@ func testAccReportDefinition_overwrite(t *testing.T) {
 
 func testAccReportDefinition_disappears(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	reportName := acctest.RandomWithPrefix(t, "tf_acc_test")
 	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -310,7 +310,7 @@ func testAccReportDefinition_disappears(t *testing.T) {
 }
 
 func testAccCheckReportDefinitionDestroy(s *terraform.State) error {
-	conn := testAccProviderCur.Meta().(*conns.AWSClient).CURConn
+	conn := testAccProviderCur.Meta().(*conns.AWSClient).CURConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cur_report_definition" {
@@ -334,7 +334,7 @@ func testAccCheckReportDefinitionDestroy(s *terraform.State) error {
 
 func testAccCheckReportDefinitionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProviderCur.Meta().(*conns.AWSClient).CURConn
+		conn := testAccProviderCur.Meta().(*conns.AWSClient).CURConn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
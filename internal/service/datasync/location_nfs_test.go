@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccDataSyncLocationNFS_basic(t *testing.T) {
 	var locationNfs1 datasync.DescribeLocationNfsOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_datasync_location_nfs.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -54,7 +53,7 @@ func TestAccDataSyncLocationNFS_basic(t *testing.T) {
 
 func TestAccDataSyncLocationNFS_mountOptions(t *testing.T) {
 	var locationNfs1 datasync.DescribeLocationNfsOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_datasync_location_nfs.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -89,7 +88,7 @@ func TestAccDataSyncLocationNFS_mountOptions(t *testing.T) {
 
 func TestAccDataSyncLocationNFS_disappears(t *testing.T) {
 	var locationNfs1 datasync.DescribeLocationNfsOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_datasync_location_nfs.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,7 +111,7 @@ func TestAccDataSyncLocationNFS_disappears(t *testing.T) {
 
 func TestAccDataSyncLocationNFS_AgentARNs_multiple(t *testing.T) {
 	var locationNfs1 datasync.DescribeLocationNfsOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_datasync_location_nfs.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -140,7 +139,7 @@ func TestAccDataSyncLocationNFS_AgentARNs_multiple(t *testing.T) {
 }
 
 func TestAccDataSyncLocationNFS_subdirectory(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	var locationNfs1 datasync.DescribeLocationNfsOutput
 	resourceName := "aws_datasync_location_nfs.test"
 
@@ -176,7 +175,7 @@ func TestAccDataSyncLocationNFS_subdirectory(t *testing.T) {
 
 func TestAccDataSyncLocationNFS_tags(t *testing.T) {
 	var locationNfs1, locationNfs2, locationNfs3 datasync.DescribeLocationNfsOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_datasync_location_nfs.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -223,7 +222,7 @@ func TestAccDataSyncLocationNFS_tags(t *testing.T) {
 }
 
 func testAccCheckLocationNFSDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_nfs" {
@@ -255,7 +254,7 @@ func testAccCheckLocationNFSExists(resourceName string, locationNfs *datasync.De
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn()
 		input := &datasync.DescribeLocationNfsInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -278,7 +277,7 @@ func testAccCheckLocationNFSExists(resourceName string, locationNfs *datasync.De
 
 func testAccCheckLocationNFSDisappears(location *datasync.DescribeLocationNfsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
@@ -0,0 +1,32 @@
+package datasync_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccLocationS3BaseConfig(rName), fmt.Sprintf(`
+resource "aws_datasync_location_s3" "test" {
+  s3_bucket_arn = aws_s3_bucket.test.arn
+  subdirectory  = "/test"
+
+  s3_config {
+    bucket_access_role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_datasync_tag" "test" {
+  resource_arn = aws_datasync_location_s3.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
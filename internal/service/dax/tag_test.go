@@ -0,0 +1,27 @@
+package dax_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`%[1]s
+resource "aws_dax_cluster" "test" {
+  # DAX cluster names are limited to 20 characters.
+  cluster_name       = substr(%[2]q, 0, 20)
+  iam_role_arn       = aws_iam_role.test.arn
+  node_type          = "dax.t2.small"
+  replication_factor = 1
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_dax_tag" "test" {
+  resource_arn = aws_dax_cluster.test.arn
+  key          = %[3]q
+  value        = %[4]q
+}
+`, baseConfig, rName, key, value)
+}
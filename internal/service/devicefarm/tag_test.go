@@ -0,0 +1,32 @@
+package devicefarm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPreCheckTag(t *testing.T) {
+	// Device Farm is only available in us-west-2.
+	acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+}
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_devicefarm_project" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_devicefarm_tag" "test" {
+  resource_arn = aws_devicefarm_project.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
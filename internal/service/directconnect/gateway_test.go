@@ -16,7 +16,7 @@ import (
 
 func TestAccDirectConnectGateway_basic(t *testing.T) {
 	var v directconnect.Gateway
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	resourceName := "aws_dx_gateway.test"
 
@@ -44,7 +44,7 @@ func TestAccDirectConnectGateway_basic(t *testing.T) {
 
 func TestAccDirectConnectGateway_disappears(t *testing.T) {
 	var v directconnect.Gateway
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	resourceName := "aws_dx_gateway.test"
 
@@ -68,7 +68,7 @@ func TestAccDirectConnectGateway_disappears(t *testing.T) {
 
 func TestAccDirectConnectGateway_complex(t *testing.T) {
 	var v directconnect.Gateway
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	resourceName := "aws_dx_gateway.test"
 
@@ -95,7 +95,7 @@ func TestAccDirectConnectGateway_complex(t *testing.T) {
 }
 
 func testAccCheckGatewayDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_gateway" {
@@ -128,7 +128,7 @@ func testAccCheckGatewayExists(name string, v *directconnect.Gateway) resource.T
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn()
 
 		output, err := tfdirectconnect.FindGatewayByID(conn, rs.Primary.ID)
 
@@ -0,0 +1,32 @@
+package directconnect_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+data "aws_dx_locations" "test" {}
+
+locals {
+  location_codes = tolist(data.aws_dx_locations.test.location_codes)
+  idx            = min(2, length(local.location_codes) - 1)
+}
+
+resource "aws_dx_connection" "test" {
+  name      = %[1]q
+  bandwidth = "1Gbps"
+  location  = local.location_codes[local.idx]
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_dx_tag" "test" {
+  resource_arn = aws_dx_connection.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
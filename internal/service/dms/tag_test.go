@@ -0,0 +1,31 @@
+package dms_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  database_name = "tftest"
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "aurora"
+  password      = "tftest"
+  port          = 3306
+  server_name   = "tftest"
+  ssl_mode      = "none"
+  username      = "tftest"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_dms_tag" "test" {
+  resource_arn = aws_dms_endpoint.test.endpoint_arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
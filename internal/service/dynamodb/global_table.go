@@ -1,6 +1,7 @@
 package dynamodb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -23,6 +24,8 @@ func ResourceGlobalTable() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffValidateReplicaRegions,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(1 * time.Minute),
@@ -59,10 +62,14 @@ func ResourceGlobalTable() *schema.Resource {
 }
 
 func resourceGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	globalTableName := d.Get("name").(string)
 
+	if err := validateReplicaRegions(meta.(*conns.AWSClient), d.Get("replica").(*schema.Set).List()); err != nil {
+		return err
+	}
+
 	input := &dynamodb.CreateGlobalTableInput{
 		GlobalTableName:  aws.String(globalTableName),
 		ReplicationGroup: expandReplicas(d.Get("replica").(*schema.Set).List()),
@@ -114,7 +121,7 @@ func resourceGlobalTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	if d.HasChange("replica") {
 		o, n := d.GetChange("replica")
@@ -127,6 +134,11 @@ func resourceGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
+
+		if err := validateReplicaRegions(meta.(*conns.AWSClient), ns.Difference(os).List()); err != nil {
+			return err
+		}
+
 		replicaUpdateCreateReplicas := expandReplicaUpdateCreateReplicas(ns.Difference(os).List())
 		replicaUpdateDeleteReplicas := expandReplicaUpdateDeleteReplicas(os.Difference(ns).List())
 
@@ -168,7 +180,7 @@ func resourceGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
 
 // Deleting a DynamoDB Global Table is represented by removing all replicas.
 func resourceGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	input := &dynamodb.UpdateGlobalTableInput{
 		GlobalTableName: aws.String(d.Id()),
@@ -197,7 +209,7 @@ func resourceGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGlobalTableRetrieve(d *schema.ResourceData, meta interface{}) (*dynamodb.GlobalTableDescription, error) {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	input := &dynamodb.DescribeGlobalTableInput{
 		GlobalTableName: aws.String(d.Id()),
@@ -284,6 +296,42 @@ func expandReplicaUpdateDeleteReplica(configuredReplica map[string]interface{})
 	return replicaUpdate
 }
 
+// customizeDiffValidateReplicaRegions returns an error if the provider configuration does not allow
+// the region of any added replica whose region is known at plan time.
+func customizeDiffValidateReplicaRegions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("replica") {
+		return nil
+	}
+
+	o, n := diff.GetChange("replica")
+
+	return validateReplicaRegions(meta.(*conns.AWSClient), n.(*schema.Set).Difference(o.(*schema.Set)).List())
+}
+
+// validateReplicaRegions returns an error if the provider configuration does not allow the region of any of the specified replicas.
+func validateReplicaRegions(client *conns.AWSClient, configuredReplicas []interface{}) error {
+	for _, replicaRaw := range configuredReplicas {
+		replica, ok := replicaRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		regionName := replica["region_name"].(string)
+
+		// Unknown at plan time.
+		if regionName == "" {
+			continue
+		}
+
+		if err := client.ValidateRegion(regionName); err != nil {
+			return fmt.Errorf("error validating DynamoDB replica region: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func expandReplicas(configuredReplicas []interface{}) []*dynamodb.Replica {
 	replicas := make([]*dynamodb.Replica, 0, len(configuredReplicas))
 	for _, replicaRaw := range configuredReplicas {
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
@@ -30,7 +30,7 @@ func sweepTables(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DynamoDBConn
+	conn := client.(*conns.AWSClient).DynamoDBConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	var g multierror.Group
@@ -67,6 +67,7 @@ func ResourceTable() *schema.Resource {
 				return nil
 			},
 			verify.SetTagsDiff,
+			customizeDiffValidateReplicaRegions,
 		),
 
 		SchemaVersion: 1,
@@ -323,10 +324,14 @@ func ResourceTable() *schema.Resource {
 }
 
 func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	if err := validateReplicaRegions(meta.(*conns.AWSClient), d.Get("replica").(*schema.Set).List()); err != nil {
+		return err
+	}
+
 	keySchemaMap := map[string]interface{}{
 		"hash_key": d.Get("hash_key").(string),
 	}
@@ -473,7 +478,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -602,9 +607,17 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	billingMode := d.Get("billing_mode").(string)
 
+	if d.HasChange("replica") {
+		o, n := d.GetChange("replica")
+
+		if err := validateReplicaRegions(meta.(*conns.AWSClient), n.(*schema.Set).Difference(o.(*schema.Set)).List()); err != nil {
+			return err
+		}
+	}
+
 	// Global Secondary Index operations must occur in multiple phases
 	// to prevent various error scenarios. If there are no detected required
 	// updates in the Terraform configuration, later validation or API errors
@@ -787,7 +800,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	log.Printf("[DEBUG] DynamoDB delete table: %s", d.Id())
 
@@ -26,9 +26,10 @@ func init() {
 }
 
 // This is part of an experimental feature, do not use this as a starting point for tests
-//   "This place is not a place of honor... no highly esteemed deed is commemorated here... nothing valued is here.
-//   What is here was dangerous and repulsive to us. This message is a warning about danger."
-//   --  https://hyperallergic.com/312318/a-nuclear-warning-designed-to-last-10000-years/
+//
+//	"This place is not a place of honor... no highly esteemed deed is commemorated here... nothing valued is here.
+//	What is here was dangerous and repulsive to us. This message is a warning about danger."
+//	--  https://hyperallergic.com/312318/a-nuclear-warning-designed-to-last-10000-years/
 func TestAccEC2ClientVPNEndpoint_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Endpoint": {
@@ -338,7 +339,7 @@ func testAccClientVPNEndpoint_tags(t *testing.T) {
 
 func testAccClientVPNEndpoint_splitTunnel(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -372,7 +373,7 @@ func testAccClientVPNEndpoint_splitTunnel(t *testing.T) {
 
 func testAccClientVPNEndpoint_selfServicePortal(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -409,7 +410,7 @@ func testAccPreCheckClientVPNSyncronize(t *testing.T) {
 }
 
 func testAccCheckClientVPNEndpointDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_client_vpn_endpoint" {
@@ -437,7 +438,7 @@ func testAccCheckClientVPNEndpointExists(name string, endpoint *ec2.ClientVpnEnd
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := &ec2.DescribeClientVpnEndpointsInput{
 			ClientVpnEndpointIds: aws.StringSlice([]string{rs.Primary.ID}),
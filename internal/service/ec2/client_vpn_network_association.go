@@ -59,7 +59,7 @@ func ResourceClientVPNNetworkAssociation() *schema.Resource {
 }
 
 func resourceClientVPNNetworkAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.AssociateClientVpnTargetNetworkInput{
 		ClientVpnEndpointId: aws.String(d.Get("client_vpn_endpoint_id").(string)),
@@ -97,7 +97,7 @@ func resourceClientVPNNetworkAssociationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceClientVPNNetworkAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("security_groups") {
 		input := &ec2.ApplySecurityGroupsToClientVpnTargetNetworkInput{
@@ -115,7 +115,7 @@ func resourceClientVPNNetworkAssociationUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceClientVPNNetworkAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	var err error
 
 	result, err := conn.DescribeClientVpnTargetNetworks(&ec2.DescribeClientVpnTargetNetworksInput{
@@ -160,7 +160,7 @@ func resourceClientVPNNetworkAssociationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceClientVPNNetworkAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	err := DeleteClientVPNNetworkAssociation(conn, d.Id(), d.Get("client_vpn_endpoint_id").(string))
 	if err != nil {
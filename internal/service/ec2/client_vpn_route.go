@@ -56,7 +56,7 @@ func ResourceClientVPNRoute() *schema.Resource {
 }
 
 func resourceClientVPNRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	endpointID := d.Get("client_vpn_endpoint_id").(string)
 	targetSubnetID := d.Get("target_vpc_subnet_id").(string)
@@ -86,7 +86,7 @@ func resourceClientVPNRouteCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceClientVPNRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	resp, err := FindClientVPNRoute(conn,
 		d.Get("client_vpn_endpoint_id").(string),
@@ -126,7 +126,7 @@ func resourceClientVPNRouteRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceClientVPNRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	err := deleteClientVpnRoute(conn, &ec2.DeleteClientVpnRouteInput{
 		ClientVpnEndpointId:  aws.String(d.Get("client_vpn_endpoint_id").(string)),
@@ -3,6 +3,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -20,14 +21,19 @@ const EventualConsistencyTimeout = 5 * time.Minute
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func CreateTags(conn *ec2.EC2, identifier string, tagsMap interface{}) error {
+	return CreateTagsWithContext(context.Background(), conn, identifier, tagsMap)
+}
+
+// CreateTagsWithContext is the same as CreateTags with the addition of the ability to pass a context.
+func CreateTagsWithContext(ctx context.Context, conn *ec2.EC2, identifier string, tagsMap interface{}) error {
 	tags := tftags.New(tagsMap)
 	input := &ec2.CreateTagsInput{
 		Resources: aws.StringSlice([]string{identifier}),
 		Tags:      Tags(tags.IgnoreAWS()),
 	}
 
-	_, err := tfresource.RetryWhenNotFound(EventualConsistencyTimeout, func() (interface{}, error) {
-		output, err := conn.CreateTags(input)
+	_, err := tfresource.RetryWhenNotFoundContext(ctx, EventualConsistencyTimeout, func() (interface{}, error) {
+		output, err := conn.CreateTagsWithContext(ctx, input)
 
 		if tfawserr.ErrCodeContains(err, ".NotFound") {
 			err = &resource.NotFoundError{
@@ -14,6 +14,7 @@ func TestAccEC2CustomerGatewayDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_customer_gateway.test"
 	resourceName := "aws_customer_gateway.test"
 
+	rName := acctest.RandomWithPrefix(t, "test-filter")
 	asn := sdkacctest.RandIntRange(64512, 65534)
 	hostOctet := sdkacctest.RandIntRange(1, 254)
 
@@ -24,7 +25,7 @@ func TestAccEC2CustomerGatewayDataSource_filter(t *testing.T) {
 		CheckDestroy: testAccCheckCustomerGatewayDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCustomerGatewayFilterDataSourceConfig(asn, hostOctet),
+				Config: testAccCustomerGatewayFilterDataSourceConfig(rName, asn, hostOctet),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(resourceName, "bgp_asn", dataSourceName, "bgp_asn"),
 					resource.TestCheckResourceAttrPair(resourceName, "ip_address", dataSourceName, "ip_address"),
@@ -64,8 +65,7 @@ func TestAccEC2CustomerGatewayDataSource_id(t *testing.T) {
 	})
 }
 
-func testAccCustomerGatewayFilterDataSourceConfig(asn, hostOctet int) string {
-	name := sdkacctest.RandomWithPrefix("test-filter")
+func testAccCustomerGatewayFilterDataSourceConfig(rName string, asn, hostOctet int) string {
 	return fmt.Sprintf(`
 resource "aws_customer_gateway" "test" {
   bgp_asn    = %d
@@ -83,7 +83,7 @@ data "aws_customer_gateway" "test" {
     values = [aws_customer_gateway.test.tags.Name]
   }
 }
-`, asn, hostOctet, name)
+`, asn, hostOctet, rName)
 }
 
 func testAccCustomerGatewayIDDataSourceConfig(asn, hostOctet int) string {
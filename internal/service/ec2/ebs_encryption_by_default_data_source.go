@@ -21,7 +21,7 @@ func DataSourceEBSEncryptionByDefault() *schema.Resource {
 	}
 }
 func dataSourceEBSEncryptionByDefaultRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	res, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
 	if err != nil {
@@ -69,7 +69,7 @@ func ResourceEIPAssociation() *schema.Resource {
 }
 
 func resourceEIPAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	request := &ec2.AssociateAddressInput{}
 
@@ -151,7 +151,7 @@ func resourceEIPAssociationCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceEIPAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	request, err := DescribeAddressesByID(d.Id(), meta.(*conns.AWSClient).SupportedPlatforms)
 	if err != nil {
@@ -159,7 +159,7 @@ func resourceEIPAssociationRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	var response *ec2.DescribeAddressesOutput
-	err = resource.Retry(PropagationTimeout, func() *resource.RetryError {
+	err = resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.EC2).Timeout, func() *resource.RetryError {
 		var err error
 		response, err = conn.DescribeAddresses(request)
 
@@ -202,7 +202,7 @@ func resourceEIPAssociationRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEIPAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	var opts *ec2.DisassociateAddressInput
 	// We assume EC2 Classic if ID is a valid IPv4 address
@@ -69,7 +69,7 @@ func TestAccEC2EIPAssociation_networkInterface(t *testing.T) {
 func TestAccEC2EIPAssociation_basic(t *testing.T) {
 	var a ec2.Address
 	resourceName := "aws_eip_association.by_allocation_id"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckEC2VPCOnly(t) },
@@ -128,7 +128,7 @@ func TestAccEC2EIPAssociation_ec2Classic(t *testing.T) {
 
 func TestAccEC2EIPAssociation_spotInstance(t *testing.T) {
 	var a ec2.Address
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_eip_association.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
@@ -163,7 +163,7 @@ func TestAccEC2EIPAssociation_spotInstance(t *testing.T) {
 func TestAccEC2EIPAssociation_disappears(t *testing.T) {
 	var a ec2.Address
 	resourceName := "aws_eip_association.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -205,7 +205,7 @@ func testAccCheckEIPAssociationExists(name string, res *ec2.Address) resource.Te
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		platforms := acctest.Provider.Meta().(*conns.AWSClient).SupportedPlatforms
 
 		request, err := tfec2.DescribeAddressesByID(rs.Primary.ID, platforms)
@@ -238,7 +238,7 @@ func testAccCheckEIPAssociationClassicExists(name string, res *ec2.Address) reso
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn()
 		platforms := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).SupportedPlatforms
 
 		request, err := tfec2.DescribeAddressesByID(rs.Primary.ID, platforms)
@@ -292,7 +292,7 @@ func testAccCheckEIPAssociationDestroy(s *terraform.State) error {
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		request := &ec2.DescribeAddressesInput{
 			Filters: []*ec2.Filter{
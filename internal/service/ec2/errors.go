@@ -59,6 +59,8 @@ const (
 const (
 	InvalidSecurityGroupIDNotFound = "InvalidSecurityGroupID.NotFound"
 	InvalidGroupNotFound           = "InvalidGroup.NotFound"
+
+	ErrCodeInvalidSecurityGroupRuleIdNotFound = "InvalidSecurityGroupRuleId.NotFound"
 )
 
 const (
@@ -0,0 +1,101 @@
+// Code generated by "internal/generate/findfuncs/main.go -Op=DescribeSecurityGroups -Name=SecurityGroup -InputIDField=GroupIds -IDField=GroupId -NotFoundErrCodes=InvalidSecurityGroupIDNotFound,InvalidGroupNotFound"; DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// FindSecurityGroupByID looks up a SecurityGroup by ID. Returns a resource.NotFoundError if not found.
+func FindSecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
+	return FindSecurityGroupByIDWithContext(context.Background(), conn, id)
+}
+
+// FindSecurityGroupByIDWithContext is the same as FindSecurityGroupByID with the addition of the ability to pass a context.
+func FindSecurityGroupByIDWithContext(ctx context.Context, conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
+	input := &ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindSecurityGroupWithContext(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.GroupId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindSecurityGroup looks up a SecurityGroup using the specified ec2.DescribeSecurityGroupsInput. Returns a resource.NotFoundError if not found.
+func FindSecurityGroup(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (*ec2.SecurityGroup, error) {
+	return FindSecurityGroupWithContext(context.Background(), conn, input)
+}
+
+// FindSecurityGroupWithContext is the same as FindSecurityGroup with the addition of the ability to pass a context.
+func FindSecurityGroupWithContext(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (*ec2.SecurityGroup, error) {
+	output, err := FindSecurityGroupsWithContext(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// FindSecurityGroups returns all SecurityGroups matching the specified ec2.DescribeSecurityGroupsInput.
+func FindSecurityGroups(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
+	return FindSecurityGroupsWithContext(context.Background(), conn, input)
+}
+
+// FindSecurityGroupsWithContext is the same as FindSecurityGroups with the addition of the ability to pass a context.
+func FindSecurityGroupsWithContext(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
+	var output []*ec2.SecurityGroup
+
+	err := conn.DescribeSecurityGroupsPagesWithContext(ctx, input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, InvalidSecurityGroupIDNotFound) || tfawserr.ErrCodeEquals(err, InvalidGroupNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
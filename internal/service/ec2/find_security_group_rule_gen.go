@@ -0,0 +1,101 @@
+// Code generated by "internal/generate/findfuncs/main.go -Op=DescribeSecurityGroupRules -Name=SecurityGroupRule -InputIDField=SecurityGroupRuleIds -IDField=SecurityGroupRuleId -NotFoundErrCodes=ErrCodeInvalidSecurityGroupRuleIdNotFound,InvalidGroupNotFound"; DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// FindSecurityGroupRuleByID looks up a SecurityGroupRule by ID. Returns a resource.NotFoundError if not found.
+func FindSecurityGroupRuleByID(conn *ec2.EC2, id string) (*ec2.SecurityGroupRule, error) {
+	return FindSecurityGroupRuleByIDWithContext(context.Background(), conn, id)
+}
+
+// FindSecurityGroupRuleByIDWithContext is the same as FindSecurityGroupRuleByID with the addition of the ability to pass a context.
+func FindSecurityGroupRuleByIDWithContext(ctx context.Context, conn *ec2.EC2, id string) (*ec2.SecurityGroupRule, error) {
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		SecurityGroupRuleIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindSecurityGroupRuleWithContext(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.SecurityGroupRuleId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindSecurityGroupRule looks up a SecurityGroupRule using the specified ec2.DescribeSecurityGroupRulesInput. Returns a resource.NotFoundError if not found.
+func FindSecurityGroupRule(conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) (*ec2.SecurityGroupRule, error) {
+	return FindSecurityGroupRuleWithContext(context.Background(), conn, input)
+}
+
+// FindSecurityGroupRuleWithContext is the same as FindSecurityGroupRule with the addition of the ability to pass a context.
+func FindSecurityGroupRuleWithContext(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) (*ec2.SecurityGroupRule, error) {
+	output, err := FindSecurityGroupRulesWithContext(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// FindSecurityGroupRules returns all SecurityGroupRules matching the specified ec2.DescribeSecurityGroupRulesInput.
+func FindSecurityGroupRules(conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) ([]*ec2.SecurityGroupRule, error) {
+	return FindSecurityGroupRulesWithContext(context.Background(), conn, input)
+}
+
+// FindSecurityGroupRulesWithContext is the same as FindSecurityGroupRules with the addition of the ability to pass a context.
+func FindSecurityGroupRulesWithContext(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) ([]*ec2.SecurityGroupRule, error) {
+	var output []*ec2.SecurityGroupRule
+
+	err := conn.DescribeSecurityGroupRulesPagesWithContext(ctx, input, func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroupRules {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidSecurityGroupRuleIdNotFound) || tfawserr.ErrCodeEquals(err, InvalidGroupNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -1,5 +1,7 @@
-//go:generate go run ../../generate/tagresource/main.go -IDAttribName=resource_id
+//go:generate go run ../../generate/findfuncs/main.go -Op=DescribeSecurityGroupRules -Name=SecurityGroupRule -InputIDField=SecurityGroupRuleIds -IDField=SecurityGroupRuleId -NotFoundErrCodes=ErrCodeInvalidSecurityGroupRuleIdNotFound,InvalidGroupNotFound
+//go:generate go run ../../generate/findfuncs/main.go -Op=DescribeSecurityGroups -Name=SecurityGroup -InputIDField=GroupIds -IDField=GroupId -NotFoundErrCodes=InvalidSecurityGroupIDNotFound,InvalidGroupNotFound
 //go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsOp=DescribeTags -ListTagsInFiltIDName=resource-id -ListTagsInIDElem=Resources -ServiceTagsSlice -TagOp=CreateTags -TagInIDElem=Resources -TagInIDNeedSlice=yes -TagType2=TagDescription -UntagOp=DeleteTags -UntagInNeedTagType -UntagInTagsElem=Tags -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -IDAttribName=resource_id
 //go:generate go run generate/createtags/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -122,6 +122,7 @@ var templateBody = `
 package {{ .ServicePackage }}
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -139,6 +140,11 @@ const EventualConsistencyTimeout = 5 * time.Minute
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func CreateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, tagsMap interface{}) error {
+	return CreateTagsWithContext(context.Background(), conn, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}, tagsMap)
+}
+
+// CreateTagsWithContext is the same as CreateTags with the addition of the ability to pass a context.
+func CreateTagsWithContext(ctx context.Context, conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, tagsMap interface{}) error {
 	tags := tftags.New(tagsMap)
 
 	{{- if .TagOpBatchSize }}
@@ -164,15 +170,15 @@ func CreateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 
 	{{- if .RetryCreateOnNotFound }}
 
-	_, err := tfresource.RetryWhenNotFound(EventualConsistencyTimeout, func() (interface{}, error) {
-		output, err := conn.{{ .TagOp }}(input)
+	_, err := tfresource.RetryWhenNotFoundContext(ctx, EventualConsistencyTimeout, func() (interface{}, error) {
+		output, err := conn.{{ .TagOp }}WithContext(ctx, input)
 
 		{{ .ParentNotFoundError }}
 
 		return output, err
 	})
 	{{- else }}
-	_, err := conn.{{ .TagOp }}(input)
+	_, err := conn.{{ .TagOp }}WithContext(ctx, input)
 	{{- end }}
 
 	if err != nil {
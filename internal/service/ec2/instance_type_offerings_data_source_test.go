@@ -90,7 +90,7 @@ func testAccCheckEc2InstanceTypeOfferingsLocations(dataSourceName string) resour
 }
 
 func testAccPreCheckInstanceTypeOfferings(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeInstanceTypeOfferingsInput{
 		MaxResults: aws.Int64(5),
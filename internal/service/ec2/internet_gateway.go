@@ -50,7 +50,7 @@ func ResourceInternetGateway() *schema.Resource {
 }
 
 func resourceInternetGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -101,7 +101,7 @@ func resourceInternetGatewayCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -162,7 +162,7 @@ func resourceInternetGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -176,7 +176,7 @@ func resourceInternetGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceInternetGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	// Detach if it is attached
 	if err := resourceInternetGatewayDetach(d, meta); err != nil {
@@ -213,7 +213,7 @@ func resourceInternetGatewayDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceInternetGatewayAttach(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.Get("vpc_id").(string) == "" {
 		log.Printf(
@@ -271,7 +271,7 @@ func resourceInternetGatewayAttach(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceInternetGatewayDetach(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	// Get the old VPC ID to detach from
 	vpcID, _ := d.GetChange("vpc_id")
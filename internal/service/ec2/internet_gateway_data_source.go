@@ -52,7 +52,7 @@ func DataSourceInternetGateway() *schema.Resource {
 }
 
 func dataSourceInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeInternetGatewaysInput{}
@@ -73,7 +73,7 @@ func ResourceNatGateway() *schema.Resource {
 }
 
 func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -122,7 +122,7 @@ func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -171,7 +171,7 @@ func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -185,7 +185,7 @@ func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	deleteOpts := &ec2.DeleteNatGatewayInput{
 		NatGatewayId: aws.String(d.Id()),
 	}
@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -170,7 +171,7 @@ func ResourceNetworkInterface() *schema.Resource {
 }
 
 func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -281,13 +282,19 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(1*time.Minute, func() (interface{}, error) {
-		return FindNetworkInterfaceByID(conn, d.Id())
-	}, d.IsNewResource())
+	var eni *ec2.NetworkInterface
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.EC2).Read(context.Background(), d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		eni, err = FindNetworkInterfaceByID(conn, d.Id())
+
+		return err
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 Network Interface (%s) not found, removing from state", d.Id())
@@ -299,8 +306,6 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error reading EC2 Network Interface (%s): %w", d.Id(), err)
 	}
 
-	eni := outputRaw.(*ec2.NetworkInterface)
-
 	ownerID := aws.StringValue(eni.OwnerId)
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -374,7 +379,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("attachment") {
 		oa, na := d.GetChange("attachment")
@@ -774,7 +779,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if v, ok := d.GetOk("attachment"); ok && v.(*schema.Set).Len() > 0 {
 		attachment := v.(*schema.Set).List()[0].(map[string]interface{})
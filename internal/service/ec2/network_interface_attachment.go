@@ -44,7 +44,7 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 }
 
 func resourceNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	attachmentID, err := attachNetworkInterface(
 		conn,
@@ -66,7 +66,7 @@ func resourceNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	attachment, err := FindNetworkInterfaceAttachmentByID(conn, d.Id())
 
@@ -89,7 +89,7 @@ func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	return detachNetworkInterface(conn, d.Get("network_interface_id").(string), d.Id(), networkInterfaceDetachedTimeout)
 }
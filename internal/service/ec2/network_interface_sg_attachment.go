@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -34,7 +35,7 @@ func ResourceNetworkInterfaceSGAttachment() *schema.Resource {
 }
 
 func resourceNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
@@ -82,13 +83,19 @@ func resourceNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta int
 }
 
 func resourceNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
-		return FindNetworkInterfaceSecurityGroup(conn, networkInterfaceID, sgID)
-	}, d.IsNewResource())
+	var groupIdentifier *ec2.GroupIdentifier
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.EC2).Read(context.Background(), d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		groupIdentifier, err = FindNetworkInterfaceSecurityGroup(conn, networkInterfaceID, sgID)
+
+		return err
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 Network Interface (%s) Security Group (%s) Attachment not found, removing from state", networkInterfaceID, sgID)
@@ -100,8 +107,6 @@ func resourceNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error reading EC2 Network Interface (%s) Security Group (%s) Attachment: %w", networkInterfaceID, sgID, err)
 	}
 
-	groupIdentifier := outputRaw.(*ec2.GroupIdentifier)
-
 	d.Set("network_interface_id", networkInterfaceID)
 	d.Set("security_group_id", groupIdentifier.GroupId)
 
@@ -109,7 +114,7 @@ func resourceNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta inter
 }
 
 func resourceNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
@@ -655,7 +655,7 @@ func TestAccEC2SecurityGroupRule_prefixListEgress(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupSG := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -842,7 +842,7 @@ func TestAccEC2SecurityGroupRule_EgressDescription_updates(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_Description_allPorts(t *testing.T) {
 	var group ec2.SecurityGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	securityGroupResourceName := "aws_security_group.test"
 	resourceName := "aws_security_group_rule.test"
 
@@ -900,7 +900,7 @@ func TestAccEC2SecurityGroupRule_Description_allPorts(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_DescriptionAllPorts_nonZeroPorts(t *testing.T) {
 	var group ec2.SecurityGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	securityGroupResourceName := "aws_security_group.test"
 	resourceName := "aws_security_group_rule.test"
 
@@ -959,7 +959,7 @@ func TestAccEC2SecurityGroupRule_DescriptionAllPorts_nonZeroPorts(t *testing.T)
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/6416
 func TestAccEC2SecurityGroupRule_MultipleRuleSearching_allProtocolCrash(t *testing.T) {
 	var group ec2.SecurityGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	securityGroupResourceName := "aws_security_group.test"
 	resourceName1 := "aws_security_group_rule.test1"
 	resourceName2 := "aws_security_group_rule.test2"
@@ -1055,7 +1055,7 @@ func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupPL := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -1178,7 +1178,7 @@ func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 }
 
 func testAccCheckSecurityGroupRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_security_group" {
@@ -1210,7 +1210,7 @@ func testAccCheckSecurityGroupRuleExists(n string, group *ec2.SecurityGroup) res
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if err != nil {
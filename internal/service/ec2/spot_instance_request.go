@@ -158,7 +158,7 @@ func ResourceSpotInstanceRequest() *schema.Resource {
 }
 
 func resourceSpotInstanceRequestCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -285,13 +285,13 @@ func resourceSpotInstanceRequestCreate(d *schema.ResourceData, meta interface{})
 
 // Update spot state, etc
 func resourceSpotInstanceRequestRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	var request *ec2.SpotInstanceRequest
 
-	err := resource.Retry(PropagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.EC2).Timeout, func() *resource.RetryError {
 		var err error
 
 		request, err = FindSpotInstanceRequestByID(conn, d.Id())
@@ -386,7 +386,7 @@ func resourceSpotInstanceRequestRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func readInstance(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	instance, err := InstanceFindByID(conn, d.Get("spot_instance_id").(string))
 	if err != nil {
@@ -469,7 +469,7 @@ func readInstance(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSpotInstanceRequestUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -483,7 +483,7 @@ func resourceSpotInstanceRequestUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSpotInstanceRequestDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Cancelling spot request: %s", d.Id())
 	_, err := conn.CancelSpotInstanceRequests(&ec2.CancelSpotInstanceRequestsInput{
@@ -8,10 +8,9 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
-func TestAccEC2Tag_basic(t *testing.T) {
+func TestAccEC2Tag_ResourceID_transitGatewayAttachment(t *testing.T) {
 	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	resourceName := "aws_ec2_tag.test"
 
@@ -22,7 +21,7 @@ func TestAccEC2Tag_basic(t *testing.T) {
 		CheckDestroy: testAccCheckTagDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccEc2TagConfig(rBgpAsn, "key1", "value1"),
+				Config: testAccTagConfigResourceIDTransitGatewayAttachment(rBgpAsn, "key1", "value1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTagExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
@@ -38,64 +37,34 @@ func TestAccEC2Tag_basic(t *testing.T) {
 	})
 }
 
-func TestAccEC2Tag_disappears(t *testing.T) {
-	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
-	resourceName := "aws_ec2_tag.test"
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckTagDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccEc2TagConfig(rBgpAsn, "key1", "value1"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTagExists(resourceName),
-					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceTag(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func TestAccEC2Tag_value(t *testing.T) {
-	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
-	resourceName := "aws_ec2_tag.test"
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckTagDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccEc2TagConfig(rBgpAsn, "key1", "value1"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTagExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
-					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccEc2TagConfig(rBgpAsn, "key1", "value1updated"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTagExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
-					resource.TestCheckResourceAttr(resourceName, "value", "value1updated"),
-				),
-			},
-		},
-	})
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_ec2_tag" "test" {
+  resource_id = aws_security_group.test.id
+  key         = %[2]q
+  value       = %[3]q
+}
+`, rName, key, value)
 }
 
-func testAccEc2TagConfig(rBgpAsn int, key string, value string) string {
+func testAccTagConfigResourceIDTransitGatewayAttachment(rBgpAsn int, key string, value string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {}
 
@@ -47,7 +47,7 @@ func ResourceTransitGatewayRouteTableAssociation() *schema.Resource {
 }
 
 func resourceTransitGatewayRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayAttachmentID := d.Get("transit_gateway_attachment_id").(string)
 	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
@@ -72,7 +72,7 @@ func resourceTransitGatewayRouteTableAssociationCreate(d *schema.ResourceData, m
 }
 
 func resourceTransitGatewayRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, transitGatewayAttachmentID, err := DecodeTransitGatewayRouteTableAssociationID(d.Id())
 	if err != nil {
@@ -112,7 +112,7 @@ func resourceTransitGatewayRouteTableAssociationRead(d *schema.ResourceData, met
 }
 
 func resourceTransitGatewayRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, transitGatewayAttachmentID, err := DecodeTransitGatewayRouteTableAssociationID(d.Id())
 	if err != nil {
@@ -138,7 +138,7 @@ func ResourceVPC() *schema.Resource {
 }
 
 func resourceVPCCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -248,7 +248,7 @@ func resourceVPCCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -429,7 +429,7 @@ func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	vpcid := d.Id()
 	if d.HasChange("enable_dns_hostnames") {
@@ -582,7 +582,7 @@ func resourceVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	vpcID := d.Id()
 	deleteVpcOpts := &ec2.DeleteVpcInput{
 		VpcId: &vpcID,
@@ -34,7 +34,7 @@ func TestAccEC2VPCEndpointServiceAllowedPrincipal_basic(t *testing.T) {
 }
 
 func testAccCheckVpcEndpointServiceAllowedPrincipalDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint_service_allowed_principal" {
@@ -77,7 +77,7 @@ func testAccCheckVpcEndpointServiceAllowedPrincipalExists(n string) resource.Tes
 			return fmt.Errorf("No VPC Endpoint Service ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		resp, err := conn.DescribeVpcEndpointServicePermissions(&ec2.DescribeVpcEndpointServicePermissionsInput{
 			ServiceId: aws.String(rs.Primary.Attributes["vpc_endpoint_service_id"]),
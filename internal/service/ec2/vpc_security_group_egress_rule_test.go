@@ -0,0 +1,137 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2VPCSecurityGroupEgressRule_basic(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_egress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCSecurityGroupEgressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupEgressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupEgressRuleExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`security-group-rule/sgr-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", ""),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv6", "::/0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "egress"),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "443"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCSecurityGroupEgressRule_disappears(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_egress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCSecurityGroupEgressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupEgressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupEgressRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCSecurityGroupEgressRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCSecurityGroupEgressRule_prefixListID(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_egress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCSecurityGroupEgressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupEgressRulePrefixListIDConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupEgressRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", "aws_ec2_managed_prefix_list.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCSecurityGroupEgressRuleDestroy(s *terraform.State) error {
+	return testAccCheckVPCSecurityGroupRuleDestroy(s, "aws_vpc_security_group_egress_rule")
+}
+
+func testAccCheckVPCSecurityGroupEgressRuleExists(n string, v *ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return testAccCheckVPCSecurityGroupRuleExists(n, true, v)
+}
+
+func testAccVPCSecurityGroupEgressRuleConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfigBase(rName), `
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv6   = "::/0"
+  description = "egress"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+`)
+}
+
+func testAccVPCSecurityGroupEgressRulePrefixListIDConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ip_protocol    = "-1"
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+}
+`, rName))
+}
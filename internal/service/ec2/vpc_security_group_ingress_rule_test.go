@@ -0,0 +1,321 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2VPCSecurityGroupIngressRule_basic(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupIngressRuleExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`security-group-rule/sgr-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv6", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_list_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "referenced_security_group_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "security_group_rule_id", regexp.MustCompile(`^sgr-`)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "8080"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCSecurityGroupIngressRule_disappears(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupIngressRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCSecurityGroupIngressRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCSecurityGroupIngressRule_tags(t *testing.T) {
+	var v ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupIngressRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupIngressRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupIngressRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCSecurityGroupIngressRule_updateSource(t *testing.T) {
+	var v1, v2 ec2.SecurityGroupRule
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCSecurityGroupIngressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupIngressRuleExists(resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRuleReferencedSecurityGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupIngressRuleExists(resourceName, &v2),
+					testAccCheckVPCSecurityGroupRuleNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "referenced_security_group_id", "aws_security_group.source", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCSecurityGroupRuleNotRecreated(before, after *ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.SecurityGroupRuleId), aws.StringValue(after.SecurityGroupRuleId); before != after {
+			return fmt.Errorf("EC2 Security Group Rule (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCSecurityGroupIngressRuleDestroy(s *terraform.State) error {
+	return testAccCheckVPCSecurityGroupRuleDestroy(s, "aws_vpc_security_group_ingress_rule")
+}
+
+func testAccCheckVPCSecurityGroupRuleDestroy(s *terraform.State, resourceType string) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		_, err := tfec2.FindSecurityGroupRuleByIDWithContext(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Security Group Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVPCSecurityGroupIngressRuleExists(n string, v *ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return testAccCheckVPCSecurityGroupRuleExists(n, false, v)
+}
+
+func testAccCheckVPCSecurityGroupRuleExists(n string, isEgress bool, v *ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Security Group Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindSecurityGroupRuleByIDWithContext(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.IsEgress); got != isEgress {
+			return fmt.Errorf("EC2 Security Group Rule (%s) IsEgress: expected %t, got %t", rs.Primary.ID, isEgress, got)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVPCSecurityGroupRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCSecurityGroupIngressRuleConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfigBase(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`)
+}
+
+func testAccVPCSecurityGroupIngressRuleReferencedSecurityGroupConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_security_group" "source" {
+  vpc_id = aws_vpc.test.id
+  name   = "%[1]s-source"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  description                  = "updated"
+  ip_protocol                  = "-1"
+  referenced_security_group_id = aws_security_group.source.id
+}
+`, rName))
+}
+
+func testAccVPCSecurityGroupIngressRuleConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccVPCSecurityGroupIngressRuleConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,401 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	securityGroupRuleTypeEgress  = "egress"
+	securityGroupRuleTypeIngress = "ingress"
+)
+
+// resourceVPCSecurityGroupRule returns the resource shared by aws_vpc_security_group_ingress_rule
+// and aws_vpc_security_group_egress_rule, each of which manages a single security group rule.
+func resourceVPCSecurityGroupRule(ruleType string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceVPCSecurityGroupRuleCreate(ctx, d, meta, ruleType)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceVPCSecurityGroupRuleRead(ctx, d, meta, ruleType)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceVPCSecurityGroupRuleUpdate(ctx, d, meta, ruleType)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceVPCSecurityGroupRuleDelete(ctx, d, meta, ruleType)
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: ProtocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"referenced_security_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"to_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+	}
+}
+
+func resourceVPCSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, ruleType string) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ipPermission := expandVPCSecurityGroupRuleIPPermission(d)
+	tagSpecifications := ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule)
+
+	var rules []*ec2.SecurityGroupRule
+
+	switch ruleType {
+	case securityGroupRuleTypeIngress:
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Authorizing EC2 Security Group (%s) ingress rule: %s", securityGroupID, input)
+		output, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error authorizing EC2 Security Group (%s) ingress rule: %s", securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	case securityGroupRuleTypeEgress:
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Authorizing EC2 Security Group (%s) egress rule: %s", securityGroupID, input)
+		output, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error authorizing EC2 Security Group (%s) egress rule: %s", securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	}
+
+	// A single IP permission with a single source or destination always creates exactly one rule.
+	if len(rules) != 1 {
+		return diag.Errorf("error authorizing EC2 Security Group (%s) %s rule: expected 1 rule, got %d", securityGroupID, ruleType, len(rules))
+	}
+
+	d.SetId(aws.StringValue(rules[0].SecurityGroupRuleId))
+
+	return resourceVPCSecurityGroupRuleRead(ctx, d, meta, ruleType)
+}
+
+func resourceVPCSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}, ruleType string) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var rule *ec2.SecurityGroupRule
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.EC2).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		rule, err = FindSecurityGroupRuleByIDWithContext(ctx, conn, d.Id())
+
+		if err == nil && aws.BoolValue(rule.IsEgress) != (ruleType == securityGroupRuleTypeEgress) {
+			err = &resource.NotFoundError{
+				Message: fmt.Sprintf("EC2 Security Group Rule (%s) is not an %s rule", d.Id(), ruleType),
+			}
+		}
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Security Group %s rule (%s) not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Security Group %s rule (%s): %s", ruleType, d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	d.Set("ip_protocol", ProtocolForValue(aws.StringValue(rule.IpProtocol)))
+	d.Set("prefix_list_id", rule.PrefixListId)
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+
+	// Ports are not applicable to all protocols; the API returns -1 for them.
+	if aws.StringValue(rule.IpProtocol) == "-1" {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	} else {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	}
+
+	d.Set("referenced_security_group_id", nil)
+
+	if v := rule.ReferencedGroupInfo; v != nil {
+		referencedSecurityGroupID := aws.StringValue(v.GroupId)
+
+		// Security groups in other accounts are referenced as <account-id>/<group-id>.
+		if userID := aws.StringValue(v.UserId); userID != "" && userID != aws.StringValue(rule.GroupOwnerId) {
+			referencedSecurityGroupID = userID + "/" + referencedSecurityGroupID
+		}
+
+		d.Set("referenced_security_group_id", referencedSecurityGroupID)
+	}
+
+	tags := KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVPCSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, ruleType string) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandVPCSecurityGroupRuleRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Security Group %s rule: %s", ruleType, input)
+		_, err := conn.ModifySecurityGroupRulesWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error modifying EC2 Security Group %s rule (%s): %s", ruleType, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating EC2 Security Group %s rule (%s) tags: %s", ruleType, d.Id(), err)
+		}
+	}
+
+	return resourceVPCSecurityGroupRuleRead(ctx, d, meta, ruleType)
+}
+
+func resourceVPCSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, ruleType string) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ruleIDs := aws.StringSlice([]string{d.Id()})
+
+	log.Printf("[INFO] Revoking EC2 Security Group (%s) %s rule: %s", securityGroupID, ruleType, d.Id())
+	var err error
+
+	switch ruleType {
+	case securityGroupRuleTypeIngress:
+		_, err = conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: ruleIDs,
+		})
+	case securityGroupRuleTypeEgress:
+		_, err = conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: ruleIDs,
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidSecurityGroupRuleIdNotFound, InvalidSecurityGroupIDNotFound, InvalidGroupNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error revoking EC2 Security Group (%s) %s rule (%s): %s", securityGroupID, ruleType, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVPCSecurityGroupRuleIPPermission(d *schema.ResourceData) *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	var description *string
+
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(v.(string)),
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		pair := &ec2.UserIdGroupPair{
+			Description: description,
+		}
+
+		if parts := strings.Split(v.(string), "/"); len(parts) == 2 {
+			pair.UserId = aws.String(parts[0])
+			pair.GroupId = aws.String(parts[1])
+		} else {
+			pair.GroupId = aws.String(v.(string))
+		}
+
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+	}
+
+	return apiObject
+}
+
+func expandVPCSecurityGroupRuleRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		// Only the group ID is accepted when modifying a rule.
+		parts := strings.Split(v.(string), "/")
+		apiObject.ReferencedGroupId = aws.String(parts[len(parts)-1])
+	}
+
+	return apiObject
+}
@@ -30,7 +30,7 @@ func DataSourceVPCs() *schema.Resource {
 }
 
 func dataSourceVPCsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeVpcsInput{}
 
@@ -63,7 +63,7 @@ func ResourceVPNGateway() *schema.Resource {
 }
 
 func resourceVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -100,7 +100,7 @@ func resourceVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -175,7 +175,7 @@ func resourceVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -189,7 +189,7 @@ func resourceVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	// Detach if it is attached
 	if err := resourceVPNGatewayDetach(d, meta); err != nil {
@@ -229,7 +229,7 @@ func resourceVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPNGatewayAttach(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	vpcId := d.Get("vpc_id").(string)
 
@@ -278,7 +278,7 @@ func resourceVPNGatewayAttach(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPNGatewayDetach(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	// Get the old VPC ID to detach from
 	vpcIdRaw, _ := d.GetChange("vpc_id")
@@ -0,0 +1,23 @@
+package ecr_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_ecr_tag" "test" {
+  resource_arn = aws_ecr_repository.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
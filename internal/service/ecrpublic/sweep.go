@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
 		Name: "aws_ecrpublic_repository",
 		F:    sweepRepositories,
 	})
@@ -26,7 +26,7 @@ func sweepRepositories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ECRPublicConn
+	conn := client.(*conns.AWSClient).ECRPublicConn()
 	input := &ecrpublic.DescribeRepositoriesInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
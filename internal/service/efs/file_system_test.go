@@ -18,7 +18,7 @@ import (
 func TestAccEFSFileSystem_basic(t *testing.T) {
 	var desc efs.FileSystemDescription
 	resourceName := "aws_efs_file_system.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -66,7 +66,7 @@ func TestAccEFSFileSystem_basic(t *testing.T) {
 func TestAccEFSFileSystem_availabilityZoneName(t *testing.T) {
 	var desc efs.FileSystemDescription
 	resourceName := "aws_efs_file_system.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -93,7 +93,7 @@ func TestAccEFSFileSystem_availabilityZoneName(t *testing.T) {
 
 func TestAccEFSFileSystem_tags(t *testing.T) {
 	var desc efs.FileSystemDescription
-	rName := sdkacctest.RandomWithPrefix("tf-acc-tags")
+	rName := acctest.RandomWithPrefix(t, "tf-acc-tags")
 	resourceName := "aws_efs_file_system.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -361,7 +361,7 @@ func TestAccEFSFileSystem_lifecyclePolicy(t *testing.T) {
 func TestAccEFSFileSystem_disappears(t *testing.T) {
 	var desc efs.FileSystemDescription
 	resourceName := "aws_efs_file_system.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -383,7 +383,7 @@ func TestAccEFSFileSystem_disappears(t *testing.T) {
 }
 
 func testAccCheckEfsFileSystemDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_file_system" {
 			continue
@@ -416,7 +416,7 @@ func testAccCheckEfsFileSystem(resourceID string, fDesc *efs.FileSystemDescripti
 			return fmt.Errorf("No EFS file system ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn()
 
 		fs, err := tfefs.FindFileSystemByID(conn, rs.Primary.ID)
 
@@ -96,7 +96,7 @@ func ResourceMountTarget() *schema.Resource {
 }
 
 func resourceMountTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EFSConn
+	conn := meta.(*conns.AWSClient).EFSConn()
 
 	fsId := d.Get("file_system_id").(string)
 	subnetId := d.Get("subnet_id").(string)
@@ -105,7 +105,7 @@ func resourceMountTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	// to parallel requests if they both include the same AZ
 	// and we would end up managing the same MT as 2 resources.
 	// So we make it fail by calling 1 request per AZ at a time.
-	az, err := getAzFromSubnetId(subnetId, meta.(*conns.AWSClient).EC2Conn)
+	az, err := getAzFromSubnetId(subnetId, meta.(*conns.AWSClient).EC2Conn())
 	if err != nil {
 		return fmt.Errorf("Failed getting Availability Zone from subnet ID (%s): %s", subnetId, err)
 	}
@@ -171,7 +171,7 @@ func resourceMountTargetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMountTargetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EFSConn
+	conn := meta.(*conns.AWSClient).EFSConn()
 
 	if d.HasChange("security_groups") {
 		input := efs.ModifyMountTargetSecurityGroupsInput{
@@ -188,7 +188,7 @@ func resourceMountTargetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMountTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EFSConn
+	conn := meta.(*conns.AWSClient).EFSConn()
 	resp, err := conn.DescribeMountTargets(&efs.DescribeMountTargetsInput{
 		MountTargetId: aws.String(d.Id()),
 	})
@@ -264,7 +264,7 @@ func getAzFromSubnetId(subnetId string, conn *ec2.EC2) (string, error) {
 }
 
 func resourceMountTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EFSConn
+	conn := meta.(*conns.AWSClient).EFSConn()
 
 	log.Printf("[DEBUG] Deleting EFS mount target %q", d.Id())
 	_, err := conn.DeleteMountTarget(&efs.DeleteMountTargetInput{
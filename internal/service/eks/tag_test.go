@@ -0,0 +1,32 @@
+package eks_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Base(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_eks_tag" "test" {
+  resource_arn = aws_eks_cluster.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
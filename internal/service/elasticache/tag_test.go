@@ -0,0 +1,27 @@
+package elasticache_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id       = %[1]q
+  user_name     = "username1"
+  access_string = "on ~app::* -@all +@read"
+  engine        = "REDIS"
+  passwords     = ["password123456789"]
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_elasticache_tag" "test" {
+  resource_arn = aws_elasticache_user.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,8 +17,8 @@ import (
 func TestAccElasticsearchDomainSamlOptions_SAML_basic(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix(t, "acc-test")
+	rUserName := acctest.RandomWithPrefix(t, "es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -50,8 +49,8 @@ func TestAccElasticsearchDomainSamlOptions_SAML_basic(t *testing.T) {
 }
 
 func TestAccElasticsearchDomainSamlOptions_SAML_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix(t, "acc-test")
+	rUserName := acctest.RandomWithPrefix(t, "es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -73,8 +72,8 @@ func TestAccElasticsearchDomainSamlOptions_SAML_disappears(t *testing.T) {
 }
 
 func TestAccElasticsearchDomainSamlOptions_SAMLDisappears_domain(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix(t, "acc-test")
+	rUserName := acctest.RandomWithPrefix(t, "es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -97,8 +96,8 @@ func TestAccElasticsearchDomainSamlOptions_SAMLDisappears_domain(t *testing.T) {
 }
 
 func TestAccElasticsearchDomainSamlOptions_SAML_update(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix(t, "acc-test")
+	rUserName := acctest.RandomWithPrefix(t, "es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -129,8 +128,8 @@ func TestAccElasticsearchDomainSamlOptions_SAML_update(t *testing.T) {
 }
 
 func TestAccElasticsearchDomainSamlOptions_SAML_disabled(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix(t, "acc-test")
+	rUserName := acctest.RandomWithPrefix(t, "es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -161,7 +160,7 @@ func TestAccElasticsearchDomainSamlOptions_SAML_disabled(t *testing.T) {
 }
 
 func testAccCheckESDomainSAMLOptionsDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticsearch_domain_saml_options" {
@@ -205,7 +204,7 @@ func testAccCheckESDomainSAMLOptions(esResource string, samlOptionsResource stri
 			return fmt.Errorf("Not found: %s", samlOptionsResource)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn()
 		_, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(options.Primary.Attributes["domain_name"]),
 		})
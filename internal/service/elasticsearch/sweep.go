@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticsearch_domain", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_elasticsearch_domain", &resource.Sweeper{
 		Name: "aws_elasticsearch_domain",
 		F:    sweepDomains,
 	})
@@ -29,7 +29,7 @@ func sweepDomains(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).ElasticsearchConn
+	conn := client.(*conns.AWSClient).ElasticsearchConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
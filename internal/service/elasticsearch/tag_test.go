@@ -0,0 +1,29 @@
+package elasticsearch_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  # Domain names are limited to 28 characters.
+  domain_name = substr(%[1]q, 0, 28)
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_elasticsearch_tag" "test" {
+  resource_arn = aws_elasticsearch_domain.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
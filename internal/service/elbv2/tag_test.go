@@ -0,0 +1,34 @@
+package elbv2_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_lb_tag" "test" {
+  resource_arn = aws_lb_target_group.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
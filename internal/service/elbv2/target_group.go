@@ -286,7 +286,7 @@ func suppressIfTargetType(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -381,7 +381,7 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(resp.TargetGroups[0].TargetGroupArn))
 
-	err = resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err = resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.ELBV2).Timeout, func() *resource.RetryError {
 		var err error
 
 		_, err = FindTargetGroupByARN(conn, d.Id())
@@ -514,11 +514,11 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	var targetGroup *elbv2.TargetGroup
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.ELBV2).Timeout, func() *resource.RetryError {
 		var err error
 
 		targetGroup, err = FindTargetGroupByARN(conn, d.Id())
@@ -568,7 +568,7 @@ func resourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -762,7 +762,7 @@ func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	input := &elbv2.DeleteTargetGroupInput{
 		TargetGroupArn: aws.String(d.Id()),
@@ -855,7 +855,7 @@ func TargetGroupSuffixFromARN(arn *string) string {
 
 // flattenTargetGroupResource takes a *elbv2.TargetGroup and populates all respective resource fields.
 func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, targetGroup *elbv2.TargetGroup) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
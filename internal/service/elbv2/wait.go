@@ -29,8 +29,6 @@ const (
 	loadBalancerListenerCreateTimeout = 5 * time.Minute
 	loadBalancerListenerReadTimeout   = 2 * time.Minute
 	loadBalancerListenerUpdateTimeout = 5 * time.Minute
-
-	propagationTimeout = 2 * time.Minute
 )
 
 // waitLoadBalancerActive waits for a Load Balancer to return active
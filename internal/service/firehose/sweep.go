@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
 		Name: "aws_kinesis_firehose_delivery_stream",
 		F:    sweepDeliveryStreams,
 	})
@@ -26,7 +26,7 @@ func sweepDeliveryStreams(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).FirehoseConn
+	conn := client.(*conns.AWSClient).FirehoseConn()
 	input := &firehose.ListDeliveryStreamsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
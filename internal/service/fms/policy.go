@@ -152,7 +152,7 @@ func ResourcePolicy() *schema.Resource {
 }
 
 func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	fmsPolicy := resourcePolicyExpandPolicy(d)
 
@@ -175,7 +175,7 @@ func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	var resp *fms.GetPolicyOutput
 	var req = &fms.GetPolicyInput{
@@ -264,7 +264,7 @@ func resourcePolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
 }
 
 func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	fmsPolicy := resourcePolicyExpandPolicy(d)
 
@@ -279,7 +279,7 @@ func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 	log.Printf("[DEBUG] Delete FMS Policy: %s", d.Id())
 
 	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
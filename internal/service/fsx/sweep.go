@@ -16,22 +16,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_backup", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_fsx_backup", &resource.Sweeper{
 		Name: "aws_fsx_backup",
 		F:    sweepFSXBackups,
 	})
 
-	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
 		Name: "aws_fsx_lustre_file_system",
 		F:    sweepFSXLustreFileSystems,
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
 		Name: "aws_fsx_ontap_file_system",
 		F:    sweepFSXOntapFileSystems,
 	})
 
-	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_fsx_windows_file_system",
 		F:    sweepFSXWindowsFileSystems,
 	})
@@ -44,7 +44,7 @@ func sweepFSXBackups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeBackupsInput{}
@@ -88,7 +88,7 @@ func sweepFSXLustreFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeFileSystemsInput{}
@@ -136,7 +136,7 @@ func sweepFSXOntapFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeFileSystemsInput{}
@@ -184,7 +184,7 @@ func sweepFSXWindowsFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeFileSystemsInput{}
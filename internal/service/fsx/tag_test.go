@@ -0,0 +1,27 @@
+package fsx_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(_ string, key string, value string) string {
+	return acctest.ConfigCompose(testAccLustreFileSystemBaseConfig(), fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity = 1200
+  subnet_ids       = [aws_subnet.test1.id]
+  deployment_type  = data.aws_partition.current.partition == "aws-us-gov" ? "SCRATCH_2" : null # GovCloud does not support SCRATCH_1
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_fsx_tag" "test" {
+  resource_arn = aws_fsx_lustre_file_system.test.arn
+  key          = %[1]q
+  value        = %[2]q
+}
+`, key, value))
+}
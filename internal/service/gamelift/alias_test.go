@@ -79,7 +79,7 @@ func TestAccGameLiftAlias_tags(t *testing.T) {
 	var conf gamelift.Alias
 
 	resourceName := "aws_gamelift_alias.test"
-	aliasName := sdkacctest.RandomWithPrefix("tf-acc-alias")
+	aliasName := acctest.RandomWithPrefix(t, "tf-acc-alias")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -221,7 +221,7 @@ func TestAccGameLiftAlias_disappears(t *testing.T) {
 
 func testAccCheckAliasDisappears(res *gamelift.Alias) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		input := &gamelift.DeleteAliasInput{AliasId: res.AliasId}
 
@@ -242,7 +242,7 @@ func testAccCheckAliasExists(n string, res *gamelift.Alias) resource.TestCheckFu
 			return fmt.Errorf("No Gamelift Alias ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
 			AliasId: aws.String(rs.Primary.ID),
@@ -263,7 +263,7 @@ func testAccCheckAliasExists(n string, res *gamelift.Alias) resource.TestCheckFu
 }
 
 func testAccCheckAliasDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_alias" {
@@ -0,0 +1,28 @@
+package gamelift_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_alias" "test" {
+  name = %[1]q
+
+  routing_strategy {
+    message = "Game over"
+    type    = "TERMINAL"
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_gamelift_tag" "test" {
+  resource_arn = aws_gamelift_alias.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -55,7 +55,7 @@ func ResourceVaultLock() *schema.Resource {
 }
 
 func resourceVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlacierConn
+	conn := meta.(*conns.AWSClient).GlacierConn()
 	vaultName := d.Get("vault_name").(string)
 
 	input := &glacier.InitiateVaultLockInput{
@@ -96,7 +96,7 @@ func resourceVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlacierConn
+	conn := meta.(*conns.AWSClient).GlacierConn()
 
 	input := &glacier.GetVaultLockInput{
 		AccountId: aws.String("-"),
@@ -130,7 +130,7 @@ func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlacierConn
+	conn := meta.(*conns.AWSClient).GlacierConn()
 
 	input := &glacier.AbortVaultLockInput{
 		VaultName: aws.String(d.Id()),
@@ -0,0 +1,24 @@
+package globalaccelerator_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "test" {
+  name    = %[1]q
+  enabled = false
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_globalaccelerator_tag" "test" {
+  resource_arn = aws_globalaccelerator_accelerator.test.id
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ import (
 
 func TestAccGlueCrawler_dynamoDBTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -89,7 +88,7 @@ func TestAccGlueCrawler_dynamoDBTarget(t *testing.T) {
 
 func TestAccGlueCrawler_DynamoDBTarget_scanAll(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -133,7 +132,7 @@ func TestAccGlueCrawler_DynamoDBTarget_scanAll(t *testing.T) {
 
 func TestAccGlueCrawler_DynamoDBTarget_scanRate(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -177,7 +176,7 @@ func TestAccGlueCrawler_DynamoDBTarget_scanRate(t *testing.T) {
 
 func TestAccGlueCrawler_jdbcTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -249,7 +248,7 @@ func TestAccGlueCrawler_jdbcTarget(t *testing.T) {
 
 func TestAccGlueCrawler_JDBCTarget_exclusions(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -292,7 +291,7 @@ func TestAccGlueCrawler_JDBCTarget_exclusions(t *testing.T) {
 
 func TestAccGlueCrawler_JDBCTarget_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -353,7 +352,7 @@ func TestAccGlueCrawler_JDBCTarget_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_mongoDBTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	connectionUrl := fmt.Sprintf("mongodb://%s:27017/testdatabase", acctest.RandomDomainName())
@@ -395,7 +394,7 @@ func TestAccGlueCrawler_mongoDBTarget(t *testing.T) {
 
 func TestAccGlueCrawler_MongoDBTargetScan_all(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	connectionUrl := fmt.Sprintf("mongodb://%s:27017/testdatabase", acctest.RandomDomainName())
@@ -447,7 +446,7 @@ func TestAccGlueCrawler_MongoDBTargetScan_all(t *testing.T) {
 
 func TestAccGlueCrawler_MongoDBTarget_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	connectionUrl := fmt.Sprintf("mongodb://%s:27017/testdatabase", acctest.RandomDomainName())
@@ -505,7 +504,7 @@ func TestAccGlueCrawler_MongoDBTarget_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_s3Target(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -573,7 +572,7 @@ func TestAccGlueCrawler_s3Target(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_connectionName(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 	connectionName := "aws_glue_connection.test"
 
@@ -603,7 +602,7 @@ func TestAccGlueCrawler_S3Target_connectionName(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_sampleSize(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -639,7 +638,7 @@ func TestAccGlueCrawler_S3Target_sampleSize(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_exclusions(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -680,7 +679,7 @@ func TestAccGlueCrawler_S3Target_exclusions(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_eventqueue(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -710,7 +709,7 @@ func TestAccGlueCrawler_S3Target_eventqueue(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_dlqeventqueue(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -741,7 +740,7 @@ func TestAccGlueCrawler_S3Target_dlqeventqueue(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -795,7 +794,7 @@ func TestAccGlueCrawler_S3Target_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_catalogTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -868,7 +867,7 @@ func TestAccGlueCrawler_catalogTarget(t *testing.T) {
 
 func TestAccGlueCrawler_CatalogTarget_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -924,7 +923,7 @@ func TestAccGlueCrawler_CatalogTarget_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_disappears(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -947,7 +946,7 @@ func TestAccGlueCrawler_disappears(t *testing.T) {
 
 func TestAccGlueCrawler_classifiers(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -994,7 +993,7 @@ func TestAccGlueCrawler_Configuration(t *testing.T) {
 	var crawler glue.Crawler
 	configuration1 := `{"Version": 1.0, "CrawlerOutput": {"Tables": { "AddOrUpdateBehavior": "MergeNewColumns" }}}`
 	configuration2 := `{"Version": 1.0, "CrawlerOutput": {"Partitions": { "AddOrUpdateBehavior": "InheritFromTable" }}}`
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1035,7 +1034,7 @@ func TestAccGlueCrawler_Configuration(t *testing.T) {
 
 func TestAccGlueCrawler_description(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1070,7 +1069,7 @@ func TestAccGlueCrawler_description(t *testing.T) {
 func TestAccGlueCrawler_RoleARN_noPath(t *testing.T) {
 	var crawler glue.Crawler
 	iamRoleResourceName := "aws_iam_role.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1097,7 +1096,7 @@ func TestAccGlueCrawler_RoleARN_noPath(t *testing.T) {
 
 func TestAccGlueCrawler_RoleARN_path(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1124,7 +1123,7 @@ func TestAccGlueCrawler_RoleARN_path(t *testing.T) {
 
 func TestAccGlueCrawler_RoleName_path(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1151,7 +1150,7 @@ func TestAccGlueCrawler_RoleName_path(t *testing.T) {
 
 func TestAccGlueCrawler_schedule(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1192,7 +1191,7 @@ func TestAccGlueCrawler_schedule(t *testing.T) {
 
 func TestAccGlueCrawler_schemaChangePolicy(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1230,7 +1229,7 @@ func TestAccGlueCrawler_schemaChangePolicy(t *testing.T) {
 
 func TestAccGlueCrawler_tablePrefix(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1264,7 +1263,7 @@ func TestAccGlueCrawler_tablePrefix(t *testing.T) {
 
 func TestAccGlueCrawler_removeTablePrefix(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1298,7 +1297,7 @@ func TestAccGlueCrawler_removeTablePrefix(t *testing.T) {
 
 func TestAccGlueCrawler_tags(t *testing.T) {
 	var crawler1, crawler2, crawler3 glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1343,7 +1342,7 @@ func TestAccGlueCrawler_tags(t *testing.T) {
 
 func TestAccGlueCrawler_security(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1377,7 +1376,7 @@ func TestAccGlueCrawler_security(t *testing.T) {
 
 func TestAccGlueCrawler_lineage(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1420,7 +1419,7 @@ func TestAccGlueCrawler_lineage(t *testing.T) {
 
 func TestAccGlueCrawler_reCrawlPolicy(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1472,7 +1471,7 @@ func testAccCheckCrawlerExists(resourceName string, crawler *glue.Crawler) resou
 			return fmt.Errorf("no ID is set")
 		}
 
-		glueConn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		glueConn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 		out, err := glueConn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -1497,7 +1496,7 @@ func testAccCheckCrawlerDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
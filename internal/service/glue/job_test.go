@@ -653,7 +653,7 @@ func testAccCheckJobExists(resourceName string, job *glue.Job) resource.TestChec
 			return fmt.Errorf("No Glue Job ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 
 		output, err := conn.GetJob(&glue.GetJobInput{
 			JobName: aws.String(rs.Primary.ID),
@@ -681,7 +681,7 @@ func testAccCheckJobDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 
 		output, err := conn.GetJob(&glue.GetJobInput{
 			JobName: aws.String(rs.Primary.ID),
@@ -41,7 +41,7 @@ func ResourceResourcePolicy() *schema.Resource {
 
 func resourceResourcePolicyPut(condition string) func(d *schema.ResourceData, meta interface{}) error {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		conn := meta.(*conns.AWSClient).GlueConn
+		conn := meta.(*conns.AWSClient).GlueConn()
 
 		input := &glue.PutResourcePolicyInput{
 			PolicyInJson:          aws.String(d.Get("policy").(string)),
@@ -62,7 +62,7 @@ func resourceResourcePolicyPut(condition string) func(d *schema.ResourceData, me
 }
 
 func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	resourcePolicy, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
 	if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
@@ -84,7 +84,7 @@ func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	_, err := conn.DeleteResourcePolicy(&glue.DeleteResourcePolicyInput{})
 	if err != nil {
@@ -84,7 +84,7 @@ func TestAccIAMPolicyAttachment_paginatedEntities(t *testing.T) {
 func TestAccIAMPolicyAttachment_Groups_renamedGroup(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	groupName1 := fmt.Sprintf("%s-1", rName)
 	groupName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
@@ -116,7 +116,7 @@ func TestAccIAMPolicyAttachment_Groups_renamedGroup(t *testing.T) {
 func TestAccIAMPolicyAttachment_Roles_renamedRole(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	roleName1 := fmt.Sprintf("%s-1", rName)
 	roleName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
@@ -148,7 +148,7 @@ func TestAccIAMPolicyAttachment_Roles_renamedRole(t *testing.T) {
 func TestAccIAMPolicyAttachment_Users_renamedUser(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	userName1 := fmt.Sprintf("%s-1", rName)
 	userName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
@@ -192,7 +192,7 @@ func testAccCheckPolicyAttachmentExists(n string, c int64, out *iam.ListEntities
 			return fmt.Errorf("No policy name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 		arn := rs.Primary.Attributes["policy_arn"]
 
 		resp, err := conn.GetPolicy(&iam.GetPolicyInput{
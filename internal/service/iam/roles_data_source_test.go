@@ -32,7 +32,7 @@ func TestAccIAMRolesDataSource_basic(t *testing.T) {
 
 func TestAccIAMRolesDataSource_nameRegex(t *testing.T) {
 	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_iam_roles.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,8 +53,8 @@ func TestAccIAMRolesDataSource_nameRegex(t *testing.T) {
 
 func TestAccIAMRolesDataSource_pathPrefix(t *testing.T) {
 	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rPathPrefix := acctest.RandomWithPrefix(t, "tf-acc-path")
 	dataSourceName := "data.aws_iam_roles.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -94,8 +94,8 @@ func TestAccIAMRolesDataSource_nonExistentPathPrefix(t *testing.T) {
 
 func TestAccIAMRolesDataSource_nameRegexAndPathPrefix(t *testing.T) {
 	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rPathPrefix := acctest.RandomWithPrefix(t, "tf-acc-path")
 	dataSourceName := "data.aws_iam_roles.test"
 
 	resource.ParallelTest(t, resource.TestCase{
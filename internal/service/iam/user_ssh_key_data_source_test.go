@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -14,7 +13,7 @@ func TestAccIAMUserSSHKeyDataSource_basic(t *testing.T) {
 	resourceName := "aws_iam_user_ssh_key.test"
 	dataSourceName := "data.aws_iam_user_ssh_key.test"
 
-	username := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	username := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	publicKey, _, err := RandSSHKeyPairSize(2048, acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
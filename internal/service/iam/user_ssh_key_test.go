@@ -18,7 +18,7 @@ func TestAccIAMUserSSHKey_basic(t *testing.T) {
 	var conf iam.GetSSHPublicKeyOutput
 	resourceName := "aws_iam_user_ssh_key.user"
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	publicKey, _, err := RandSSHKeyPairSize(2048, acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
@@ -76,7 +76,7 @@ func TestAccIAMUserSSHKey_pemEncoding(t *testing.T) {
 }
 
 func testAccCheckUserSSHKeyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user_ssh_key" {
@@ -118,7 +118,7 @@ func testAccCheckUserSSHKeyExists(n, status string, res *iam.GetSSHPublicKeyOutp
 			return fmt.Errorf("No SSHPublicKeyID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 		username := rs.Primary.Attributes["username"]
 		encoding := rs.Primary.Attributes["encoding"]
@@ -0,0 +1,39 @@
+package imagebuilder_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_component" "test" {
+  data = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = ["echo 'hello world'"]
+        }
+        name      = "example"
+        onFailure = "Continue"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  name     = %[1]q
+  platform = "Linux"
+  version  = "1.0.0"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_imagebuilder_tag" "test" {
+  resource_arn = aws_imagebuilder_component.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -0,0 +1,26 @@
+package iot_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = replace(%[1]q, "-", "_")
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_iot_tag" "test" {
+  resource_arn = aws_iot_topic_rule.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
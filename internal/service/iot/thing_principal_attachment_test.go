@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccIoTThingPrincipalAttachment_basic(t *testing.T) {
-	thingName := sdkacctest.RandomWithPrefix("tf-acc")
-	thingName2 := sdkacctest.RandomWithPrefix("tf-acc2")
+	thingName := acctest.RandomWithPrefix(t, "tf-acc")
+	thingName2 := acctest.RandomWithPrefix(t, "tf-acc2")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -70,7 +69,7 @@ func TestAccIoTThingPrincipalAttachment_basic(t *testing.T) {
 }
 
 func testAccCheckThingPrincipalAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_thing_principal_attachment" {
@@ -107,7 +106,7 @@ func testAccCheckThingPrincipalAttachmentExists(n string) resource.TestCheckFunc
 			return fmt.Errorf("No attachment")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 		thing := rs.Primary.Attributes["thing"]
 		principal := rs.Primary.Attributes["principal"]
 
@@ -127,7 +126,7 @@ func testAccCheckThingPrincipalAttachmentExists(n string) resource.TestCheckFunc
 
 func testAccCheckThingPrincipalAttachmentStatus(thingName string, exists bool, principals []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 		principalARNs := make(map[string]string)
 
@@ -122,7 +122,7 @@ func testAccCheckIotThingExists(n string, thing *iot.DescribeThingOutput) resour
 			return fmt.Errorf("No IoT Thing ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 		params := &iot.DescribeThingInput{
 			ThingName: aws.String(rs.Primary.ID),
 		}
@@ -138,7 +138,7 @@ func testAccCheckIotThingExists(n string, thing *iot.DescribeThingOutput) resour
 }
 
 func testAccCheckThingDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_thing" {
@@ -0,0 +1,25 @@
+package iotanalytics_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// testAccPreCheckTag skips the tag acceptance tests unless the ARN of an
+// existing resource to tag, such as an AWS IoT Analytics channel, is set in AWS_IOTANALYTICS_TAG_RESOURCE_ARN.
+func testAccPreCheckTag(t *testing.T) {
+	if os.Getenv("AWS_IOTANALYTICS_TAG_RESOURCE_ARN") == "" {
+		t.Skip("Environment variable AWS_IOTANALYTICS_TAG_RESOURCE_ARN is not set")
+	}
+}
+
+func testAccTagConfig(_ string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_tag" "test" {
+  resource_arn = %[1]q
+  key          = %[2]q
+  value        = %[3]q
+}
+`, os.Getenv("AWS_IOTANALYTICS_TAG_RESOURCE_ARN"), key, value)
+}
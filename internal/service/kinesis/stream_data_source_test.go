@@ -21,7 +21,7 @@ func TestAccKinesisStreamDataSource_basic(t *testing.T) {
 	config := fmt.Sprintf(testAccCheckStreamDataSourceConfig, sn)
 
 	updateShardCount := func() {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 		_, err := conn.UpdateShardCount(&kinesis.UpdateShardCountInput{
 			ScalingType:      aws.String(kinesis.ScalingTypeUniformScaling),
 			StreamName:       aws.String(sn),
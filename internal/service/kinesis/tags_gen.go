@@ -2,6 +2,7 @@
 package kinesis
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,11 +14,16 @@ import (
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func ListTags(conn *kinesis.Kinesis, identifier string) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier)
+}
+
+// ListTagsWithContext is the same as ListTags with the addition of the ability to pass a context.
+func ListTagsWithContext(ctx context.Context, conn *kinesis.Kinesis, identifier string) (tftags.KeyValueTags, error) {
 	input := &kinesis.ListTagsForStreamInput{
 		StreamName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForStream(input)
+	output, err := conn.ListTagsForStreamWithContext(ctx, input)
 
 	if err != nil {
 		return tftags.New(nil), err
@@ -59,6 +65,11 @@ func KeyValueTags(tags []*kinesis.Tag) tftags.KeyValueTags {
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func UpdateTags(conn *kinesis.Kinesis, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, oldTagsMap, newTagsMap)
+}
+
+// UpdateTagsWithContext is the same as UpdateTags with the addition of the ability to pass a context.
+func UpdateTagsWithContext(ctx context.Context, conn *kinesis.Kinesis, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -69,7 +80,7 @@ func UpdateTags(conn *kinesis.Kinesis, identifier string, oldTagsMap interface{}
 				TagKeys:    aws.StringSlice(removedTags.IgnoreAWS().Keys()),
 			}
 
-			_, err := conn.RemoveTagsFromStream(input)
+			_, err := conn.RemoveTagsFromStreamWithContext(ctx, input)
 
 			if err != nil {
 				return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
@@ -84,7 +95,7 @@ func UpdateTags(conn *kinesis.Kinesis, identifier string, oldTagsMap interface{}
 				Tags:       aws.StringMap(updatedTags.IgnoreAWS().Map()),
 			}
 
-			_, err := conn.AddTagsToStream(input)
+			_, err := conn.AddTagsToStreamWithContext(ctx, input)
 
 			if err != nil {
 				return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
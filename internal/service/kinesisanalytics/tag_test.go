@@ -0,0 +1,23 @@
+package kinesisanalytics_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_analytics_application" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_kinesis_analytics_tag" "test" {
+  resource_arn = aws_kinesis_analytics_application.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -0,0 +1,27 @@
+package kinesisanalyticsv2_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccApplicationConfigBaseServiceExecutionIamRole(rName), fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "SQL-1_0"
+  service_execution_role = aws_iam_role.test[0].arn
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_kinesisanalyticsv2_tag" "test" {
+  resource_arn = aws_kinesisanalyticsv2_application.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
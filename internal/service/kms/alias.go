@@ -1,6 +1,7 @@
 package kms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -63,7 +64,7 @@ func ResourceAlias() *schema.Resource {
 }
 
 func resourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	namePrefix := d.Get("name_prefix").(string)
 	if namePrefix == "" {
@@ -93,11 +94,17 @@ func resourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
-		return FindAliasByName(conn, d.Id())
-	}, d.IsNewResource())
+	var alias *kms.AliasListEntry
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.KMS).Read(context.Background(), d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		alias, err = FindAliasByName(conn, d.Id())
+
+		return err
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] KMS Alias (%s) not found, removing from state", d.Id())
@@ -109,7 +116,6 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading KMS Alias (%s): %w", d.Id(), err)
 	}
 
-	alias := outputRaw.(*kms.AliasListEntry)
 	aliasARN := aws.StringValue(alias.AliasArn)
 	targetKeyID := aws.StringValue(alias.TargetKeyId)
 	targetKeyARN, err := AliasARNToKeyARN(aliasARN, targetKeyID)
@@ -128,7 +134,7 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	if d.HasChange("target_key_id") {
 		input := &kms.UpdateAliasInput{
@@ -148,7 +154,7 @@ func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	log.Printf("[DEBUG] Deleting KMS Alias: (%s)", d.Id())
 	_, err := conn.DeleteAlias(&kms.DeleteAliasInput{
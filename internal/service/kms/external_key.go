@@ -106,7 +106,7 @@ func ResourceExternalKey() *schema.Resource {
 }
 
 func resourceExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -190,11 +190,11 @@ func resourceExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	key, err := findKmsKey(conn, d.Id(), d.IsNewResource())
+	key, err := findKmsKey(conn, d.Id(), d.IsNewResource(), meta.(*conns.AWSClient).ConsistencyPolicy(conns.KMS).Timeout)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] KMS External Key (%s) not found, removing from state", d.Id())
@@ -248,7 +248,7 @@ func resourceExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	if hasChange, enabled, state := d.HasChange("enabled"), d.Get("enabled").(bool), d.Get("key_state").(string); hasChange && enabled && state != kms.KeyStatePendingImport {
 		// Enable before any attributes are modified.
@@ -308,7 +308,7 @@ func resourceExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceExternalKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	input := &kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(d.Id()),
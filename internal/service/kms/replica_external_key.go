@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -29,7 +30,10 @@ func ResourceReplicaExternalKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffValidatePrimaryKeyRegion,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -104,7 +108,7 @@ func ResourceReplicaExternalKey() *schema.Resource {
 }
 
 func resourceReplicaExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -115,6 +119,10 @@ func resourceReplicaExternalKeyCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error parsing primary key ARN: %w", err)
 	}
 
+	if err := meta.(*conns.AWSClient).ValidateRegion(primaryKeyARN.Region); err != nil {
+		return fmt.Errorf("error validating primary key region: %w", err)
+	}
+
 	input := &kms.ReplicateKeyInput{
 		KeyId:         aws.String(strings.TrimPrefix(primaryKeyARN.Resource, "key/")),
 		ReplicaRegion: aws.String(meta.(*conns.AWSClient).Region),
@@ -201,11 +209,11 @@ func resourceReplicaExternalKeyCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceReplicaExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	key, err := findKmsKey(conn, d.Id(), d.IsNewResource())
+	key, err := findKmsKey(conn, d.Id(), d.IsNewResource(), meta.(*conns.AWSClient).ConsistencyPolicy(conns.KMS).Timeout)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] KMS External Replica Key (%s) not found, removing from state", d.Id())
@@ -260,7 +268,7 @@ func resourceReplicaExternalKeyRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceReplicaExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	if hasChange, enabled, state := d.HasChange("enabled"), d.Get("enabled").(bool), d.Get("key_state").(string); hasChange && enabled && state != kms.KeyStatePendingImport {
 		// Enable before any attributes are modified.
@@ -320,7 +328,7 @@ func resourceReplicaExternalKeyUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceReplicaExternalKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	input := &kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(d.Id()),
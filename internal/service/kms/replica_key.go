@@ -1,6 +1,7 @@
 package kms
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -28,7 +30,10 @@ func ResourceReplicaKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffValidatePrimaryKeyRegion,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -91,8 +96,28 @@ func ResourceReplicaKey() *schema.Resource {
 	}
 }
 
+// customizeDiffValidatePrimaryKeyRegion returns an error if the provider configuration does not allow
+// the region of the primary key, if its ARN is known at plan time.
+func customizeDiffValidatePrimaryKeyRegion(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("primary_key_arn") || !diff.NewValueKnown("primary_key_arn") {
+		return nil
+	}
+
+	primaryKeyARN, err := arn.Parse(diff.Get("primary_key_arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing primary key ARN: %w", err)
+	}
+
+	if err := meta.(*conns.AWSClient).ValidateRegion(primaryKeyARN.Region); err != nil {
+		return fmt.Errorf("error validating primary key region: %w", err)
+	}
+
+	return nil
+}
+
 func resourceReplicaKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -103,6 +128,10 @@ func resourceReplicaKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error parsing primary key ARN: %w", err)
 	}
 
+	if err := meta.(*conns.AWSClient).ValidateRegion(primaryKeyARN.Region); err != nil {
+		return fmt.Errorf("error validating primary key region: %w", err)
+	}
+
 	input := &kms.ReplicateKeyInput{
 		KeyId:         aws.String(strings.TrimPrefix(primaryKeyARN.Resource, "key/")),
 		ReplicaRegion: aws.String(meta.(*conns.AWSClient).Region),
@@ -173,11 +202,11 @@ func resourceReplicaKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceReplicaKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	key, err := findKmsKey(conn, d.Id(), d.IsNewResource())
+	key, err := findKmsKey(conn, d.Id(), d.IsNewResource(), meta.(*conns.AWSClient).ConsistencyPolicy(conns.KMS).Timeout)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] KMS Replica Key (%s) not found, removing from state", d.Id())
@@ -227,7 +256,7 @@ func resourceReplicaKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceReplicaKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	if hasChange, enabled := d.HasChange("enabled"), d.Get("enabled").(bool); hasChange && enabled {
 		// Enable before any attributes are modified.
@@ -271,7 +300,7 @@ func resourceReplicaKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceReplicaKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	input := &kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(d.Id()),
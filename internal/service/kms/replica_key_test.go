@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/kms"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 func TestAccKMSReplicaKey_basic(t *testing.T) {
 	var providers []*schema.Provider
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	primaryKeyResourceName := "aws_kms_key.test"
 	resourceName := "aws_kms_replica_key.test"
 
@@ -58,7 +57,7 @@ func TestAccKMSReplicaKey_basic(t *testing.T) {
 func TestAccKMSReplicaKey_disappears(t *testing.T) {
 	var providers []*schema.Provider
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_replica_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -85,10 +84,10 @@ func TestAccKMSReplicaKey_disappears(t *testing.T) {
 func TestAccKMSReplicaKey_DescriptionAndEnabled(t *testing.T) {
 	var providers []*schema.Provider
 	var key kms.KeyMetadata
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName4 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName3 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName4 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_replica_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -137,7 +136,7 @@ func TestAccKMSReplicaKey_DescriptionAndEnabled(t *testing.T) {
 func TestAccKMSReplicaKey_Policy(t *testing.T) {
 	var providers []*schema.Provider
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_replica_key.test"
 	policy1 := `{"Version":"2012-10-17","Id":"kms-tf-1","Statement":[{"Sid":"Enable IAM User Permissions 1","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"}]}`
 	policy2 := `{"Version":"2012-10-17","Id":"kms-tf-1","Statement":[{"Sid":"Enable IAM User Permissions 2","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"}]}`
@@ -180,7 +179,7 @@ func TestAccKMSReplicaKey_Policy(t *testing.T) {
 func TestAccKMSReplicaKey_Tags(t *testing.T) {
 	var providers []*schema.Provider
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_replica_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -230,7 +229,7 @@ func TestAccKMSReplicaKey_Tags(t *testing.T) {
 func TestAccKMSReplicaKey_TwoReplicas(t *testing.T) {
 	var providers []*schema.Provider
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_replica_key.test1"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -252,6 +251,27 @@ func TestAccKMSReplicaKey_TwoReplicas(t *testing.T) {
 	})
 }
 
+func TestAccKMSReplicaKey_forbiddenPrimaryKeyRegion(t *testing.T) {
+	var providers []*schema.Provider
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicaKeyForbiddenPrimaryKeyRegionConfig(rName),
+				ExpectError: regexp.MustCompile(`Forbidden AWS Region: ` + acctest.AlternateRegion()),
+			},
+		},
+	})
+}
+
 func testAccReplicaKeyConfig(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -267,6 +287,25 @@ resource "aws_kms_replica_key" "test" {
 `, rName))
 }
 
+func testAccReplicaKeyForbiddenPrimaryKeyRegionConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+provider "aws" {
+  forbidden_regions = [%[2]q]
+}
+
+resource "aws_kms_key" "test" {
+  provider = awsalternate
+
+  description  = %[1]q
+  multi_region = true
+}
+
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn = aws_kms_key.test.arn
+}
+`, rName, acctest.AlternateRegion()))
+}
+
 func testAccReplicaKeyDescriptionAndEnabledConfig(rName, description string, enabled bool) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -0,0 +1,24 @@
+package kms_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_kms_tag" "test" {
+  resource_arn = aws_kms_key.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -136,7 +136,7 @@ func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 func TestAccLambdaFunction_disappears(t *testing.T) {
 	var function lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -408,7 +408,7 @@ func TestAccLambdaFunction_envVariables(t *testing.T) {
 
 func TestAccLambdaFunction_EnvironmentVariables_noValue(t *testing.T) {
 	var conf lambda.GetFunctionOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1178,7 +1178,7 @@ func TestAccLambdaFunction_tracing(t *testing.T) {
 func TestAccLambdaFunction_KMSKeyARN_noEnvironmentVariables(t *testing.T) {
 	var function1 lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1332,7 +1332,7 @@ func TestAccLambdaFunction_vpc(t *testing.T) {
 func TestAccLambdaFunction_vpcRemoval(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1539,7 +1539,7 @@ func TestAccLambdaFunction_VPCPublishHas_changes(t *testing.T) {
 func TestAccLambdaFunction_VPC_properIAMDependencies(t *testing.T) {
 	var function lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 	vpcResourceName := "aws_vpc.test"
 
@@ -1945,7 +1945,7 @@ func TestAccLambdaFunction_tags(t *testing.T) {
 func TestAccLambdaFunction_runtimes(t *testing.T) {
 	var v lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	steps := []resource.TestStep{
 		{
@@ -2023,7 +2023,7 @@ func TestAccLambdaFunction_Zip_validation(t *testing.T) {
 }
 
 func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
@@ -2045,7 +2045,7 @@ func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
 
 func testAccCheckFunctionDisappears(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		input := &lambda.DeleteFunctionInput{
 			FunctionName: function.Configuration.FunctionName,
@@ -2069,7 +2069,7 @@ func testAccCheckFunctionExists(res, funcName string, function *lambda.GetFuncti
 			return fmt.Errorf("Lambda function ID not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		params := &lambda.GetFunctionInput{
 			FunctionName: aws.String(funcName),
@@ -2096,7 +2096,7 @@ func testAccCheckFunctionInvokeARN(name string, function *lambda.GetFunctionOutp
 func testAccInvokeFunction(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		f := function.Configuration
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		// If the function is VPC-enabled this will create ENI automatically
 		_, err := conn.Invoke(&lambda.InvokeInput{
@@ -3420,7 +3420,7 @@ func TestFlattenLambdaImageConfigShouldNotFailWithEmptyImageConfig(t *testing.T)
 }
 
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn()
 
 	input := &signer.ListSigningPlatformsInput{}
 
@@ -208,7 +208,7 @@ func TestAccLambdaPermission_basic(t *testing.T) {
 }
 
 func TestAccLambdaPermission_StatementID_duplicate(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -263,7 +263,7 @@ func TestAccLambdaPermission_withRawFunctionName(t *testing.T) {
 func TestAccLambdaPermission_withStatementIdPrefix(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	startsWithPrefix := regexp.MustCompile("^AllowExecutionWithStatementIdPrefix-")
 
 	resourceName := "aws_lambda_permission.with_statement_id_prefix"
@@ -561,7 +561,7 @@ func testAccPermissionDisappears(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No resource ID is set")
@@ -596,7 +596,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *tflambda.PolicyStat
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		// IAM is eventually consistent
 		var foundStatement *tflambda.PolicyStatement
@@ -628,7 +628,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *tflambda.PolicyStat
 }
 
 func testAccCheckPermissionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_permission" {
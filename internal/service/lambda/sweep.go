@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 	})
@@ -31,7 +31,7 @@ func sweepFunctions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
+	conn := client.(*conns.AWSClient).LambdaConn()
 
 	resp, err := conn.ListFunctions(&lambda.ListFunctionsInput{})
 	if err != nil {
@@ -66,7 +66,7 @@ func sweepLayerVersions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
+	conn := client.(*conns.AWSClient).LambdaConn()
 	resp, err := conn.ListLayers(&lambda.ListLayersInput{})
 	if err != nil {
 		if sweep.SkipSweepError(err) {
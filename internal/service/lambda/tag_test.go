@@ -0,0 +1,29 @@
+package lambda_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_lambda_tag" "test" {
+  resource_arn = aws_lambda_function.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
@@ -361,7 +361,7 @@ func testAccCheckBotAliasExists(rName string, output *lexmodelbuildingservice.Ge
 		botAliasName := rs.Primary.Attributes["name"]
 
 		var err error
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		output, err = conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
 			BotName: aws.String(botName),
@@ -380,7 +380,7 @@ func testAccCheckBotAliasExists(rName string, output *lexmodelbuildingservice.Ge
 
 func testAccCheckBotAliasDestroy(botName, botAliasName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		_, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
 			BotName: aws.String(botName),
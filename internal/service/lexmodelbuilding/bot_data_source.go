@@ -86,7 +86,7 @@ func DataSourceBot() *schema.Resource {
 }
 
 func dataSourceBotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelBuildingConn
+	conn := meta.(*conns.AWSClient).LexModelBuildingConn()
 
 	name := d.Get("name").(string)
 	version := d.Get("version").(string)
@@ -737,7 +737,7 @@ func testAccCheckBotExistsWithVersion(rName, botVersion string, v *lexmodelbuild
 			return fmt.Errorf("No Lex Bot ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		output, err := tflexmodelbuilding.FindBotVersionByName(conn, rs.Primary.ID, botVersion)
 
@@ -757,7 +757,7 @@ func testAccCheckBotExists(rName string, output *lexmodelbuildingservice.GetBotO
 
 func testAccCheckBotNotExists(botName, botVersion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		_, err := tflexmodelbuilding.FindBotVersionByName(conn, botName, botVersion)
 
@@ -774,7 +774,7 @@ func testAccCheckBotNotExists(botName, botVersion string) resource.TestCheckFunc
 }
 
 func testAccCheckBotDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lex_bot" {
@@ -81,7 +81,7 @@ func ResourceLicenseConfiguration() *schema.Resource {
 }
 
 func resourceLicenseConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -121,7 +121,7 @@ func resourceLicenseConfigurationCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLicenseConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -164,7 +164,7 @@ func resourceLicenseConfigurationRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLicenseConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -195,7 +195,7 @@ func resourceLicenseConfigurationUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLicenseConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	opts := &licensemanager.DeleteLicenseConfigurationInput{
 		LicenseConfigurationArn: aws.String(d.Id()),
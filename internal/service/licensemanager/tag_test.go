@@ -0,0 +1,24 @@
+package licensemanager_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_configuration" "test" {
+  name                  = %[1]q
+  license_counting_type = "vCPU"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_licensemanager_tag" "test" {
+  resource_arn = aws_licensemanager_license_configuration.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
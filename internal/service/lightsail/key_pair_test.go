@@ -17,7 +17,7 @@ import (
 
 func TestAccLightsailKeyPair_basic(t *testing.T) {
 	var conf lightsail.KeyPair
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resourceName := "aws_lightsail_key_pair.test"
 
@@ -43,7 +43,7 @@ func TestAccLightsailKeyPair_basic(t *testing.T) {
 
 func TestAccLightsailKeyPair_publicKey(t *testing.T) {
 	var conf lightsail.KeyPair
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resourceName := "aws_lightsail_key_pair.test"
 
@@ -76,7 +76,7 @@ func TestAccLightsailKeyPair_publicKey(t *testing.T) {
 
 func TestAccLightsailKeyPair_encrypted(t *testing.T) {
 	var conf lightsail.KeyPair
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resourceName := "aws_lightsail_key_pair.test"
 
@@ -135,7 +135,7 @@ func testAccCheckKeyPairExists(n string, res *lightsail.KeyPair) resource.TestCh
 			return errors.New("No LightsailKeyPair set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
 
 		respKeyPair, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
 			KeyPairName: aws.String(rs.Primary.Attributes["name"]),
@@ -159,7 +159,7 @@ func testAccCheckKeyPairDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
 
 		respKeyPair, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
 			KeyPairName: aws.String(rs.Primary.Attributes["name"]),
@@ -0,0 +1,25 @@
+package mediaconnect_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// testAccPreCheckTag skips the tag acceptance tests unless the ARN of an
+// existing resource to tag, such as an AWS Elemental MediaConnect flow, is set in AWS_MEDIACONNECT_TAG_RESOURCE_ARN.
+func testAccPreCheckTag(t *testing.T) {
+	if os.Getenv("AWS_MEDIACONNECT_TAG_RESOURCE_ARN") == "" {
+		t.Skip("Environment variable AWS_MEDIACONNECT_TAG_RESOURCE_ARN is not set")
+	}
+}
+
+func testAccTagConfig(_ string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_tag" "test" {
+  resource_arn = %[1]q
+  key          = %[2]q
+  value        = %[3]q
+}
+`, os.Getenv("AWS_MEDIACONNECT_TAG_RESOURCE_ARN"), key, value)
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -97,7 +96,7 @@ func ResourceQueue() *schema.Resource {
 }
 
 func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	conn, err := meta.(*conns.AWSClient).MediaConvertAccountConn()
 	if err != nil {
 		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 	}
@@ -131,7 +130,7 @@ func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	conn, err := meta.(*conns.AWSClient).MediaConvertAccountConn()
 	if err != nil {
 		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 	}
@@ -184,7 +183,7 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	conn, err := meta.(*conns.AWSClient).MediaConvertAccountConn()
 	if err != nil {
 		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 	}
@@ -227,7 +226,7 @@ func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	conn, err := meta.(*conns.AWSClient).MediaConvertAccountConn()
 	if err != nil {
 		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 	}
@@ -246,41 +245,3 @@ func resourceQueueDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-
-func GetAccountClient(awsClient *conns.AWSClient) (*mediaconvert.MediaConvert, error) {
-	const mutexKey = `mediaconvertaccountconn`
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
-
-	if awsClient.MediaConvertAccountConn != nil {
-		return awsClient.MediaConvertAccountConn, nil
-	}
-
-	input := &mediaconvert.DescribeEndpointsInput{
-		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
-	}
-
-	output, err := awsClient.MediaConvertConn.DescribeEndpoints(input)
-
-	if err != nil {
-		return nil, fmt.Errorf("error describing MediaConvert Endpoints: %w", err)
-	}
-
-	if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil || output.Endpoints[0].Url == nil {
-		return nil, fmt.Errorf("error describing MediaConvert Endpoints: empty response or URL")
-	}
-
-	endpointURL := aws.StringValue(output.Endpoints[0].Url)
-
-	sess, err := session.NewSession(&awsClient.MediaConvertConn.Config)
-
-	if err != nil {
-		return nil, fmt.Errorf("error creating AWS MediaConvert session: %w", err)
-	}
-
-	conn := mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(endpointURL)}))
-
-	awsClient.MediaConvertAccountConn = conn
-
-	return conn, nil
-}
@@ -8,18 +8,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 )
 
 func TestAccMediaConvertQueue_basic(t *testing.T) {
 	var queue mediaconvert.Queue
 	resourceName := "aws_media_convert_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -51,7 +49,7 @@ func TestAccMediaConvertQueue_reservationPlanSettings(t *testing.T) {
 
 	var queue mediaconvert.Queue
 	resourceName := "aws_media_convert_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -93,7 +91,7 @@ func TestAccMediaConvertQueue_reservationPlanSettings(t *testing.T) {
 func TestAccMediaConvertQueue_withStatus(t *testing.T) {
 	var queue mediaconvert.Queue
 	resourceName := "aws_media_convert_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -127,7 +125,7 @@ func TestAccMediaConvertQueue_withStatus(t *testing.T) {
 func TestAccMediaConvertQueue_withTags(t *testing.T) {
 	var queue mediaconvert.Queue
 	resourceName := "aws_media_convert_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -172,7 +170,7 @@ func TestAccMediaConvertQueue_withTags(t *testing.T) {
 func TestAccMediaConvertQueue_disappears(t *testing.T) {
 	var queue mediaconvert.Queue
 	resourceName := "aws_media_convert_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -195,9 +193,9 @@ func TestAccMediaConvertQueue_disappears(t *testing.T) {
 func TestAccMediaConvertQueue_withDescription(t *testing.T) {
 	var queue mediaconvert.Queue
 	resourceName := "aws_media_convert_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	description1 := sdkacctest.RandomWithPrefix("Description: ")
-	description2 := sdkacctest.RandomWithPrefix("Description: ")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	description1 := acctest.RandomWithPrefix(t, "Description: ")
+	description2 := acctest.RandomWithPrefix(t, "Description: ")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -228,7 +226,7 @@ func testAccCheckQueueDestroy(s *terraform.State) error {
 		if rs.Type != "aws_media_convert_queue" {
 			continue
 		}
-		conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+		conn, err := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertAccountConn()
 		if err != nil {
 			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 		}
@@ -249,7 +247,7 @@ func testAccCheckQueueDestroy(s *terraform.State) error {
 
 func testAccCheckQueueDisappears(queue *mediaconvert.Queue) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+		conn, err := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertAccountConn()
 		if err != nil {
 			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 		}
@@ -275,7 +273,7 @@ func testAccCheckQueueExists(n string, queue *mediaconvert.Queue) resource.TestC
 			return fmt.Errorf("No Queue id is set")
 		}
 
-		conn, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+		conn, err := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertAccountConn()
 		if err != nil {
 			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 		}
@@ -293,7 +291,7 @@ func testAccCheckQueueExists(n string, queue *mediaconvert.Queue) resource.TestC
 }
 
 func testAccPreCheck(t *testing.T) {
-	_, err := tfmediaconvert.GetAccountClient(acctest.Provider.Meta().(*conns.AWSClient))
+	_, err := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertAccountConn()
 
 	if acctest.PreCheckSkipError(err) {
 		t.Skipf("skipping acceptance testing: %s", err)
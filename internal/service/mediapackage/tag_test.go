@@ -0,0 +1,23 @@
+package mediapackage_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_media_package_channel" "test" {
+  channel_id = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_media_package_tag" "test" {
+  resource_arn = aws_media_package_channel.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
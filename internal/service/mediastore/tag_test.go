@@ -0,0 +1,23 @@
+package mediastore_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_media_store_container" "test" {
+  name = replace(%[1]q, "-", "_")
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_media_store_tag" "test" {
+  resource_arn = aws_media_store_container.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
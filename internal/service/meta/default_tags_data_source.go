@@ -13,6 +13,10 @@ func DataSourceDefaultTags() *schema.Resource {
 		Read: dataSourceDefaultTagsRead,
 
 		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
@@ -24,6 +28,12 @@ func dataSourceDefaultTagsRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(meta.(*conns.AWSClient).Partition)
 
+	// Without a resource type, tag values are returned as configured,
+	// i.e. with any ${resource_type} variable left unresolved.
+	if v, ok := d.GetOk("resource_type"); ok {
+		defaultTagsConfig = defaultTagsConfig.ForResourceType(v.(string))
+	}
+
 	tags := defaultTagsConfig.GetTags()
 
 	if tags != nil {
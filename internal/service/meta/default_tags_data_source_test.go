@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -118,6 +119,75 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 	})
 }
 
+func TestAccMetaDefaultTagsDataSource_template(t *testing.T) {
+	var providers []*schema.Provider
+
+	dataSourceName := "data.aws_default_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("type", "$${resource_type}"),
+					testAccDefaultTagsDataSource(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.type", "${resource_type}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaDefaultTagsDataSource_resourceType(t *testing.T) {
+	var providers []*schema.Provider
+
+	dataSourceName := "data.aws_default_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1IncludeResourceTypes1("type", "$${resource_type}", "aws_instance", "cost", "12345"),
+					testAccDefaultTagsDataSourceResourceType("aws_instance"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.type", "aws_instance"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.cost", "12345"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1IncludeResourceTypes1("type", "$${resource_type}", "aws_instance", "cost", "12345"),
+					testAccDefaultTagsDataSourceResourceType("aws_autoscaling_group"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.type", "aws_autoscaling_group"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDefaultTagsDataSource() string {
 	return `data "aws_default_tags" "test" {}`
 }
+
+func testAccDefaultTagsDataSourceResourceType(resourceType string) string {
+	return fmt.Sprintf(`
+data "aws_default_tags" "test" {
+  resource_type = %[1]q
+}
+`, resourceType)
+}
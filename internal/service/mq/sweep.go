@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
 		Name: "aws_mq_broker",
 		F:    sweepBrokers,
 	})
@@ -29,7 +29,7 @@ func sweepBrokers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).MQConn
+	conn := client.(*conns.AWSClient).MQConn()
 
 	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
@@ -0,0 +1,31 @@
+package mq_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_configuration" "test" {
+  name           = %[1]q
+  engine_type    = "ActiveMQ"
+  engine_version = "5.15.0"
+
+  data = <<DATA
+<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+</broker>
+DATA
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_mq_tag" "test" {
+  resource_arn = aws_mq_configuration.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
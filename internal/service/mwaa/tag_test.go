@@ -0,0 +1,34 @@
+package mwaa_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBase(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_mwaa_tag" "test" {
+  resource_arn = aws_mwaa_environment.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
@@ -0,0 +1,24 @@
+package neptune_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_parameter_group" "test" {
+  name   = %[1]q
+  family = "neptune1"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_neptune_tag" "test" {
+  resource_arn = aws_neptune_parameter_group.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
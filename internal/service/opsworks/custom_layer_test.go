@@ -62,7 +62,7 @@ func TestAccOpsWorksCustomLayer_basic(t *testing.T) {
 }
 
 func TestAccOpsWorksCustomLayer_tags(t *testing.T) {
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.test"
 
@@ -186,7 +186,7 @@ func testAccCheckLayerExists(n string, opslayer *opsworks.Layer) resource.TestCh
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 		params := &opsworks.DescribeLayersInput{
 			LayerIds: []*string{aws.String(rs.Primary.ID)},
@@ -269,7 +269,7 @@ func testAccCheckCreateLayerAttributes(
 }
 
 func testAccCheckLayerDestroy(resourceType string, s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resourceType {
 			continue
@@ -465,7 +465,7 @@ func resourceInstanceValidate(d *schema.ResourceData) error {
 }
 
 func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	req := &opsworks.DescribeInstancesInput{
 		InstanceIds: []*string{
@@ -574,7 +574,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	err := resourceInstanceValidate(d)
 	if err != nil {
@@ -737,7 +737,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	err := resourceInstanceValidate(d)
 	if err != nil {
@@ -818,7 +818,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	if v, ok := d.GetOk("status"); ok && v.(string) != "stopped" {
 		err := stopOpsworksInstance(d, meta, d.Timeout(schema.TimeoutDelete))
@@ -850,7 +850,7 @@ func resourceInstanceImport(
 }
 
 func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool, timeout time.Duration) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	instanceId := d.Id()
 
@@ -888,7 +888,7 @@ func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool,
 }
 
 func stopOpsworksInstance(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	instanceId := d.Id()
 
@@ -87,7 +87,7 @@ func testAccCheckRDSDBExists(
 			return fmt.Errorf("Rds Db stack id is missing, should be set.")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 		params := &opsworks.DescribeRdsDbInstancesInput{
 			StackId: aws.String(rs.Primary.Attributes["stack_id"]),
@@ -122,7 +122,7 @@ func testAccCheckCreateRDSDBAttributes(
 }
 
 func testAccCheckRDSDBDestroy(s *terraform.State) error {
-	client := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	client := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_rds_db_instance" {
@@ -0,0 +1,104 @@
+package opsworks_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "service" {
+  name = "%[1]s-service"
+
+  assume_role_policy = <<EOT
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "opsworks.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOT
+}
+
+resource "aws_iam_role_policy" "service" {
+  name = %[1]q
+  role = aws_iam_role.service.id
+
+  policy = <<EOT
+{
+  "Statement": [
+    {
+      "Action": [
+        "ec2:*",
+        "iam:PassRole",
+        "cloudwatch:GetMetricStatistics",
+        "elasticloadbalancing:*",
+        "rds:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+EOT
+}
+
+resource "aws_iam_role" "instance" {
+  name = "%[1]s-instance"
+
+  assume_role_policy = <<EOT
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOT
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = %[1]q
+  role = aws_iam_role.instance.name
+}
+
+resource "aws_opsworks_stack" "test" {
+  name                         = %[1]q
+  region                       = data.aws_region.current.name
+  service_role_arn             = aws_iam_role.service.arn
+  default_instance_profile_arn = aws_iam_instance_profile.test.arn
+  default_availability_zone    = data.aws_availability_zones.available.names[0]
+  use_opsworks_security_groups = false
+
+  depends_on = [aws_iam_role_policy.service]
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_opsworks_tag" "test" {
+  resource_arn = aws_opsworks_stack.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
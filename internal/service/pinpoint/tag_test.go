@@ -0,0 +1,23 @@
+package pinpoint_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_pinpoint_tag" "test" {
+  resource_arn = aws_pinpoint_app.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -90,7 +90,7 @@ func testAccCheckLedgerDestroy(s *terraform.State) error {
 }
 
 func testAccCheckLedgerDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).QLDBConn
+	conn := provider.Meta().(*conns.AWSClient).QLDBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_qldb_ledger" {
@@ -134,7 +134,7 @@ func testAccCheckLedgerExists(n string, v *qldb.DescribeLedgerOutput) resource.T
 			return fmt.Errorf("No QLDB Ledger ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QLDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QLDBConn()
 		resp, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -174,7 +174,7 @@ resource "aws_qldb_ledger" "test" {
 
 func TestAccQLDBLedger_tags(t *testing.T) {
 	var cluster1, cluster2, cluster3 qldb.DescribeLedgerOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_qldb_ledger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
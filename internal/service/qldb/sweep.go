@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
 		Name: "aws_qldb_ledger",
 		F:    sweepLedgers,
 	})
@@ -28,7 +28,7 @@ func sweepLedgers(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).QLDBConn
+	conn := client.(*conns.AWSClient).QLDBConn()
 	input := &qldb.ListLedgersInput{}
 	page, err := conn.ListLedgers(input)
 
@@ -0,0 +1,25 @@
+package qldb_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "ALLOW_ALL"
+  deletion_protection = false
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_qldb_tag" "test" {
+  resource_arn = aws_qldb_ledger.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
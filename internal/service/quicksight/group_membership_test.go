@@ -16,7 +16,7 @@ import (
 )
 
 func TestAccQuickSightGroupMembership_basic(t *testing.T) {
-	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	memberName := "tfacctest" + sdkacctest.RandString(10)
 	resourceName := "aws_quicksight_group_membership.default"
 
@@ -42,7 +42,7 @@ func TestAccQuickSightGroupMembership_basic(t *testing.T) {
 }
 
 func TestAccQuickSightGroupMembership_disappears(t *testing.T) {
-	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	memberName := "tfacctest" + sdkacctest.RandString(10)
 	resourceName := "aws_quicksight_group_membership.default"
 
@@ -65,7 +65,7 @@ func TestAccQuickSightGroupMembership_disappears(t *testing.T) {
 }
 
 func testAccCheckQuickSightGroupMembershipDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_quicksight_group_membership" {
@@ -109,7 +109,7 @@ func testAccCheckQuickSightGroupMembershipExists(resourceName string) resource.T
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
 
 		listInput := &quicksight.ListGroupMembershipsInput{
 			AwsAccountId: aws.String(awsAccountID),
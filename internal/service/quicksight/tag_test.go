@@ -0,0 +1,37 @@
+package quicksight_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return acctest.ConfigCompose(testAccBaseDataSourceConfig(rName), fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  type = "S3"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_quicksight_tag" "test" {
+  resource_arn = aws_quicksight_data_source.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value))
+}
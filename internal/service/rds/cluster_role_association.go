@@ -45,7 +45,7 @@ func ResourceClusterRoleAssociation() *schema.Resource {
 }
 
 func resourceClusterRoleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	dbClusterID := d.Get("db_cluster_identifier").(string)
 	roleARN := d.Get("role_arn").(string)
@@ -74,7 +74,7 @@ func resourceClusterRoleAssociationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceClusterRoleAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	dbClusterID, roleARN, err := ClusterRoleAssociationParseResourceID(d.Id())
 
@@ -102,7 +102,7 @@ func resourceClusterRoleAssociationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceClusterRoleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	dbClusterID, roleARN, err := ClusterRoleAssociationParseResourceID(d.Id())
 
@@ -0,0 +1,24 @@
+package rds_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = "mysql5.6"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_rds_tag" "test" {
+  resource_arn = aws_db_parameter_group.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
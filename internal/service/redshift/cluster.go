@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -313,7 +314,10 @@ func ResourceCluster() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.ValidateRegionDiff("snapshot_copy.0.destination_region"),
+		),
 	}
 }
 
@@ -327,10 +331,14 @@ func resourceClusterImport(
 }
 
 func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RedshiftConn
+	conn := meta.(*conns.AWSClient).RedshiftConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	if err := validateSnapshotCopyRegion(meta.(*conns.AWSClient), d.Get("snapshot_copy").([]interface{})); err != nil {
+		return err
+	}
+
 	if v, ok := d.GetOk("snapshot_identifier"); ok {
 		restoreOpts := &redshift.RestoreFromClusterSnapshotInput{
 			ClusterIdentifier:                aws.String(d.Get("cluster_identifier").(string)),
@@ -515,7 +523,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RedshiftConn
+	conn := meta.(*conns.AWSClient).RedshiftConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -631,7 +639,13 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RedshiftConn
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	if d.HasChange("snapshot_copy") {
+		if err := validateSnapshotCopyRegion(meta.(*conns.AWSClient), d.Get("snapshot_copy").([]interface{})); err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -836,6 +850,24 @@ func enableRedshiftClusterLogging(d *schema.ResourceData, conn *redshift.Redshif
 	return nil
 }
 
+// validateSnapshotCopyRegion returns an error if the provider configuration does not allow
+// the destination region of the snapshot copy configuration, to which snapshots are copied.
+func validateSnapshotCopyRegion(client *conns.AWSClient, tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["destination_region"].(string); ok && v != "" {
+		if err := client.ValidateRegion(v); err != nil {
+			return fmt.Errorf("error validating Redshift Cluster snapshot copy destination region: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.Redshift) error {
 	sc := scList[0].(map[string]interface{})
 
@@ -858,7 +890,7 @@ func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.
 }
 
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RedshiftConn
+	conn := meta.(*conns.AWSClient).RedshiftConn()
 
 	skipFinalSnapshot := d.Get("skip_final_snapshot").(bool)
 	input := &redshift.DeleteClusterInput{
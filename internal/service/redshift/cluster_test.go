@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,7 +20,7 @@ import (
 func TestAccRedshiftCluster_basic(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -57,7 +56,7 @@ func TestAccRedshiftCluster_basic(t *testing.T) {
 func TestAccRedshiftCluster_disappears(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -80,7 +79,7 @@ func TestAccRedshiftCluster_disappears(t *testing.T) {
 func TestAccRedshiftCluster_withFinalSnapshot(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -112,7 +111,7 @@ func TestAccRedshiftCluster_kmsKey(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
 	keyResourceName := "aws_kms_key.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -146,7 +145,7 @@ func TestAccRedshiftCluster_kmsKey(t *testing.T) {
 func TestAccRedshiftCluster_enhancedVPCRoutingEnabled(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -186,7 +185,7 @@ func TestAccRedshiftCluster_loggingEnabled(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
 	bucketResourceName := "aws_s3_bucket.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -227,7 +226,7 @@ func TestAccRedshiftCluster_snapshotCopy(t *testing.T) {
 	var providers []*schema.Provider
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -257,10 +256,31 @@ func TestAccRedshiftCluster_snapshotCopy(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftCluster_forbiddenSnapshotCopyRegion(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_forbiddenSnapshotCopyRegion(rName, acctest.AlternateRegion()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Forbidden AWS Region: ` + acctest.AlternateRegion()),
+			},
+		},
+	})
+}
+
 func TestAccRedshiftCluster_iamRoles(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -289,7 +309,7 @@ func TestAccRedshiftCluster_iamRoles(t *testing.T) {
 func TestAccRedshiftCluster_publiclyAccessible(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -319,7 +339,7 @@ func TestAccRedshiftCluster_publiclyAccessible(t *testing.T) {
 func TestAccRedshiftCluster_updateNodeCount(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -350,7 +370,7 @@ func TestAccRedshiftCluster_updateNodeCount(t *testing.T) {
 func TestAccRedshiftCluster_updateNodeType(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -379,7 +399,7 @@ func TestAccRedshiftCluster_updateNodeType(t *testing.T) {
 func TestAccRedshiftCluster_tags(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -429,7 +449,7 @@ func TestAccRedshiftCluster_tags(t *testing.T) {
 func TestAccRedshiftCluster_forceNewUsername(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -460,7 +480,7 @@ func TestAccRedshiftCluster_forceNewUsername(t *testing.T) {
 func TestAccRedshiftCluster_changeAvailabilityZone(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -489,7 +509,7 @@ func TestAccRedshiftCluster_changeAvailabilityZone(t *testing.T) {
 func TestAccRedshiftCluster_changeEncryption1(t *testing.T) {
 	var cluster1, cluster2 redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -519,7 +539,7 @@ func TestAccRedshiftCluster_changeEncryption1(t *testing.T) {
 func TestAccRedshiftCluster_changeEncryption2(t *testing.T) {
 	var cluster1, cluster2 redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -547,7 +567,7 @@ func TestAccRedshiftCluster_changeEncryption2(t *testing.T) {
 }
 
 func testAccCheckClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_cluster" {
@@ -578,7 +598,7 @@ func testAccCheckDestroyClusterSnapshot(rName string) resource.TestCheckFunc {
 			}
 
 			// Try and delete the snapshot before we check for the cluster not found
-			conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+			conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
 
 			_, err := conn.DeleteClusterSnapshot(
 				&redshift.DeleteClusterSnapshotInput{
@@ -617,7 +637,7 @@ func testAccCheckClusterExists(n string, v *redshift.Cluster) resource.TestCheck
 			return fmt.Errorf("No Redshift Cluster ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
 
 		output, err := tfredshift.FindClusterByID(conn, rs.Primary.ID)
 
@@ -1005,6 +1025,32 @@ resource "aws_redshift_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_forbiddenSnapshotCopyRegion(rName, region string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
+provider "aws" {
+  forbidden_regions = [%[2]q]
+}
+
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+
+  snapshot_copy {
+    destination_region = %[2]q
+    retention_period   = 1
+  }
+
+  skip_final_snapshot = true
+}
+`, rName, region))
+}
+
 func testAccClusterConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
 resource "aws_redshift_cluster" "test" {
@@ -1,6 +1,7 @@
 package route53
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -189,7 +190,7 @@ func ResourceHealthCheck() *schema.Resource {
 }
 
 func resourceHealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -304,11 +305,19 @@ func resourceHealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	output, err := FindHealthCheckByID(conn, d.Id())
+	var output *route53.HealthCheck
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.Route53).Read(context.Background(), d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindHealthCheckByID(conn, d.Id())
+
+		return err
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Health Check (%s) not found, removing from state", d.Id())
@@ -377,7 +386,7 @@ func resourceHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		updateHealthCheck := &route53.UpdateHealthCheckInput{
@@ -464,7 +473,7 @@ func resourceHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceHealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	log.Printf("[DEBUG] Deleting Route53 Health Check: %s", d.Id())
 	_, err := conn.DeleteHealthCheck(&route53.DeleteHealthCheckInput{HealthCheckId: aws.String(d.Id())})
@@ -332,7 +332,7 @@ func TestAccRoute53Zone_tags(t *testing.T) {
 func TestAccRoute53Zone_VPC_single(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName := "aws_vpc.test1"
 	zoneName := acctest.RandomDomainName()
@@ -364,7 +364,7 @@ func TestAccRoute53Zone_VPC_single(t *testing.T) {
 func TestAccRoute53Zone_VPC_multiple(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName1 := "aws_vpc.test1"
 	vpcResourceName2 := "aws_vpc.test2"
@@ -398,7 +398,7 @@ func TestAccRoute53Zone_VPC_multiple(t *testing.T) {
 func TestAccRoute53Zone_VPC_updates(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName1 := "aws_vpc.test1"
 	vpcResourceName2 := "aws_vpc.test2"
@@ -444,7 +444,7 @@ func testAccCheckRoute53ZoneDestroy(s *terraform.State) error {
 }
 
 func testAccCheckRoute53ZoneDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).Route53Conn
+	conn := provider.Meta().(*conns.AWSClient).Route53Conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_zone" {
 			continue
@@ -465,7 +465,7 @@ func testAccCreateRandomRoute53RecordsInZoneId(zone *route53.GetHostedZoneOutput
 func testAccCreateRandomRoute53RecordsInZoneIdWithProvider(providerF func() *schema.Provider, zone *route53.GetHostedZoneOutput, recordsCount int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).Route53Conn
+		conn := provider.Meta().(*conns.AWSClient).Route53Conn()
 
 		var changes []*route53.Change
 		if recordsCount > 100 {
@@ -519,7 +519,7 @@ func testAccCheckRoute53ZoneExistsWithProvider(n string, zone *route53.GetHosted
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).Route53Conn
+		conn := provider.Meta().(*conns.AWSClient).Route53Conn()
 		resp, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(rs.Primary.ID)})
 		if err != nil {
 			return fmt.Errorf("Hosted zone err: %v", err)
@@ -553,7 +553,7 @@ func testAccCheckRoute53ZoneExistsWithProvider(n string, zone *route53.GetHosted
 
 func testAccCheckRoute53ZoneDisappears(zone *route53.GetHostedZoneOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
 
 		input := &route53.DeleteHostedZoneInput{
 			Id: zone.HostedZone.Id,
@@ -0,0 +1,23 @@
+package route53recoveryreadiness_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoveryreadiness_cell" "test" {
+  cell_name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_route53recoveryreadiness_tag" "test" {
+  resource_arn = aws_route53recoveryreadiness_cell.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -0,0 +1,23 @@
+package route53resolver_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_route53_resolver_tag" "test" {
+  resource_arn = aws_route53_resolver_firewall_domain_list.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -77,8 +78,8 @@ func ResourceBucket() *schema.Resource {
 
 			"acl": {
 				Type:          schema.TypeString,
-				Default:       "private",
 				Optional:      true,
+				Computed:      true,
 				ConflictsWith: []string{"grant"},
 				ValidateFunc:  validation.StringInSlice(BucketCannedACL_Values(), false),
 			},
@@ -86,6 +87,7 @@ func ResourceBucket() *schema.Resource {
 			"grant": {
 				Type:          schema.TypeSet,
 				Optional:      true,
+				Computed:      true,
 				Set:           grantHash,
 				ConflictsWith: []string{"acl"},
 				Elem: &schema.Resource{
@@ -131,6 +133,7 @@ func ResourceBucket() *schema.Resource {
 			"cors_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_headers": {
@@ -164,6 +167,7 @@ func ResourceBucket() *schema.Resource {
 			"website": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -245,6 +249,7 @@ func ResourceBucket() *schema.Resource {
 			"logging": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -269,6 +274,7 @@ func ResourceBucket() *schema.Resource {
 			"lifecycle_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -397,6 +403,7 @@ func ResourceBucket() *schema.Resource {
 			"replication_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -571,6 +578,7 @@ func ResourceBucket() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rule": {
@@ -608,10 +616,20 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			"object_lock_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"object_lock_configuration"},
+			},
+
 			"object_lock_configuration": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"object_lock_enabled"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"object_lock_enabled": {
@@ -665,12 +683,15 @@ func ResourceBucket() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceBucketCustomizeDiff,
+		),
 	}
 }
 
 func resourceBucketCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	// Get the bucket and acl
 	var bucket string
@@ -683,6 +704,10 @@ func resourceBucketCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("bucket", bucket)
 
+	if err := validateBucketReplicationDestinationRegions(meta.(*conns.AWSClient), conn, d); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] S3 bucket create: %s", bucket)
 
 	req := &s3.CreateBucketInput{
@@ -715,6 +740,9 @@ func resourceBucketCreate(d *schema.ResourceData, meta interface{}) error {
 	if objectLockConfiguration != nil && aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled {
 		req.ObjectLockEnabledForBucket = aws.Bool(true)
 	}
+	if d.Get("object_lock_enabled").(bool) {
+		req.ObjectLockEnabledForBucket = aws.Bool(true)
+	}
 
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		_, err := conn.CreateBucket(req)
@@ -743,7 +771,13 @@ func resourceBucketCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if d.HasChange("replication_configuration") {
+		if err := validateBucketReplicationDestinationRegions(meta.(*conns.AWSClient), conn, d); err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -759,78 +793,78 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("policy") {
-		if err := resourceBucketPolicyUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalPolicyUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("cors_rule") {
-		if err := resourceBucketCorsUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalCorsUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("website") {
-		if err := resourceBucketWebsiteUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalWebsiteUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("versioning") {
-		if err := resourceBucketVersioningUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalVersioningUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 	if d.HasChange("acl") && !d.IsNewResource() {
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalACLUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("grant") {
-		if err := resourceBucketGrantsUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalGrantsUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceBucketLoggingUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalLoggingUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
-		if err := resourceBucketLifecycleUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalLifecycleUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("acceleration_status") {
-		if err := resourceBucketAccelerationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalAccelerationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("request_payer") {
-		if err := resourceBucketRequestPayerUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalRequestPayerUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("replication_configuration") {
-		if err := resourceBucketReplicationConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalReplicationConfigurationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceBucketServerSideEncryptionConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalServerSideEncryptionConfigurationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("object_lock_configuration") {
-		if err := resourceBucketObjectLockConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalObjectLockConfigurationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
@@ -839,7 +873,7 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1303,6 +1337,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("object_lock_configuration", conf); err != nil {
 			return fmt.Errorf("error setting object_lock_configuration: %s", err)
 		}
+		d.Set("object_lock_enabled", len(conf) > 0)
 	}
 
 	// Add the region as an attribute
@@ -1396,7 +1431,7 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	log.Printf("[DEBUG] S3 Delete Bucket: %s", d.Id())
 	_, err := conn.DeleteBucket(&s3.DeleteBucketInput{
@@ -1412,7 +1447,7 @@ func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 			// Use a S3 service client that can handle multiple slashes in URIs.
 			// While aws_s3_bucket_object resources cannot create these object
 			// keys, other AWS services and applications using the S3 Bucket can.
-			conn = meta.(*conns.AWSClient).S3ConnURICleaningDisabled
+			conn = meta.(*conns.AWSClient).S3ConnURICleaningDisabled()
 
 			// bucket may have things delete them
 			log.Printf("[DEBUG] S3 Bucket attempting to forceDestroy %+v", err)
@@ -1424,6 +1459,9 @@ func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 			if objectLockConfiguration != nil {
 				objectLockEnabled = aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled
 			}
+			if d.Get("object_lock_enabled").(bool) {
+				objectLockEnabled = true
+			}
 			err = DeleteAllObjectVersions(conn, d.Id(), "", objectLockEnabled, false)
 
 			if err != nil {
@@ -1442,7 +1480,7 @@ func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
 
@@ -1486,13 +1524,13 @@ func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	rawGrants := d.Get("grant").(*schema.Set).List()
 
 	if len(rawGrants) == 0 {
 		log.Printf("[DEBUG] S3 bucket: %s, Grants fallback to canned ACL", bucket)
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalACLUpdate(conn, d); err != nil {
 			return fmt.Errorf("Error fallback to canned ACL, %s", err)
 		}
 	} else {
@@ -1550,11 +1588,14 @@ func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 		if err != nil {
 			return fmt.Errorf("Error putting S3 Grants: %s", err)
 		}
+
+		// The canned ACL is Computed, clear it so that the grants are read.
+		d.Set("acl", "")
 	}
 	return nil
 }
 
-func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
 
@@ -1620,11 +1661,11 @@ func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	ws := d.Get("website").([]interface{})
 
 	if len(ws) == 0 {
-		return resourceBucketWebsiteDelete(conn, d)
+		return resourceBucketInternalWebsiteDelete(conn, d)
 	}
 
 	var w map[string]interface{}
@@ -1633,10 +1674,10 @@ func resourceBucketWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	} else {
 		w = make(map[string]interface{})
 	}
-	return resourceBucketWebsitePut(conn, d, w)
+	return resourceBucketInternalWebsitePut(conn, d, w)
 }
 
-func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
+func resourceBucketInternalWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
 	bucket := d.Get("bucket").(string)
 
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
@@ -1710,7 +1751,7 @@ func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[s
 	return nil
 }
 
-func resourceBucketWebsiteDelete(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalWebsiteDelete(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
@@ -1741,7 +1782,7 @@ func websiteEndpoint(client *conns.AWSClient, d *schema.ResourceData) (*S3Websit
 	// Lookup the region for this bucket
 
 	locationResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return client.S3Conn.GetBucketLocation(
+		return client.S3Conn().GetBucketLocation(
 			&s3.GetBucketLocationInput{
 				Bucket: aws.String(bucket),
 			},
@@ -1810,7 +1851,7 @@ func isOldRegion(region string) bool {
 	return false
 }
 
-func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
 
@@ -1830,7 +1871,7 @@ func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketVersioningUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalVersioningUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("bucket").(string)
 	vc := &s3.VersioningConfiguration{}
@@ -1870,7 +1911,7 @@ func resourceBucketVersioningUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
@@ -1905,7 +1946,7 @@ func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	enableAcceleration := d.Get("acceleration_status").(string)
 
@@ -1927,7 +1968,7 @@ func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	payer := d.Get("request_payer").(string)
 
@@ -1949,7 +1990,7 @@ func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
 	if len(serverSideEncryptionConfiguration) == 0 {
@@ -2016,7 +2057,7 @@ func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schem
 	return nil
 }
 
-func resourceBucketObjectLockConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalObjectLockConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	// S3 Object Lock configuration cannot be deleted, only updated.
 	req := &s3.PutObjectLockConfigurationInput{
 		Bucket:                  aws.String(d.Get("bucket").(string)),
@@ -2033,7 +2074,128 @@ func resourceBucketObjectLockConfigurationUpdate(conn *s3.S3, d *schema.Resource
 	return nil
 }
 
-func resourceBucketReplicationConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+// resourceBucketCustomizeDiff checks at plan time, where possible, that the provider configuration allows
+// the regions of the replication destination buckets. The regions are checked again when the bucket is created or updated.
+func resourceBucketCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("replication_configuration") {
+		return nil
+	}
+
+	return validateBucketReplicationDestinationRegionsDiff(ctx, meta.(*conns.AWSClient), bucketReplicationDestinationBucketARNs(diff.Get("replication_configuration").([]interface{})))
+}
+
+// validateBucketReplicationDestinationRegionsDiff returns an error if the provider configuration does not allow
+// the region of any of the specified replication destination buckets. Buckets whose ARN is unknown at plan time,
+// or whose region cannot be found, e.g. because they are created by the same plan, are skipped.
+func validateBucketReplicationDestinationRegionsDiff(ctx context.Context, client *conns.AWSClient, bucketARNs []string) error {
+	if !client.RegionsRestricted() {
+		return nil
+	}
+
+	conn := client.S3Conn()
+
+	for _, bucketARN := range bucketARNs {
+		// Unknown at plan time.
+		if bucketARN == "" {
+			continue
+		}
+
+		region, err := findBucketReplicationDestinationRegion(ctx, conn, bucketARN)
+
+		if err != nil {
+			log.Printf("[DEBUG] Unable to check S3 Bucket replication destination (%s) region at plan time: %s", bucketARN, err)
+			continue
+		}
+
+		if err := client.ValidateRegion(region); err != nil {
+			return fmt.Errorf("error validating S3 Bucket replication destination (%s) region: %w", bucketARN, err)
+		}
+	}
+
+	return nil
+}
+
+// bucketReplicationDestinationBucketARNs returns the ARNs of the destination buckets of the replication configuration's rules.
+func bucketReplicationDestinationBucketARNs(replicationConfiguration []interface{}) []string {
+	var bucketARNs []string
+
+	if len(replicationConfiguration) == 0 || replicationConfiguration[0] == nil {
+		return bucketARNs
+	}
+
+	for _, v := range replicationConfiguration[0].(map[string]interface{})["rules"].(*schema.Set).List() {
+		rr := v.(map[string]interface{})
+
+		dest, ok := rr["destination"].([]interface{})
+
+		if !ok || len(dest) == 0 || dest[0] == nil {
+			continue
+		}
+
+		bucketARNs = append(bucketARNs, dest[0].(map[string]interface{})["bucket"].(string))
+	}
+
+	return bucketARNs
+}
+
+// validateBucketReplicationDestinationRegions returns an error if the provider configuration does not allow
+// the region of any of the configured replication destination buckets.
+func validateBucketReplicationDestinationRegions(client *conns.AWSClient, conn *s3.S3, d *schema.ResourceData) error {
+	if !client.RegionsRestricted() {
+		return nil
+	}
+
+	for _, bucketARN := range bucketReplicationDestinationBucketARNs(d.Get("replication_configuration").([]interface{})) {
+		if err := validateBucketReplicationDestinationRegion(context.Background(), client, conn, bucketARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBucketReplicationDestinationRegion returns an error if the provider configuration does not allow
+// the region of the replication destination bucket with the specified ARN.
+func validateBucketReplicationDestinationRegion(ctx context.Context, client *conns.AWSClient, conn *s3.S3, bucketARN string) error {
+	if !client.RegionsRestricted() {
+		return nil
+	}
+
+	region, err := findBucketReplicationDestinationRegion(ctx, conn, bucketARN)
+
+	if err != nil {
+		return err
+	}
+
+	if err := client.ValidateRegion(region); err != nil {
+		return fmt.Errorf("error validating S3 Bucket replication destination (%s) region: %w", bucketARN, err)
+	}
+
+	return nil
+}
+
+// findBucketReplicationDestinationRegion returns the region of the replication destination bucket with the specified ARN.
+func findBucketReplicationDestinationRegion(ctx context.Context, conn *s3.S3, bucketARN string) (string, error) {
+	// e.g. arn:aws:s3:::destination-bucket
+	destinationBucketARN, err := arn.Parse(bucketARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing S3 Bucket replication destination ARN: %w", err)
+	}
+
+	region, err := s3manager.GetBucketRegionWithClient(ctx, conn, destinationBucketARN.Resource, func(r *request.Request) {
+		r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
+		r.Config.Credentials = conn.Config.Credentials
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error getting S3 Bucket (%s) replication destination location: %w", destinationBucketARN.Resource, err)
+	}
+
+	return region, nil
+}
+
+func resourceBucketInternalReplicationConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
 
@@ -2214,7 +2376,7 @@ func resourceBucketReplicationConfigurationUpdate(conn *s3.S3, d *schema.Resourc
 	return nil
 }
 
-func resourceBucketLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
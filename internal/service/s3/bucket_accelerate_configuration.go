@@ -0,0 +1,122 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketAccelerateConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketAccelerateConfigurationCreate,
+		ReadContext:   resourceBucketAccelerateConfigurationRead,
+		UpdateContext: resourceBucketAccelerateConfigurationUpdate,
+		DeleteContext: resourceBucketAccelerateConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3.BucketAccelerateStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceBucketAccelerateConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketAccelerateConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceBucketAccelerateConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var output *s3.GetBucketAccelerateConfigurationOutput
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindBucketAccelerateConfiguration(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Accelerate Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket Accelerate Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceBucketAccelerateConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(d.Get("status").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Accelerate Configuration: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketAccelerateConfigurationWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) Accelerate Configuration: %s", d.Id(), err)
+	}
+
+	return resourceBucketAccelerateConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketAccelerateConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// Transfer acceleration cannot be removed once configured, only suspended.
+	log.Printf("[DEBUG] Deleting S3 Bucket Accelerate Configuration: %s", d.Id())
+	_, err := conn.PutBucketAccelerateConfigurationWithContext(ctx, &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(s3.BucketAccelerateStatusSuspended),
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Accelerate Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketACLCreate,
+		ReadContext:   resourceBucketACLRead,
+		UpdateContext: resourceBucketACLUpdate,
+		DeleteContext: resourceBucketACLDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_control_policy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"email_address": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+												},
+												"uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"permission": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+									},
+								},
+							},
+						},
+						"owner": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+		},
+	}
+}
+
+func resourceBucketACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketACLUpdate(ctx, d, meta)
+}
+
+func resourceBucketACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var output *s3.GetBucketAclOutput
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindBucketACL(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket ACL (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("access_control_policy", flattenBucketAccessControlPolicy(output)); err != nil {
+		return diag.Errorf("error setting access_control_policy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBucketACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	// The canned ACL takes precedence as the access control policy is Computed.
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else {
+		input.AccessControlPolicy = expandBucketAccessControlPolicy(d.Get("access_control_policy").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket ACL: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketAclWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) ACL: %s", d.Id(), err)
+	}
+
+	return resourceBucketACLRead(ctx, d, meta)
+}
+
+func resourceBucketACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A bucket always has an ACL, it is removed from state only.
+	log.Printf("[WARN] S3 Bucket ACL (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func expandBucketAccessControlPolicy(tfList []interface{}) *s3.AccessControlPolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &s3.AccessControlPolicy{}
+
+	if v, ok := tfMap["grant"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Grants = expandBucketACLGrants(v.List())
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Owner = &s3.Owner{}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.Owner.DisplayName = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Owner.ID = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandBucketACLGrants(tfList []interface{}) []*s3.Grant {
+	var apiObjects []*s3.Grant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.Grant{}
+
+		if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Grantee = expandBucketGrantee(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["permission"].(string); ok && v != "" {
+			apiObject.Permission = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBucketAccessControlPolicy(apiObject *s3.GetBucketAclOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Grants; len(v) > 0 {
+		tfMap["grant"] = flattenBucketACLGrants(v)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = []interface{}{
+			map[string]interface{}{
+				"display_name": aws.StringValue(v.DisplayName),
+				"id":           aws.StringValue(v.ID),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenBucketACLGrants(apiObjects []*s3.Grant) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"permission": aws.StringValue(apiObject.Permission),
+		}
+
+		if v := apiObject.Grantee; v != nil {
+			tfMap["grantee"] = []interface{}{flattenBucketGrantee(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,220 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketCORSConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketCORSConfigurationCreate,
+		ReadContext:   resourceBucketCORSConfigurationRead,
+		UpdateContext: resourceBucketCORSConfigurationUpdate,
+		DeleteContext: resourceBucketCORSConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"cors_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_methods": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_origins": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"max_age_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketCORSConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketCORSConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceBucketCORSConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var rules []*s3.CORSRule
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		rules, err = FindBucketCORSRules(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket CORS Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket CORS Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("cors_rule", flattenBucketCORSRules(rules)); err != nil {
+		return diag.Errorf("error setting cors_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBucketCORSConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandBucketCORSRules(d.Get("cors_rule").(*schema.Set).List()),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket CORS Configuration: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketCorsWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) CORS Configuration: %s", d.Id(), err)
+	}
+
+	return resourceBucketCORSConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketCORSConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	log.Printf("[DEBUG] Deleting S3 Bucket CORS Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketCorsWithContext(ctx, &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) CORS Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketCORSRules(tfList []interface{}) []*s3.CORSRule {
+	var apiObjects []*s3.CORSRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.CORSRule{}
+
+		if v, ok := tfMap["allowed_headers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AllowedHeaders = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["allowed_methods"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AllowedMethods = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["allowed_origins"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AllowedOrigins = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["expose_headers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ExposeHeaders = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["max_age_seconds"].(int); ok && v != 0 {
+			apiObject.MaxAgeSeconds = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBucketCORSRules(apiObjects []*s3.CORSRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"allowed_headers": flex.FlattenStringSet(apiObject.AllowedHeaders),
+			"allowed_methods": flex.FlattenStringSet(apiObject.AllowedMethods),
+			"allowed_origins": flex.FlattenStringSet(apiObject.AllowedOrigins),
+			"expose_headers":  flex.FlattenStringSet(apiObject.ExposeHeaders),
+			"id":              aws.StringValue(apiObject.ID),
+			"max_age_seconds": int(aws.Int64Value(apiObject.MaxAgeSeconds)),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func DataSourceBucket() *schema.Resource {
@@ -51,12 +53,205 @@ func DataSourceBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tags": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"object_ownership": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mfa_delete": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceBucketRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -91,22 +286,135 @@ func dataSourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("bucket_regional_domain_name", regionalDomainName)
 
+	// Bucket configuration can only be read from the bucket's region.
+	regionalConn := meta.(*conns.AWSClient).S3ConnForRegion(d.Get("region").(string))
+
+	if err := bucketConfigurationState(context.Background(), regionalConn, d, bucket); err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) configuration: %w", bucket, err)
+	}
+
 	return nil
 }
 
+// bucketConfigurationState sets the bucket's versioning, encryption, lifecycle,
+// ownership controls and public access block state. Configuration that has
+// never been applied to the bucket, or that cannot be read, is left empty.
+func bucketConfigurationState(ctx context.Context, conn *s3.S3, d *schema.ResourceData, bucket string) error {
+	versioning, err := FindBucketVersioning(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("versioning_configuration", nil)
+	case bucketConfigurationUnreadable(err):
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) versioning: %s", bucket, err)
+		d.Set("versioning_configuration", nil)
+	case err != nil:
+		return fmt.Errorf("reading versioning: %w", err)
+	default:
+		if err := d.Set("versioning_configuration", flattenBucketVersioningConfiguration(versioning)); err != nil {
+			return fmt.Errorf("setting versioning_configuration: %w", err)
+		}
+	}
+
+	encryption, err := FindBucketServerSideEncryptionConfiguration(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("server_side_encryption_configuration", nil)
+	case bucketConfigurationUnreadable(err):
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) server-side encryption configuration: %s", bucket, err)
+		d.Set("server_side_encryption_configuration", nil)
+	case err != nil:
+		return fmt.Errorf("reading server-side encryption configuration: %w", err)
+	default:
+		tfMap := map[string]interface{}{
+			"rule": flattenBucketServerSideEncryptionRules(encryption.Rules),
+		}
+
+		if err := d.Set("server_side_encryption_configuration", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("setting server_side_encryption_configuration: %w", err)
+		}
+	}
+
+	rules, err := FindBucketLifecycleRules(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("lifecycle_rule", nil)
+	case bucketConfigurationUnreadable(err):
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) lifecycle configuration: %s", bucket, err)
+		d.Set("lifecycle_rule", nil)
+	case err != nil:
+		return fmt.Errorf("reading lifecycle configuration: %w", err)
+	default:
+		if err := d.Set("lifecycle_rule", flattenBucketLifecycleRules(rules)); err != nil {
+			return fmt.Errorf("setting lifecycle_rule: %w", err)
+		}
+	}
+
+	ownershipControls, err := FindBucketOwnershipControls(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("object_ownership", nil)
+	case bucketConfigurationUnreadable(err):
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) ownership controls: %s", bucket, err)
+		d.Set("object_ownership", nil)
+	case err != nil:
+		return fmt.Errorf("reading ownership controls: %w", err)
+	default:
+		if len(ownershipControls.Rules) > 0 && ownershipControls.Rules[0] != nil {
+			d.Set("object_ownership", ownershipControls.Rules[0].ObjectOwnership)
+		} else {
+			d.Set("object_ownership", nil)
+		}
+	}
+
+	publicAccessBlock, err := FindPublicAccessBlockConfiguration(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("public_access_block", nil)
+	case bucketConfigurationUnreadable(err):
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) public access block: %s", bucket, err)
+		d.Set("public_access_block", nil)
+	case err != nil:
+		return fmt.Errorf("reading public access block: %w", err)
+	default:
+		tfMap := map[string]interface{}{
+			"block_public_acls":       aws.BoolValue(publicAccessBlock.BlockPublicAcls),
+			"block_public_policy":     aws.BoolValue(publicAccessBlock.BlockPublicPolicy),
+			"ignore_public_acls":      aws.BoolValue(publicAccessBlock.IgnorePublicAcls),
+			"restrict_public_buckets": aws.BoolValue(publicAccessBlock.RestrictPublicBuckets),
+		}
+
+		if err := d.Set("public_access_block", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("setting public_access_block: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// bucketConfigurationUnreadable returns whether the error means that the bucket configuration
+// cannot be read, because the caller is not allowed to or the S3 implementation does not support it.
+func bucketConfigurationUnreadable(err error) bool {
+	return tfawserr.ErrCodeEquals(err, ErrCodeAccessDenied, ErrCodeNotImplemented)
+}
+
 func bucketLocation(client *conns.AWSClient, d *schema.ResourceData, bucket string) error {
-	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.S3Conn, bucket, func(r *request.Request) {
+	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.S3Conn(), bucket, func(r *request.Request) {
 		// By default, GetBucketRegion forces virtual host addressing, which
 		// is not compatible with many non-AWS implementations. Instead, pass
 		// the provider s3_force_path_style configuration, which defaults to
 		// false, but allows override.
-		r.Config.S3ForcePathStyle = client.S3Conn.Config.S3ForcePathStyle
+		r.Config.S3ForcePathStyle = client.S3Conn().Config.S3ForcePathStyle
 
 		// By default, GetBucketRegion uses anonymous credentials when doing
 		// a HEAD request to get the bucket region. This breaks in aws-cn regions
 		// when the account doesn't have an ICP license to host public content.
 		// Use the current credentials when getting the bucket region.
-		r.Config.Credentials = client.S3Conn.Config.Credentials
+		r.Config.Credentials = client.S3Conn().Config.Credentials
 	})
 	if err != nil {
 		return err
@@ -122,7 +430,7 @@ func bucketLocation(client *conns.AWSClient, d *schema.ResourceData, bucket stri
 		d.Set("hosted_zone_id", hostedZoneID)
 	}
 
-	_, websiteErr := client.S3Conn.GetBucketWebsite(
+	_, websiteErr := client.S3Conn().GetBucketWebsite(
 		&s3.GetBucketWebsiteInput{
 			Bucket: aws.String(bucket),
 		},
@@ -2,17 +2,19 @@ package s3_test
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 )
 
 func TestAccS3BucketDataSource_basic(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix(t, "tf-test-bucket")
 	region := acctest.Region()
 	hostedZoneID, _ := tfs3.HostedZoneIDForRegion(region)
 
@@ -38,7 +40,7 @@ func TestAccS3BucketDataSource_basic(t *testing.T) {
 }
 
 func TestAccS3BucketDataSource_website(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix(t, "tf-test-bucket")
 	region := acctest.Region()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -58,6 +60,95 @@ func TestAccS3BucketDataSource_website(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketDataSource_configuration(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.0.status", s3.BucketVersioningStatusEnabled),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", s3.ServerSideEncryptionAes256),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.status", s3.ExpirationStatusEnabled),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.expiration.0.days", "90"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_ownership", s3.ObjectOwnershipBucketOwnerPreferred),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_policy", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.ignore_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.restrict_public_buckets", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketDataSource_configurationCrossRegion(t *testing.T) {
+	var providers []*schema.Provider
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationCrossRegionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.0.status", s3.BucketVersioningStatusEnabled),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", s3.ServerSideEncryptionAes256),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "object_ownership", s3.ObjectOwnershipBucketOwnerPreferred),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketDataSource_configurationRestricted(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckAssumeRoleARN(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationRestrictedDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_ownership", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccBucketDataSourceConfig_basic(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -87,3 +178,223 @@ data "aws_s3_bucket" "bucket" {
 }
 `, bucketName)
 }
+
+func testAccBucketConfigurationDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    expiration {
+      days = 90
+    }
+  }
+}
+
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    object_ownership = "BucketOwnerPreferred"
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+data "aws_s3_bucket" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  depends_on = [
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_server_side_encryption_configuration.test,
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_public_access_block.test,
+  ]
+}
+`, rName)
+}
+
+// testAccBucketConfigurationCrossRegionDataSourceConfig reads a bucket in the alternate region with the default provider.
+func testAccBucketConfigurationCrossRegionDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    expiration {
+      days = 90
+    }
+  }
+}
+
+resource "aws_s3_bucket_ownership_controls" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    object_ownership = "BucketOwnerPreferred"
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+data "aws_s3_bucket" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  depends_on = [
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_server_side_encryption_configuration.test,
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_public_access_block.test,
+  ]
+}
+`, rName))
+}
+
+// testAccBucketConfigurationRestrictedDataSourceConfig reads the bucket with an IAM Role that is not allowed to read its configuration.
+func testAccBucketConfigurationRestrictedDataSourceConfig(rName string) string {
+	policy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowAll",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "DenyConfiguration",
+      "Effect": "Deny",
+      "Action": [
+        "s3:GetBucketOwnershipControls",
+        "s3:GetBucketPublicAccessBlock",
+        "s3:GetBucketVersioning",
+        "s3:GetEncryptionConfiguration",
+        "s3:GetLifecycleConfiguration"
+      ],
+      "Resource": "*"
+    }
+  ]
+}`
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  alias = "restricted"
+
+  assume_role {
+    role_arn = %[2]q
+    policy   = %[3]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+data "aws_s3_bucket" "test" {
+  provider = aws.restricted
+
+  bucket = aws_s3_bucket.test.id
+
+  depends_on = [
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_public_access_block.test,
+  ]
+}
+`, rName, os.Getenv(conns.EnvVarAccAssumeRoleARN), policy)
+}
@@ -175,7 +175,7 @@ func ResourceBucketInventory() *schema.Resource {
 }
 
 func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 
@@ -224,7 +224,7 @@ func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Putting S3 bucket inventory configuration: %s", input)
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() *resource.RetryError {
 		_, err := conn.PutBucketInventoryConfiguration(input)
 
 		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
@@ -252,7 +252,7 @@ func resourceBucketInventoryPut(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket, name, err := BucketInventoryParseID(d.Id())
 	if err != nil {
@@ -283,7 +283,7 @@ func resourceBucketInventoryDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket, name, err := BucketInventoryParseID(d.Id())
 	if err != nil {
@@ -300,7 +300,7 @@ func resourceBucketInventoryRead(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Reading S3 bucket inventory configuration: %s", input)
 	var output *s3.GetBucketInventoryConfigurationOutput
-	err = resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err = resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() *resource.RetryError {
 		var err error
 		output, err = conn.GetBucketInventoryConfiguration(input)
 
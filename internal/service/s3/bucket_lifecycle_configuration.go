@@ -0,0 +1,470 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketLifecycleConfigurationCreate,
+		ReadContext:   resourceBucketLifecycleConfigurationRead,
+		UpdateContext: resourceBucketLifecycleConfigurationUpdate,
+		DeleteContext: resourceBucketLifecycleConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tags": tftags.TagsSchema(),
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validBucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validBucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketLifecycleConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var rules []*s3.LifecycleRule
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		rules, err = FindBucketLifecycleRules(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket Lifecycle Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenBucketLifecycleRules(rules)); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	rules, err := expandBucketLifecycleRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Lifecycle Configuration: %s", input)
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfigurationWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) Lifecycle Configuration: %s", d.Id(), err)
+	}
+
+	return resourceBucketLifecycleConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Lifecycle Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Lifecycle Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketLifecycleDate(v string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing S3 Bucket Lifecycle date (%s): %w", v, err)
+	}
+
+	return aws.Time(t), nil
+}
+
+func expandBucketLifecycleRules(tfList []interface{}) ([]*s3.LifecycleRule, error) {
+	var apiObjects []*s3.LifecycleRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.LifecycleRule{
+			// An empty filter applies the rule to all objects in the bucket.
+			Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+		}
+
+		if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(v[0].(map[string]interface{})["days_after_initiation"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			expiration := &s3.LifecycleExpiration{}
+
+			if v, ok := tfMap["date"].(string); ok && v != "" {
+				t, err := expandBucketLifecycleDate(v)
+
+				if err != nil {
+					return nil, err
+				}
+
+				expiration.Date = t
+			} else if v, ok := tfMap["days"].(int); ok && v > 0 {
+				expiration.Days = aws.Int64(int64(v))
+			} else if v, ok := tfMap["expired_object_delete_marker"].(bool); ok {
+				expiration.ExpiredObjectDeleteMarker = aws.Bool(v)
+			}
+
+			apiObject.Expiration = expiration
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			prefix, _ := tfMap["prefix"].(string)
+			tags := Tags(tftags.New(tfMap["tags"]).IgnoreAWS())
+
+			switch {
+			case len(tags) > 1 || (len(tags) == 1 && prefix != ""):
+				apiObject.Filter = &s3.LifecycleRuleFilter{
+					And: &s3.LifecycleRuleAndOperator{
+						Prefix: aws.String(prefix),
+						Tags:   tags,
+					},
+				}
+			case len(tags) == 1:
+				apiObject.Filter = &s3.LifecycleRuleFilter{Tag: tags[0]}
+			default:
+				apiObject.Filter = &s3.LifecycleRuleFilter{Prefix: aws.String(prefix)}
+			}
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(v[0].(map[string]interface{})["noncurrent_days"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.NoncurrentVersionTransitions = append(apiObject.NoncurrentVersionTransitions, &s3.NoncurrentVersionTransition{
+					NoncurrentDays: aws.Int64(int64(tfMap["noncurrent_days"].(int))),
+					StorageClass:   aws.String(tfMap["storage_class"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				transition := &s3.Transition{
+					StorageClass: aws.String(tfMap["storage_class"].(string)),
+				}
+
+				if v, ok := tfMap["date"].(string); ok && v != "" {
+					t, err := expandBucketLifecycleDate(v)
+
+					if err != nil {
+						return nil, err
+					}
+
+					transition.Date = t
+				} else if v, ok := tfMap["days"].(int); ok && v >= 0 {
+					transition.Days = aws.Int64(int64(v))
+				}
+
+				apiObject.Transitions = append(apiObject.Transitions, transition)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func flattenBucketLifecycleRules(apiObjects []*s3.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":     aws.StringValue(apiObject.ID),
+			"status": aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+			tfMap["abort_incomplete_multipart_upload"] = []interface{}{map[string]interface{}{
+				"days_after_initiation": int(aws.Int64Value(v.DaysAfterInitiation)),
+			}}
+		}
+
+		if v := apiObject.Expiration; v != nil {
+			expiration := map[string]interface{}{
+				"days":                         int(aws.Int64Value(v.Days)),
+				"expired_object_delete_marker": aws.BoolValue(v.ExpiredObjectDeleteMarker),
+			}
+
+			if v.Date != nil {
+				expiration["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+			}
+
+			tfMap["expiration"] = []interface{}{expiration}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			filter := map[string]interface{}{}
+
+			switch {
+			case v.And != nil:
+				filter["prefix"] = aws.StringValue(v.And.Prefix)
+				filter["tags"] = KeyValueTags(v.And.Tags).IgnoreAWS().Map()
+			case v.Tag != nil:
+				filter["tags"] = KeyValueTags([]*s3.Tag{v.Tag}).IgnoreAWS().Map()
+			default:
+				filter["prefix"] = aws.StringValue(v.Prefix)
+			}
+
+			// An empty filter is equivalent to no filter.
+			if filter["prefix"] != "" || len(filter) > 1 {
+				tfMap["filter"] = []interface{}{filter}
+			}
+		}
+
+		if v := apiObject.NoncurrentVersionExpiration; v != nil {
+			tfMap["noncurrent_version_expiration"] = []interface{}{map[string]interface{}{
+				"noncurrent_days": int(aws.Int64Value(v.NoncurrentDays)),
+			}}
+		}
+
+		if len(apiObject.NoncurrentVersionTransitions) > 0 {
+			var transitions []interface{}
+
+			for _, v := range apiObject.NoncurrentVersionTransitions {
+				transitions = append(transitions, map[string]interface{}{
+					"noncurrent_days": int(aws.Int64Value(v.NoncurrentDays)),
+					"storage_class":   aws.StringValue(v.StorageClass),
+				})
+			}
+
+			tfMap["noncurrent_version_transition"] = transitions
+		}
+
+		if len(apiObject.Transitions) > 0 {
+			var transitions []interface{}
+
+			for _, v := range apiObject.Transitions {
+				transition := map[string]interface{}{
+					"days":          int(aws.Int64Value(v.Days)),
+					"storage_class": aws.StringValue(v.StorageClass),
+				}
+
+				if v.Date != nil {
+					transition["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+				}
+
+				transitions = append(transitions, transition)
+			}
+
+			tfMap["transition"] = transitions
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
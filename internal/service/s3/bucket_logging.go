@@ -0,0 +1,263 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketLogging() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketLoggingCreate,
+		ReadContext:   resourceBucketLoggingRead,
+		UpdateContext: resourceBucketLoggingUpdate,
+		DeleteContext: resourceBucketLoggingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_grant": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"email_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+									},
+									"uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.BucketLogsPermission_Values(), false),
+						},
+					},
+				},
+			},
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceBucketLoggingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketLoggingUpdate(ctx, d, meta)
+}
+
+func resourceBucketLoggingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var loggingEnabled *s3.LoggingEnabled
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		loggingEnabled, err = FindBucketLogging(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket Logging (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", loggingEnabled.TargetBucket)
+	d.Set("target_prefix", loggingEnabled.TargetPrefix)
+
+	if err := d.Set("target_grant", flattenBucketLoggingTargetGrants(loggingEnabled.TargetGrants)); err != nil {
+		return diag.Errorf("error setting target_grant: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLoggingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	loggingEnabled := &s3.LoggingEnabled{
+		TargetBucket: aws.String(d.Get("target_bucket").(string)),
+		TargetPrefix: aws.String(d.Get("target_prefix").(string)),
+	}
+
+	if v, ok := d.GetOk("target_grant"); ok && v.(*schema.Set).Len() > 0 {
+		loggingEnabled.TargetGrants = expandBucketLoggingTargetGrants(v.(*schema.Set).List())
+	}
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: loggingEnabled,
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Logging: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketLoggingWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) Logging: %s", d.Id(), err)
+	}
+
+	return resourceBucketLoggingRead(ctx, d, meta)
+}
+
+func resourceBucketLoggingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// An empty logging status disables logging.
+	log.Printf("[DEBUG] Deleting S3 Bucket Logging: %s", d.Id())
+	_, err := conn.PutBucketLoggingWithContext(ctx, &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Logging: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketLoggingTargetGrants(tfList []interface{}) []*s3.TargetGrant {
+	var apiObjects []*s3.TargetGrant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.TargetGrant{}
+
+		if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Grantee = expandBucketGrantee(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["permission"].(string); ok && v != "" {
+			apiObject.Permission = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBucketGrantee(tfMap map[string]interface{}) *s3.Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Grantee{}
+
+	if v, ok := tfMap["email_address"].(string); ok && v != "" {
+		apiObject.EmailAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["uri"].(string); ok && v != "" {
+		apiObject.URI = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBucketLoggingTargetGrants(apiObjects []*s3.TargetGrant) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"permission": aws.StringValue(apiObject.Permission),
+		}
+
+		if v := apiObject.Grantee; v != nil {
+			tfMap["grantee"] = []interface{}{flattenBucketGrantee(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBucketGrantee(apiObject *s3.Grantee) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"email_address": aws.StringValue(apiObject.EmailAddress),
+		"id":            aws.StringValue(apiObject.ID),
+		"type":          aws.StringValue(apiObject.Type),
+		"uri":           aws.StringValue(apiObject.URI),
+	}
+}
@@ -61,7 +61,7 @@ func ResourceBucketMetric() *schema.Resource {
 }
 
 func resourceBucketMetricPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 
@@ -83,7 +83,7 @@ func resourceBucketMetricPut(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Putting S3 Bucket Metrics Configuration: %s", input)
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() *resource.RetryError {
 		_, err := conn.PutBucketMetricsConfiguration(input)
 
 		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
@@ -111,7 +111,7 @@ func resourceBucketMetricPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBucketMetricDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket, name, err := BucketMetricParseID(d.Id())
 	if err != nil {
@@ -142,7 +142,7 @@ func resourceBucketMetricDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketMetricRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket, name, err := BucketMetricParseID(d.Id())
 	if err != nil {
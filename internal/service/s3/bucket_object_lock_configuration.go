@@ -0,0 +1,187 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketObjectLockConfigurationCreate,
+		ReadContext:   resourceBucketObjectLockConfigurationRead,
+		UpdateContext: resourceBucketObjectLockConfigurationUpdate,
+		DeleteContext: resourceBucketObjectLockConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"object_lock_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.ObjectLockEnabledEnabled,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockEnabled_Values(), false),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_retention": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:          schema.TypeInt,
+										Optional:      true,
+										ConflictsWith: []string{"rule.0.default_retention.0.years"},
+										ValidateFunc:  validation.IntAtLeast(1),
+									},
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
+									},
+									"years": {
+										Type:          schema.TypeInt,
+										Optional:      true,
+										ConflictsWith: []string{"rule.0.default_retention.0.days"},
+										ValidateFunc:  validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceBucketObjectLockConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketObjectLockConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceBucketObjectLockConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var output *s3.ObjectLockConfiguration
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindObjectLockConfiguration(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Object Lock Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket Object Lock Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("object_lock_enabled", output.ObjectLockEnabled)
+
+	var rule []interface{}
+
+	if tfList := flattenS3ObjectLockConfiguration(output); len(tfList) > 0 {
+		rule, _ = tfList[0].(map[string]interface{})["rule"].([]interface{})
+	}
+
+	if err := d.Set("rule", rule); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBucketObjectLockConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		ObjectLockConfiguration: expandS3ObjectLockConfiguration([]interface{}{
+			map[string]interface{}{
+				"object_lock_enabled": d.Get("object_lock_enabled"),
+				"rule":                d.Get("rule"),
+			},
+		}),
+	}
+
+	if v, ok := d.GetOk("token"); ok {
+		input.Token = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Object Lock Configuration: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutObjectLockConfigurationWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) Object Lock Configuration: %s", d.Id(), err)
+	}
+
+	return resourceBucketObjectLockConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketObjectLockConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// Object Lock cannot be disabled once enabled, only the default retention rule can be removed.
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("token"); ok {
+		input.Token = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Object Lock Configuration: %s", d.Id())
+	_, err := conn.PutObjectLockConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Object Lock Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -50,7 +50,7 @@ func ResourceBucketOwnershipControls() *schema.Resource {
 }
 
 func resourceBucketOwnershipControlsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -73,7 +73,7 @@ func resourceBucketOwnershipControlsCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceBucketOwnershipControlsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	input := &s3.GetBucketOwnershipControlsInput{
 		Bucket: aws.String(d.Id()),
@@ -87,7 +87,7 @@ func resourceBucketOwnershipControlsRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "OwnershipControlsNotFoundError") {
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeOwnershipControlsNotFound) {
 		log.Printf("[WARN] S3 Bucket Ownership Controls (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -115,7 +115,7 @@ func resourceBucketOwnershipControlsRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceBucketOwnershipControlsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	input := &s3.PutBucketOwnershipControlsInput{
 		Bucket: aws.String(d.Id()),
@@ -134,7 +134,7 @@ func resourceBucketOwnershipControlsUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceBucketOwnershipControlsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	input := &s3.DeleteBucketOwnershipControlsInput{
 		Bucket: aws.String(d.Id()),
@@ -146,7 +146,7 @@ func resourceBucketOwnershipControlsDelete(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
-	if tfawserr.ErrCodeEquals(err, "OwnershipControlsNotFoundError") {
+	if tfawserr.ErrCodeEquals(err, ErrCodeOwnershipControlsNotFound) {
 		return nil
 	}
 
@@ -0,0 +1,605 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketReplicationConfigurationCreate,
+		ReadContext:   resourceBucketReplicationConfigurationRead,
+		UpdateContext: resourceBucketReplicationConfigurationUpdate,
+		DeleteContext: resourceBucketReplicationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceBucketReplicationConfigurationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_marker_replication": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.DeleteMarkerReplicationStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_translation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"owner": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.OwnerOverride_Values(), false),
+												},
+											},
+										},
+									},
+									"account": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"replica_kms_key_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"metrics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      15,
+													ValidateFunc: validation.IntBetween(10, 15),
+												},
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.MetricsStatus_Values(), false),
+												},
+											},
+										},
+									},
+									"replication_time": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      15,
+													ValidateFunc: validation.IntBetween(15, 15),
+												},
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.ReplicationTimeStatus_Values(), false),
+												},
+											},
+										},
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(s3.StorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"tags": tftags.TagsSchema(),
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"source_selection_criteria": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"replica_modifications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.ReplicaModificationsStatus_Values(), false),
+												},
+											},
+										},
+									},
+									"sse_kms_encrypted_objects": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.SseKmsEncryptedObjectsStatus_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ReplicationRuleStatus_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketReplicationConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceBucketReplicationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var output *s3.ReplicationConfiguration
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindBucketReplicationConfiguration(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket Replication Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("role", output.Role)
+
+	if err := d.Set("rule", flattenBucketReplicationRules(output.Rules)); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+// resourceBucketReplicationConfigurationCustomizeDiff checks at plan time, where possible, that the provider configuration
+// allows the regions of the destination buckets. The regions are checked again when the configuration is applied.
+func resourceBucketReplicationConfigurationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("rule") {
+		return nil
+	}
+
+	var bucketARNs []string
+
+	for _, v := range diff.Get("rule").([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		dest, ok := tfMap["destination"].([]interface{})
+
+		if !ok || len(dest) == 0 || dest[0] == nil {
+			continue
+		}
+
+		bucketARNs = append(bucketARNs, dest[0].(map[string]interface{})["bucket"].(string))
+	}
+
+	return validateBucketReplicationDestinationRegionsDiff(ctx, meta.(*conns.AWSClient), bucketARNs)
+}
+
+func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	rules := expandBucketReplicationRules(d.Get("rule").([]interface{}))
+
+	for _, rule := range rules {
+		if rule.Destination == nil {
+			continue
+		}
+
+		if err := validateBucketReplicationDestinationRegion(ctx, meta.(*conns.AWSClient), conn, aws.StringValue(rule.Destination.Bucket)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+		ReplicationConfiguration: &s3.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: rules,
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Replication Configuration: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketReplicationWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) Replication Configuration: %s", d.Id(), err)
+	}
+
+	return resourceBucketReplicationConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Replication Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketReplicationWithContext(ctx, &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Replication Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketReplicationRules(tfList []interface{}) []*s3.ReplicationRule {
+	var apiObjects []*s3.ReplicationRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.ReplicationRule{}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Destination = expandBucketReplicationDestination(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_selection_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceSelectionCriteria = expandBucketReplicationSourceSelectionCriteria(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		// A rule with a filter uses the current (V2) replication configuration schema,
+		// which requires a priority and a delete marker replication status.
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			filter := &s3.ReplicationRuleFilter{}
+
+			if v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				prefix, _ := tfMap["prefix"].(string)
+				tags := Tags(tftags.New(tfMap["tags"]).IgnoreAWS())
+
+				switch {
+				case len(tags) > 1 || (len(tags) == 1 && prefix != ""):
+					filter.And = &s3.ReplicationRuleAndOperator{
+						Prefix: aws.String(prefix),
+						Tags:   tags,
+					}
+				case len(tags) == 1:
+					filter.Tag = tags[0]
+				default:
+					filter.Prefix = aws.String(prefix)
+				}
+			}
+
+			apiObject.Filter = filter
+			apiObject.Priority = aws.Int64(int64(tfMap["priority"].(int)))
+			apiObject.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+				Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+			}
+
+			if v, ok := tfMap["delete_marker_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.DeleteMarkerReplication.Status = aws.String(v[0].(map[string]interface{})["status"].(string))
+			}
+		} else {
+			apiObject.Prefix = aws.String(tfMap["prefix"].(string))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBucketReplicationDestination(tfMap map[string]interface{}) *s3.Destination {
+	apiObject := &s3.Destination{}
+
+	if v, ok := tfMap["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AccessControlTranslation = &s3.AccessControlTranslation{
+			Owner: aws.String(v[0].(map[string]interface{})["owner"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["account"].(string); ok && v != "" {
+		apiObject.Account = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EncryptionConfiguration = &s3.EncryptionConfiguration{
+			ReplicaKmsKeyID: aws.String(v[0].(map[string]interface{})["replica_kms_key_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Metrics = &s3.Metrics{
+			EventThreshold: &s3.ReplicationTimeValue{
+				Minutes: aws.Int64(int64(tfMap["minutes"].(int))),
+			},
+			Status: aws.String(tfMap["status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["replication_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ReplicationTime = &s3.ReplicationTime{
+			Status: aws.String(tfMap["status"].(string)),
+			Time: &s3.ReplicationTimeValue{
+				Minutes: aws.Int64(int64(tfMap["minutes"].(int))),
+			},
+		}
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandBucketReplicationSourceSelectionCriteria(tfMap map[string]interface{}) *s3.SourceSelectionCriteria {
+	apiObject := &s3.SourceSelectionCriteria{}
+
+	if v, ok := tfMap["replica_modifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReplicaModifications = &s3.ReplicaModifications{
+			Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sse_kms_encrypted_objects"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SseKmsEncryptedObjects = &s3.SseKmsEncryptedObjects{
+			Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenBucketReplicationRules(apiObjects []*s3.ReplicationRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":       aws.StringValue(apiObject.ID),
+			"prefix":   aws.StringValue(apiObject.Prefix),
+			"priority": int(aws.Int64Value(apiObject.Priority)),
+			"status":   aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.DeleteMarkerReplication; v != nil {
+			tfMap["delete_marker_replication"] = []interface{}{map[string]interface{}{
+				"status": aws.StringValue(v.Status),
+			}}
+		}
+
+		if v := apiObject.Destination; v != nil {
+			tfMap["destination"] = []interface{}{flattenBucketReplicationDestination(v)}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			filter := map[string]interface{}{}
+
+			switch {
+			case v.And != nil:
+				filter["prefix"] = aws.StringValue(v.And.Prefix)
+				filter["tags"] = KeyValueTags(v.And.Tags).IgnoreAWS().Map()
+			case v.Tag != nil:
+				filter["tags"] = KeyValueTags([]*s3.Tag{v.Tag}).IgnoreAWS().Map()
+			default:
+				filter["prefix"] = aws.StringValue(v.Prefix)
+			}
+
+			tfMap["filter"] = []interface{}{filter}
+		}
+
+		if v := apiObject.SourceSelectionCriteria; v != nil {
+			criteria := map[string]interface{}{}
+
+			if v := v.ReplicaModifications; v != nil {
+				criteria["replica_modifications"] = []interface{}{map[string]interface{}{
+					"status": aws.StringValue(v.Status),
+				}}
+			}
+
+			if v := v.SseKmsEncryptedObjects; v != nil {
+				criteria["sse_kms_encrypted_objects"] = []interface{}{map[string]interface{}{
+					"status": aws.StringValue(v.Status),
+				}}
+			}
+
+			tfMap["source_selection_criteria"] = []interface{}{criteria}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBucketReplicationDestination(apiObject *s3.Destination) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"account":       aws.StringValue(apiObject.Account),
+		"bucket":        aws.StringValue(apiObject.Bucket),
+		"storage_class": aws.StringValue(apiObject.StorageClass),
+	}
+
+	if v := apiObject.AccessControlTranslation; v != nil {
+		tfMap["access_control_translation"] = []interface{}{map[string]interface{}{
+			"owner": aws.StringValue(v.Owner),
+		}}
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"replica_kms_key_id": aws.StringValue(v.ReplicaKmsKeyID),
+		}}
+	}
+
+	if v := apiObject.Metrics; v != nil {
+		metrics := map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}
+
+		if v.EventThreshold != nil {
+			metrics["minutes"] = int(aws.Int64Value(v.EventThreshold.Minutes))
+		}
+
+		tfMap["metrics"] = []interface{}{metrics}
+	}
+
+	if v := apiObject.ReplicationTime; v != nil {
+		replicationTime := map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}
+
+		if v.Time != nil {
+			replicationTime["minutes"] = int(aws.Int64Value(v.Time.Minutes))
+		}
+
+		tfMap["replication_time"] = []interface{}{replicationTime}
+	}
+
+	return tfMap
+}
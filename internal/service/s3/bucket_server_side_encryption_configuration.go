@@ -0,0 +1,209 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketServerSideEncryptionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketServerSideEncryptionConfigurationCreate,
+		ReadContext:   resourceBucketServerSideEncryptionConfigurationRead,
+		UpdateContext: resourceBucketServerSideEncryptionConfigurationUpdate,
+		DeleteContext: resourceBucketServerSideEncryptionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_server_side_encryption_by_default": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_master_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sse_algorithm": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+									},
+								},
+							},
+						},
+						"bucket_key_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketServerSideEncryptionConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketServerSideEncryptionConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceBucketServerSideEncryptionConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var output *s3.ServerSideEncryptionConfiguration
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindBucketServerSideEncryptionConfiguration(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Server-side Encryption Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket Server-side Encryption Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenBucketServerSideEncryptionRules(output.Rules)); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBucketServerSideEncryptionConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: expandBucketServerSideEncryptionRules(d.Get("rule").(*schema.Set).List()),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Server-side Encryption Configuration: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketEncryptionWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeOperationAborted)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) Server-side Encryption Configuration: %s", d.Id(), err)
+	}
+
+	return resourceBucketServerSideEncryptionConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketServerSideEncryptionConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Server-side Encryption Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketEncryptionWithContext(ctx, &s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Server-side Encryption Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketServerSideEncryptionRules(tfList []interface{}) []*s3.ServerSideEncryptionRule {
+	var apiObjects []*s3.ServerSideEncryptionRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.ServerSideEncryptionRule{}
+
+		if v, ok := tfMap["apply_server_side_encryption_by_default"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ApplyServerSideEncryptionByDefault = &s3.ServerSideEncryptionByDefault{}
+
+			if v, ok := tfMap["kms_master_key_id"].(string); ok && v != "" {
+				apiObject.ApplyServerSideEncryptionByDefault.KMSMasterKeyID = aws.String(v)
+			}
+
+			if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+				apiObject.ApplyServerSideEncryptionByDefault.SSEAlgorithm = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
+			apiObject.BucketKeyEnabled = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBucketServerSideEncryptionRules(apiObjects []*s3.ServerSideEncryptionRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"bucket_key_enabled": aws.BoolValue(apiObject.BucketKeyEnabled),
+		}
+
+		if v := apiObject.ApplyServerSideEncryptionByDefault; v != nil {
+			tfMap["apply_server_side_encryption_by_default"] = []interface{}{
+				map[string]interface{}{
+					"kms_master_key_id": aws.StringValue(v.KMSMasterKeyID),
+					"sse_algorithm":     aws.StringValue(v.SSEAlgorithm),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
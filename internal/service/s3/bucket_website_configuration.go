@@ -0,0 +1,395 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketWebsiteConfigurationCreate,
+		ReadContext:   resourceBucketWebsiteConfigurationRead,
+		UpdateContext: resourceBucketWebsiteConfigurationUpdate,
+		DeleteContext: resourceBucketWebsiteConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"error_document": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"index_document": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"index_document", "redirect_all_requests_to"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suffix": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"redirect_all_requests_to": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"index_document", "redirect_all_requests_to"},
+				ConflictsWith: []string{
+					"error_document",
+					"routing_rule",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(s3.Protocol_Values(), false),
+						},
+					},
+				},
+			},
+			"routing_rule": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_error_code_returned_equals": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"key_prefix_equals": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"redirect": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"http_redirect_code": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(s3.Protocol_Values(), false),
+									},
+									"replace_key_prefix_with": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"replace_key_with": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceBucketWebsiteConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var output *s3.GetBucketWebsiteOutput
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindBucketWebsite(ctx, conn, d.Id())
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Bucket Website Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	var errorDocument, indexDocument, redirectAllRequestsTo []interface{}
+
+	if v := output.ErrorDocument; v != nil {
+		errorDocument = []interface{}{map[string]interface{}{"key": aws.StringValue(v.Key)}}
+	}
+
+	if v := output.IndexDocument; v != nil {
+		indexDocument = []interface{}{map[string]interface{}{"suffix": aws.StringValue(v.Suffix)}}
+	}
+
+	if v := output.RedirectAllRequestsTo; v != nil {
+		redirectAllRequestsTo = []interface{}{map[string]interface{}{
+			"host_name": aws.StringValue(v.HostName),
+			"protocol":  aws.StringValue(v.Protocol),
+		}}
+	}
+
+	if err := d.Set("error_document", errorDocument); err != nil {
+		return diag.Errorf("error setting error_document: %s", err)
+	}
+
+	if err := d.Set("index_document", indexDocument); err != nil {
+		return diag.Errorf("error setting index_document: %s", err)
+	}
+
+	if err := d.Set("redirect_all_requests_to", redirectAllRequestsTo); err != nil {
+		return diag.Errorf("error setting redirect_all_requests_to: %s", err)
+	}
+
+	if err := d.Set("routing_rule", flattenBucketWebsiteRoutingRules(output.RoutingRules)); err != nil {
+		return diag.Errorf("error setting routing_rule: %s", err)
+	}
+
+	location, err := conn.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return diag.Errorf("error getting S3 Bucket (%s) location: %s", d.Id(), err)
+	}
+
+	website := WebsiteEndpoint(meta.(*conns.AWSClient), d.Id(), aws.StringValue(location.LocationConstraint))
+	d.Set("website_domain", website.Domain)
+	d.Set("website_endpoint", website.Endpoint)
+
+	return nil
+}
+
+func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	websiteConfiguration := &s3.WebsiteConfiguration{}
+
+	if v, ok := d.GetOk("error_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		websiteConfiguration.ErrorDocument = &s3.ErrorDocument{
+			Key: aws.String(v.([]interface{})[0].(map[string]interface{})["key"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("index_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		websiteConfiguration.IndexDocument = &s3.IndexDocument{
+			Suffix: aws.String(v.([]interface{})[0].(map[string]interface{})["suffix"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("redirect_all_requests_to"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		websiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(tfMap["host_name"].(string)),
+		}
+
+		if v, ok := tfMap["protocol"].(string); ok && v != "" {
+			websiteConfiguration.RedirectAllRequestsTo.Protocol = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk("routing_rule"); ok && len(v.([]interface{})) > 0 {
+		websiteConfiguration.RoutingRules = expandBucketWebsiteRoutingRules(v.([]interface{}))
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(d.Id()),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Website Configuration: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Timeout, func() (interface{}, error) {
+		return conn.PutBucketWebsiteWithContext(ctx, input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return diag.Errorf("error putting S3 Bucket (%s) Website Configuration: %s", d.Id(), err)
+	}
+
+	return resourceBucketWebsiteConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Website Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketWebsiteWithContext(ctx, &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchWebsiteConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Website Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketWebsiteRoutingRules(tfList []interface{}) []*s3.RoutingRule {
+	var apiObjects []*s3.RoutingRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.RoutingRule{}
+
+		if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Condition = &s3.Condition{}
+
+			if v, ok := tfMap["http_error_code_returned_equals"].(string); ok && v != "" {
+				apiObject.Condition.HttpErrorCodeReturnedEquals = aws.String(v)
+			}
+
+			if v, ok := tfMap["key_prefix_equals"].(string); ok && v != "" {
+				apiObject.Condition.KeyPrefixEquals = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["redirect"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Redirect = &s3.Redirect{}
+
+			if v, ok := tfMap["host_name"].(string); ok && v != "" {
+				apiObject.Redirect.HostName = aws.String(v)
+			}
+
+			if v, ok := tfMap["http_redirect_code"].(string); ok && v != "" {
+				apiObject.Redirect.HttpRedirectCode = aws.String(v)
+			}
+
+			if v, ok := tfMap["protocol"].(string); ok && v != "" {
+				apiObject.Redirect.Protocol = aws.String(v)
+			}
+
+			if v, ok := tfMap["replace_key_prefix_with"].(string); ok && v != "" {
+				apiObject.Redirect.ReplaceKeyPrefixWith = aws.String(v)
+			}
+
+			if v, ok := tfMap["replace_key_with"].(string); ok && v != "" {
+				apiObject.Redirect.ReplaceKeyWith = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBucketWebsiteRoutingRules(apiObjects []*s3.RoutingRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Condition; v != nil {
+			tfMap["condition"] = []interface{}{
+				map[string]interface{}{
+					"http_error_code_returned_equals": aws.StringValue(v.HttpErrorCodeReturnedEquals),
+					"key_prefix_equals":               aws.StringValue(v.KeyPrefixEquals),
+				},
+			}
+		}
+
+		if v := apiObject.Redirect; v != nil {
+			tfMap["redirect"] = []interface{}{
+				map[string]interface{}{
+					"host_name":               aws.StringValue(v.HostName),
+					"http_redirect_code":      aws.StringValue(v.HttpRedirectCode),
+					"protocol":                aws.StringValue(v.Protocol),
+					"replace_key_prefix_with": aws.StringValue(v.ReplaceKeyPrefixWith),
+					"replace_key_with":        aws.StringValue(v.ReplaceKeyWith),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,1076 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+// directorySyncDeleteBatchSize is the maximum number of keys in a DeleteObjects request.
+const directorySyncDeleteBatchSize = 1000
+
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectorySyncCreate,
+		ReadContext:   resourceDirectorySyncRead,
+		UpdateContext: resourceDirectorySyncUpdate,
+		DeleteContext: resourceDirectorySyncDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDirectorySyncImport,
+		},
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"delete_unmanaged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDirectorySyncGlob,
+				},
+			},
+			"file_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateObjectMetadata,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDirectorySyncGlob,
+						},
+					},
+				},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDirectorySyncGlob,
+				},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`/$`), "must end with a slash (/)"),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadPartSize,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+			"unmanaged_paths": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id := DirectorySyncCreateResourceID(bucket, keyPrefix)
+
+	d.SetId(id)
+
+	if err := resourceDirectorySyncApply(ctx, d, meta, map[string]string{}, true); err != nil {
+		return diag.Errorf("error syncing S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket, keyPrefix, err := DirectorySyncParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	manifest := aws.StringValueMap(flex.ExpandStringMap(d.Get("manifest").(map[string]interface{})))
+	policy := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3)
+	isNewResource := d.IsNewResource()
+	id := d.Id()
+
+	var mu sync.Mutex
+	var g multierror.Group
+	limiter := make(chan struct{}, d.Get("upload_concurrency").(int))
+
+	for _, path := range directorySyncSortedPaths(manifest) {
+		path := path
+		key := keyPrefix + path
+
+		limiter <- struct{}{}
+
+		g.Go(func() error {
+			defer func() { <-limiter }()
+
+			var output *s3.HeadObjectOutput
+
+			err := policy.Read(ctx, isNewResource, func(ctx context.Context) error {
+				var err error
+
+				output, err = FindObjectByBucketAndKey(ctx, conn, bucket, key)
+
+				return err
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if !isNewResource && tfresource.NotFound(err) {
+				log.Printf("[WARN] S3 Directory Sync (%s) object (%s) not found, removing from manifest", id, key)
+				delete(manifest, path)
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("reading object (%s): %w", key, err)
+			}
+
+			_, checksum := flattenObjectMetadata(output.Metadata)
+			manifest[path] = checksum
+
+			return nil
+		})
+	}
+
+	if err := g.Wait().ErrorOrNil(); err != nil {
+		return diag.Errorf("error reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("key_prefix", keyPrefix)
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return diag.Errorf("error setting manifest: %s", err)
+	}
+
+	var unmanaged []string
+
+	for _, path := range aws.StringValueSlice(flex.ExpandStringSet(d.Get("unmanaged_paths").(*schema.Set))) {
+		if _, ok := manifest[path]; ok {
+			unmanaged = append(unmanaged, path)
+		}
+	}
+
+	if err := d.Set("unmanaged_paths", unmanaged); err != nil {
+		return diag.Errorf("error setting unmanaged_paths: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, _ := d.GetChange("manifest")
+	manifest := aws.StringValueMap(flex.ExpandStringMap(o.(map[string]interface{})))
+
+	// Changes to the object settings apply to all files, not just those whose content has changed.
+	force := d.HasChanges("acl", "file_rule", "kms_key_id", "server_side_encryption", "storage_class")
+
+	if err := resourceDirectorySyncApply(ctx, d, meta, manifest, force); err != nil {
+		return diag.Errorf("error syncing S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket, keyPrefix, err := DirectorySyncParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	manifest := aws.StringValueMap(flex.ExpandStringMap(d.Get("manifest").(map[string]interface{})))
+	paths := directorySyncSortedPaths(manifest)
+
+	if !d.Get("delete_unmanaged").(bool) {
+		var unmanaged []string
+
+		paths, unmanaged = directorySyncPartitionUnmanagedPaths(paths, d.Get("unmanaged_paths").(*schema.Set))
+
+		for _, path := range unmanaged {
+			log.Printf("[WARN] S3 Directory Sync (%s) not deleting object (%s) adopted on import, set delete_unmanaged to delete it", d.Id(), keyPrefix+path)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync: %s", d.Id())
+	_, err = deleteDirectorySyncObjects(ctx, conn, bucket, keyPrefix, paths, d.Get("upload_concurrency").(int))
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceDirectorySyncImport adopts the objects under the key prefix that were uploaded by
+// Terraform, i.e. that carry a content checksum in their metadata. The key prefix must end
+// with a slash (/) so that objects under other prefixes sharing the same leading characters,
+// or in the rest of the bucket, are never adopted.
+func resourceDirectorySyncImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket, keyPrefix, err := DirectorySyncParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(keyPrefix, "/") {
+		return nil, fmt.Errorf("unexpected format for ID (%s), KEY-PREFIX must end with a slash (/) to import", d.Id())
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(keyPrefix),
+	}
+	var keys []string
+
+	err = conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			key := aws.StringValue(v.Key)
+
+			if strings.HasSuffix(key, "/") {
+				continue
+			}
+
+			keys = append(keys, key)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing S3 Bucket (%s) objects with prefix (%s): %w", bucket, keyPrefix, err)
+	}
+
+	manifest := make(map[string]string)
+	var mu sync.Mutex
+	var g multierror.Group
+	limiter := make(chan struct{}, s3manager.DefaultUploadConcurrency)
+
+	for _, key := range keys {
+		key := key
+
+		limiter <- struct{}{}
+
+		g.Go(func() error {
+			defer func() { <-limiter }()
+
+			output, err := FindObjectByBucketAndKey(ctx, conn, bucket, key)
+
+			if tfresource.NotFound(err) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("reading object (%s): %w", key, err)
+			}
+
+			_, checksum := flattenObjectMetadata(output.Metadata)
+
+			if checksum == "" {
+				log.Printf("[DEBUG] S3 Directory Sync (%s) not adopting object (%s) without checksum metadata", d.Id(), key)
+				return nil
+			}
+
+			mu.Lock()
+			manifest[strings.TrimPrefix(key, keyPrefix)] = checksum
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := g.Wait().ErrorOrNil(); err != nil {
+		return nil, fmt.Errorf("error reading S3 Bucket (%s) objects with prefix (%s): %w", bucket, keyPrefix, err)
+	}
+
+	d.Set("acl", s3.ObjectCannedACLPrivate)
+	d.Set("delete_unmanaged", false)
+	d.Set("manifest", manifest)
+	d.Set("part_size", s3manager.DefaultUploadPartSize)
+	d.Set("unmanaged_paths", directorySyncSortedPaths(manifest))
+	d.Set("upload_concurrency", s3manager.DefaultUploadConcurrency)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceDirectorySyncCustomizeDiff plans the manifest of the source directory so
+// that content changes to local files are shown as changes to the resource.
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("include") || !d.NewValueKnown("exclude") {
+		return d.SetNewComputed("manifest")
+	}
+
+	paths, err := directorySyncSourcePaths(d.Get("source_dir").(string), d.Get("include").(*schema.Set).List(), d.Get("exclude").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	manifest, err := directorySyncManifest(d.Get("source_dir").(string), paths)
+
+	if err != nil {
+		return err
+	}
+
+	o := aws.StringValueMap(flex.ExpandStringMap(d.Get("manifest").(map[string]interface{})))
+	changed := len(directorySyncChangedPaths(o, manifest, false)) > 0 || len(directorySyncRemovedPaths(o, manifest)) > 0
+
+	if changed {
+		if err := d.SetNew("manifest", manifest); err != nil {
+			return err
+		}
+	}
+
+	// Objects adopted on import become managed once uploaded, and are released if their files no longer exist.
+	if d.Get("unmanaged_paths").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	if changed || d.HasChange("acl") || d.HasChange("file_rule") || d.HasChange("kms_key_id") || d.HasChange("server_side_encryption") || d.HasChange("storage_class") {
+		return d.SetNewComputed("unmanaged_paths")
+	}
+
+	return nil
+}
+
+// resourceDirectorySyncApply uploads the files in the source directory whose content
+// differs from the specified manifest, or all files if force is set, and deletes
+// the objects of files in the manifest that no longer exist in the source directory.
+// Objects adopted on import and never uploaded are not deleted unless delete_unmanaged
+// is set; they are instead released from the manifest.
+// The manifest of the objects actually synced is recorded in state, even on error.
+func resourceDirectorySyncApply(ctx context.Context, d *schema.ResourceData, meta interface{}, manifest map[string]string, force bool) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	concurrency := d.Get("upload_concurrency").(int)
+
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	rules, err := expandDirectorySyncFileRules(d.Get("file_rule").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	paths, err := directorySyncSourcePaths(sourceDir, d.Get("include").(*schema.Set).List(), d.Get("exclude").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	desired, err := directorySyncManifest(sourceDir, paths)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("unmanaged_paths")
+	unmanaged := make(map[string]bool)
+
+	for _, path := range aws.StringValueSlice(flex.ExpandStringSet(o.(*schema.Set))) {
+		unmanaged[path] = true
+	}
+
+	uploads := directorySyncChangedPaths(manifest, desired, force)
+	deletes := directorySyncRemovedPaths(manifest, desired)
+
+	if !d.Get("delete_unmanaged").(bool) {
+		var released []string
+
+		deletes, released = directorySyncPartitionUnmanagedPaths(deletes, o.(*schema.Set))
+
+		for _, path := range released {
+			log.Printf("[WARN] S3 Directory Sync (%s) not deleting object (%s) adopted on import, set delete_unmanaged to delete it", d.Id(), keyPrefix+path)
+			delete(manifest, path)
+			delete(unmanaged, path)
+		}
+	}
+
+	log.Printf("[DEBUG] Syncing S3 Directory Sync (%s): %d files to upload, %d objects to delete", d.Id(), len(uploads), len(deletes))
+
+	// Each file is uploaded in sequential parts so that the total number of
+	// concurrent requests is bounded by upload_concurrency.
+	uploader := newObjectUploader(conn, d.Get("part_size").(int), 1)
+
+	input := s3manager.UploadInput{
+		ACL:    aws.String(d.Get("acl").(string)),
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	var mu sync.Mutex
+	var g multierror.Group
+	limiter := make(chan struct{}, concurrency)
+
+	for _, path := range uploads {
+		path := path
+		key := keyPrefix + path
+
+		limiter <- struct{}{}
+
+		g.Go(func() error {
+			defer func() { <-limiter }()
+
+			checksum, err := uploadDirectorySyncFile(ctx, uploader, input, sourceDir, path, key, rules)
+
+			if err != nil {
+				return fmt.Errorf("uploading %s to object (%s): %w", path, key, err)
+			}
+
+			mu.Lock()
+			manifest[path] = checksum
+			delete(unmanaged, path)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	errs := g.Wait()
+
+	deleted, err := deleteDirectorySyncObjects(ctx, conn, bucket, keyPrefix, deletes, concurrency)
+
+	for _, path := range deleted {
+		delete(manifest, path)
+		delete(unmanaged, path)
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("setting manifest: %w", err))
+	}
+
+	unmanagedPaths := make([]string, 0, len(unmanaged))
+
+	for path := range unmanaged {
+		unmanagedPaths = append(unmanagedPaths, path)
+	}
+
+	if err := d.Set("unmanaged_paths", unmanagedPaths); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("setting unmanaged_paths: %w", err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// uploadDirectorySyncFile uploads a file from the source directory with the object
+// settings of the specified input and of all matching rules, in order, and returns
+// the checksum of its content.
+func uploadDirectorySyncFile(ctx context.Context, uploader *s3manager.Uploader, input s3manager.UploadInput, sourceDir, path, key string, rules []*directorySyncFileRule) (string, error) {
+	file, err := os.Open(filepath.Join(sourceDir, filepath.FromSlash(path)))
+
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 Directory Sync source file (%s): %s", path, err)
+		}
+	}()
+
+	input.Key = aws.String(key)
+	input.Metadata = make(map[string]*string)
+
+	// Later rules override the settings of earlier rules and metadata is merged.
+	for _, rule := range rules {
+		if !rule.pattern.MatchString(path) {
+			continue
+		}
+
+		if rule.cacheControl != "" {
+			input.CacheControl = aws.String(rule.cacheControl)
+		}
+
+		if rule.contentEncoding != "" {
+			input.ContentEncoding = aws.String(rule.contentEncoding)
+		}
+
+		if rule.contentType != "" {
+			input.ContentType = aws.String(rule.contentType)
+		}
+
+		for k, v := range rule.metadata {
+			input.Metadata[k] = aws.String(v)
+		}
+	}
+
+	if input.ContentType == nil {
+		contentType, err := directorySyncContentType(path, file)
+
+		if err != nil {
+			return "", err
+		}
+
+		input.ContentType = aws.String(contentType)
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Object: %s/%s", aws.StringValue(input.Bucket), key)
+	return uploadObject(ctx, uploader, &input, file)
+}
+
+// deleteDirectorySyncObjects deletes the objects of the specified relative paths under
+// the key prefix, up to concurrency batches at a time, and returns the paths deleted.
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.S3, bucket, keyPrefix string, paths []string, concurrency int) ([]string, error) {
+	var deleted []string
+	var mu sync.Mutex
+	var g multierror.Group
+	limiter := make(chan struct{}, concurrency)
+
+	for len(paths) > 0 {
+		n := len(paths)
+
+		if n > directorySyncDeleteBatchSize {
+			n = directorySyncDeleteBatchSize
+		}
+
+		batch := paths[:n]
+		paths = paths[n:]
+
+		limiter <- struct{}{}
+
+		g.Go(func() error {
+			defer func() { <-limiter }()
+
+			input := &s3.DeleteObjectsInput{
+				Bucket: aws.String(bucket),
+				Delete: &s3.Delete{
+					Quiet: aws.Bool(true),
+				},
+			}
+
+			for _, path := range batch {
+				input.Delete.Objects = append(input.Delete.Objects, &s3.ObjectIdentifier{
+					Key: aws.String(keyPrefix + path),
+				})
+			}
+
+			output, err := conn.DeleteObjectsWithContext(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			var errs *multierror.Error
+			failed := make(map[string]bool)
+
+			for _, v := range output.Errors {
+				key := aws.StringValue(v.Key)
+				failed[key] = true
+				errs = multierror.Append(errs, fmt.Errorf("deleting object (%s): %s: %s", key, aws.StringValue(v.Code), aws.StringValue(v.Message)))
+			}
+
+			mu.Lock()
+			for _, path := range batch {
+				if !failed[keyPrefix+path] {
+					deleted = append(deleted, path)
+				}
+			}
+			mu.Unlock()
+
+			return errs.ErrorOrNil()
+		})
+	}
+
+	return deleted, g.Wait().ErrorOrNil()
+}
+
+const directorySyncResourceIDSeparator = ":"
+
+func DirectorySyncCreateResourceID(bucket, keyPrefix string) string {
+	parts := []string{bucket, keyPrefix}
+	id := strings.Join(parts, directorySyncResourceIDSeparator)
+
+	return id
+}
+
+// DirectorySyncParseResourceID splits the ID on the first separator as bucket names
+// cannot contain the separator but key prefixes can. The key prefix may be empty.
+func DirectorySyncParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, directorySyncResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sKEY-PREFIX", id, directorySyncResourceIDSeparator)
+}
+
+type directorySyncFileRule struct {
+	cacheControl    string
+	contentEncoding string
+	contentType     string
+	metadata        map[string]string
+	pattern         *regexp.Regexp
+}
+
+func expandDirectorySyncFileRules(tfList []interface{}) ([]*directorySyncFileRule, error) {
+	var rules []*directorySyncFileRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		pattern, err := directorySyncGlobRegexp(tfMap["pattern"].(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		rule := &directorySyncFileRule{
+			pattern: pattern,
+		}
+
+		if v, ok := tfMap["cache_control"].(string); ok {
+			rule.cacheControl = v
+		}
+
+		if v, ok := tfMap["content_encoding"].(string); ok {
+			rule.contentEncoding = v
+		}
+
+		if v, ok := tfMap["content_type"].(string); ok {
+			rule.contentType = v
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok {
+			rule.metadata = aws.StringValueMap(flex.ExpandStringMap(v))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// directorySyncSourcePaths returns the slash-separated paths, relative to the source
+// directory, of the regular files matching any include glob, or all regular files
+// if there are none, and not matching any exclude glob. Symbolic links to regular
+// files are followed; symbolic links to directories are not.
+func directorySyncSourcePaths(sourceDir string, include, exclude []interface{}) ([]string, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	// The source directory itself may be a symbolic link.
+	root, err = filepath.EvalSymlinks(root)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	includes, err := directorySyncGlobRegexps(include)
+
+	if err != nil {
+		return nil, err
+	}
+
+	excludes, err := directorySyncGlobRegexps(exclude)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		if !entry.Type().IsRegular() {
+			info, err := os.Stat(path)
+
+			if err != nil {
+				return err
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+		}
+
+		rel, err := filepath.Rel(root, path)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if len(includes) > 0 && !directorySyncGlobsMatch(includes, rel) {
+			return nil
+		}
+
+		if directorySyncGlobsMatch(excludes, rel) {
+			return nil
+		}
+
+		paths = append(paths, rel)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// directorySyncManifest returns the hex-encoded SHA-256 checksums of the content of
+// the specified files, keyed by path.
+func directorySyncManifest(sourceDir string, paths []string) (map[string]string, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	manifest := make(map[string]string, len(paths))
+
+	for _, path := range paths {
+		checksum, err := objectChecksum(filepath.Join(root, filepath.FromSlash(path)), "", "")
+
+		if err != nil {
+			return nil, err
+		}
+
+		manifest[path] = checksum
+	}
+
+	return manifest, nil
+}
+
+// directorySyncChangedPaths returns the paths in the new manifest that are missing
+// from, or have a different checksum in, the old manifest, or all paths if force is set.
+func directorySyncChangedPaths(o, n map[string]string, force bool) []string {
+	var paths []string
+
+	for path, checksum := range n {
+		if v, ok := o[path]; force || !ok || v != checksum {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+// directorySyncPartitionUnmanagedPaths splits the specified relative paths into those of
+// objects uploaded by the resource and those of objects adopted on import and never uploaded.
+func directorySyncPartitionUnmanagedPaths(paths []string, unmanaged *schema.Set) ([]string, []string) {
+	var managedPaths, unmanagedPaths []string
+
+	for _, path := range paths {
+		if unmanaged.Contains(path) {
+			unmanagedPaths = append(unmanagedPaths, path)
+		} else {
+			managedPaths = append(managedPaths, path)
+		}
+	}
+
+	return managedPaths, unmanagedPaths
+}
+
+// directorySyncRemovedPaths returns the paths in the old manifest that are missing from the new manifest.
+func directorySyncRemovedPaths(o, n map[string]string) []string {
+	var paths []string
+
+	for path := range o {
+		if _, ok := n[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func directorySyncSortedPaths(manifest map[string]string) []string {
+	paths := make([]string, 0, len(manifest))
+
+	for path := range manifest {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+// directorySyncContentType detects the content type of a file from its extension or,
+// failing that, from the first 512 bytes of its content.
+func directorySyncContentType(path string, r io.ReadSeeker) (string, error) {
+	if v := mime.TypeByExtension(filepath.Ext(path)); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(r, buf)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+func directorySyncGlobRegexps(globs []interface{}) ([]*regexp.Regexp, error) {
+	var regexps []*regexp.Regexp
+
+	for _, v := range globs {
+		re, err := directorySyncGlobRegexp(v.(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		regexps = append(regexps, re)
+	}
+
+	return regexps, nil
+}
+
+func directorySyncGlobsMatch(regexps []*regexp.Regexp, path string) bool {
+	for _, re := range regexps {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directorySyncGlobRegexp compiles a glob matched against slash-separated relative paths.
+// "*" matches any sequence of characters other than "/", "?" matches any single character
+// other than "/", "[...]" and "[!...]" match character classes and "**" matches any
+// sequence of characters including "/". "**/" also matches zero directories.
+func directorySyncGlobRegexp(glob string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	sb.WriteString("^")
+
+	// Iterate over runes so that multi-byte characters are quoted whole.
+	runes := []rune(glob)
+
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				i++
+
+				if i+1 < len(runes) && runes[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			j := -1
+
+			for k, r := range runes[i+1:] {
+				if r == ']' {
+					j = k
+					break
+				}
+			}
+
+			if j < 0 {
+				return nil, fmt.Errorf("invalid glob (%s): unterminated character class", glob)
+			}
+
+			class := string(runes[i+1 : i+1+j])
+			i += j + 1
+
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			if class == "" || class == "^" {
+				return nil, fmt.Errorf("invalid glob (%s): empty character class", glob)
+			}
+
+			sb.WriteString("[")
+			sb.WriteString(strings.NewReplacer(`\`, `\\`, `[`, `\[`).Replace(class))
+			sb.WriteString("]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob (%s): %w", glob, err)
+	}
+
+	return re, nil
+}
+
+func validateDirectorySyncGlob(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := directorySyncGlobRegexp(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
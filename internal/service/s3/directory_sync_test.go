@@ -0,0 +1,459 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html":   "<html><body>index</body></html>",
+		"css/site.css": "body { color: red; }",
+		"data":         "\x00\x01\x02\x03",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.index.html", testAccObjectChecksum([]byte("<html><body>index</body></html>"))),
+					resource.TestCheckResourceAttr(resourceName, "manifest.css/site.css", testAccObjectChecksum([]byte("body { color: red; }"))),
+					resource.TestCheckResourceAttr(resourceName, "manifest.data", testAccObjectChecksum([]byte("\x00\x01\x02\x03"))),
+					resource.TestCheckResourceAttr(resourceName, "delete_unmanaged", "false"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_paths.#", "0"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "index.html", &obj),
+					testAccCheckObjectContentType(&obj, "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "css/site.css", &obj),
+					testAccCheckObjectContentType(&obj, "text/css; charset=utf-8"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "data", &obj),
+					testAccCheckObjectContentType(&obj, "application/octet-stream"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_dir", "unmanaged_paths"},
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_importKeyPrefix(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html><body>index</body></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: rName + ":site",
+				ExpectError:   regexp.MustCompile(`KEY-PREFIX must end with a slash`),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: rName + ":",
+				ExpectError:   regexp.MustCompile(`KEY-PREFIX must end with a slash`),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html><body>index</body></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceDirectorySync(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html":        "index",
+		"css/site.css":      "css",
+		"drafts/index.html": "draft",
+		"README.md":         "readme",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncIncludeExcludeConfig(rName, sourceDir, `"**/*.html", "**/*.css"`, `"drafts/**"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.css/site.css"),
+				),
+			},
+			{
+				Config: testAccDirectorySyncIncludeExcludeConfig(rName, sourceDir, `"**/*.html"`, `"*.md"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.drafts/index.html"),
+					testAccCheckDirectorySyncObjectNotExists(resourceName, "css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_fileRule(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html":      "index",
+		"assets/app.js":   "app",
+		"assets/app.json": "{}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncFileRuleConfig(rName, sourceDir, "max-age=60"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_rule.#", "3"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "index.html", &obj),
+					testAccCheckObjectCacheControl(&obj, "no-cache"),
+					testAccCheckObjectMetadata(&obj, "team", "web"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "assets/app.js", &obj),
+					testAccCheckObjectCacheControl(&obj, "max-age=60"),
+					testAccCheckObjectMetadata(&obj, "team", "web"),
+					testAccCheckDirectorySyncObjectExists(resourceName, "assets/app.json", &obj),
+					testAccCheckObjectCacheControl(&obj, "max-age=60"),
+					testAccCheckObjectContentType(&obj, "application/x-custom"),
+				),
+			},
+			{
+				// Changing a rule re-uploads all files, even though their content is unchanged.
+				Config: testAccDirectorySyncFileRuleConfig(rName, sourceDir, "max-age=3600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjectExists(resourceName, "assets/app.js", &obj),
+					testAccCheckObjectCacheControl(&obj, "max-age=3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"unchanged.txt": "unchanged",
+		"changed.txt":   "original",
+		"deleted.txt":   "deleted",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.changed.txt", testAccObjectChecksum([]byte("original"))),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteSourceFile(t, sourceDir, "changed.txt", "updated")
+					testAccDirectorySyncWriteSourceFile(t, sourceDir, "new/nested.txt", "new")
+
+					if err := os.Remove(filepath.Join(sourceDir, "deleted.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.unchanged.txt", testAccObjectChecksum([]byte("unchanged"))),
+					resource.TestCheckResourceAttr(resourceName, "manifest.changed.txt", testAccObjectChecksum([]byte("updated"))),
+					resource.TestCheckResourceAttr(resourceName, "manifest.new/nested.txt", testAccObjectChecksum([]byte("new"))),
+					testAccCheckDirectorySyncObjectNotExists(resourceName, "deleted.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_sync" {
+			continue
+		}
+
+		bucket, keyPrefix, err := tfs3.DirectorySyncParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, path := range testAccDirectorySyncManifestPaths(rs) {
+			_, err := tfs3.FindObjectByBucketAndKey(context.Background(), conn, bucket, keyPrefix+path)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Directory Sync %s object %s still exists", rs.Primary.ID, keyPrefix+path)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectorySyncObjectExists(n, path string, v *s3.HeadObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Directory Sync ID is set")
+		}
+
+		bucket, keyPrefix, err := tfs3.DirectorySyncParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		output, err := tfs3.FindObjectByBucketAndKey(context.Background(), conn, bucket, keyPrefix+path)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(n, path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, keyPrefix, err := tfs3.DirectorySyncParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err = tfs3.FindObjectByBucketAndKey(context.Background(), conn, bucket, keyPrefix+path)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Directory Sync %s object %s still exists", rs.Primary.ID, keyPrefix+path)
+	}
+}
+
+func testAccCheckObjectCacheControl(obj *s3.HeadObjectOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(obj.CacheControl); got != expected {
+			return fmt.Errorf("Cache-Control: got %q, expected %q", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectContentType(obj *s3.HeadObjectOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(obj.ContentType); got != expected {
+			return fmt.Errorf("Content-Type: got %q, expected %q", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectMetadata(obj *s3.HeadObjectOutput, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// S3 returns user-defined metadata keys with the first letter capitalized.
+		for k, v := range obj.Metadata {
+			if strings.EqualFold(k, key) {
+				if got := aws.StringValue(v); got != expected {
+					return fmt.Errorf("metadata %q: got %q, expected %q", key, got, expected)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("metadata %q not found", key)
+	}
+}
+
+func testAccDirectorySyncManifestPaths(rs *terraform.ResourceState) []string {
+	var paths []string
+
+	for k := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "manifest.") && k != "manifest.%" {
+			paths = append(paths, strings.TrimPrefix(k, "manifest."))
+		}
+	}
+
+	return paths
+}
+
+func testAccDirectorySyncCreateSourceDir(t *testing.T, files map[string]string) string {
+	sourceDir := t.TempDir()
+
+	for path, content := range files {
+		testAccDirectorySyncWriteSourceFile(t, sourceDir, path, content)
+	}
+
+	return sourceDir
+}
+
+func testAccDirectorySyncWriteSourceFile(t *testing.T, sourceDir, path, content string) {
+	filename := filepath.Join(sourceDir, filepath.FromSlash(path))
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectorySyncConfig(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncIncludeExcludeConfig(rName, sourceDir, include, exclude string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+
+  include = [%[2]s]
+  exclude = [%[3]s]
+}
+`, sourceDir, include, exclude))
+}
+
+func testAccDirectorySyncFileRuleConfig(rName, sourceDir, assetsCacheControl string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+
+  file_rule {
+    pattern       = "**"
+    cache_control = "no-cache"
+
+    metadata = {
+      team = "web"
+    }
+  }
+
+  file_rule {
+    pattern       = "assets/**"
+    cache_control = %[2]q
+  }
+
+  file_rule {
+    pattern      = "**/*.json"
+    content_type = "application/x-custom"
+  }
+}
+`, sourceDir, assetsCacheControl))
+}
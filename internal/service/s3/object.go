@@ -0,0 +1,770 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+// objectChecksumSHA256MetadataKey is the user-defined metadata key under which the
+// SHA-256 checksum of an object's content is recorded on upload.
+// S3 only returns an MD5 ETag for single part uploads, so the ETag cannot be used
+// to detect content drift for objects uploaded in multiple parts.
+const objectChecksumSHA256MetadataKey = "tf-checksum-sha256"
+
+func ResourceObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectCreate,
+		ReadContext:   resourceObjectRead,
+		UpdateContext: resourceObjectUpdate,
+		DeleteContext: resourceObjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObjectImport,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceObjectCustomizeDiff,
+			resourceObjectSetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"bucket_key_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "content_base64"},
+			},
+			"content_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "content"},
+			},
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_language": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// ignore diffs where the user hasn't specified a kms_key_id but the bucket has a default KMS key configured
+					if new == "" && d.Get("server_side_encryption") == s3.ServerSideEncryptionAwsKms {
+						return true
+					}
+					return false
+				},
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateObjectMetadata,
+			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockLegalHoldStatus_Values(), false),
+			},
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockMode_Values(), false),
+			},
+			"object_lock_retain_until_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"override_provider": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_tags": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadPartSize,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content", "content_base64"},
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_redirect": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceObjectUpload(ctx, d, meta)
+}
+
+func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+	defaultTagsConfig := objectDefaultTagsConfig(d.Get("override_provider").([]interface{}), meta)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	var output *s3.HeadObjectOutput
+
+	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.S3).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+		var err error
+
+		output, err = FindObjectByBucketAndKey(ctx, conn, bucket, key)
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Object (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
+	d.Set("cache_control", output.CacheControl)
+	d.Set("content_disposition", output.ContentDisposition)
+	d.Set("content_encoding", output.ContentEncoding)
+	d.Set("content_language", output.ContentLanguage)
+	d.Set("content_type", output.ContentType)
+	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+	d.Set("etag", strings.Trim(aws.StringValue(output.ETag), `"`))
+
+	metadata, checksum := flattenObjectMetadata(output.Metadata)
+
+	d.Set("checksum_sha256", checksum)
+
+	if err := d.Set("metadata", metadata); err != nil {
+		return diag.Errorf("error setting metadata: %s", err)
+	}
+
+	d.Set("object_lock_legal_hold_status", output.ObjectLockLegalHoldStatus)
+	d.Set("object_lock_mode", output.ObjectLockMode)
+	d.Set("object_lock_retain_until_date", flattenS3ObjectDate(output.ObjectLockRetainUntilDate))
+	d.Set("server_side_encryption", output.ServerSideEncryption)
+	// The "STANDARD" (which is also the default) storage
+	// class when set would not be included in the results.
+	d.Set("storage_class", s3.StorageClassStandard)
+	if output.StorageClass != nil {
+		d.Set("storage_class", output.StorageClass)
+	}
+	d.Set("version_id", output.VersionId)
+	d.Set("website_redirect", output.WebsiteRedirectLocation)
+
+	if err := resourceBucketObjectSetKMS(d, meta, output.SSEKMSKeyId); err != nil {
+		return diag.Errorf("error reading S3 Object (%s) KMS key: %s", d.Id(), err)
+	}
+
+	tags, err := ObjectListTags(conn, bucket, key)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The checksum is unknown until apply when the content is read from a local file.
+	if hasObjectContentChanges(d) || d.Get("checksum_sha256").(string) == "" {
+		return resourceObjectUpload(ctx, d, meta)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	if d.HasChange("acl") {
+		_, err := conn.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			ACL:    aws.String(d.Get("acl").(string)),
+		})
+
+		if err != nil {
+			return diag.Errorf("error putting S3 Object (%s) ACL: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("object_lock_legal_hold_status") {
+		_, err := conn.PutObjectLegalHoldWithContext(ctx, &s3.PutObjectLegalHoldInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			LegalHold: &s3.ObjectLockLegalHold{
+				Status: aws.String(d.Get("object_lock_legal_hold_status").(string)),
+			},
+		})
+
+		if err != nil {
+			return diag.Errorf("error putting S3 Object (%s) lock legal hold: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("object_lock_mode", "object_lock_retain_until_date") {
+		input := &s3.PutObjectRetentionInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			Retention: &s3.ObjectLockRetention{
+				Mode:            aws.String(d.Get("object_lock_mode").(string)),
+				RetainUntilDate: expandS3ObjectDate(d.Get("object_lock_retain_until_date").(string)),
+			},
+		}
+
+		// Bypass required to lower or clear retain-until date.
+		if d.HasChange("object_lock_retain_until_date") {
+			oraw, nraw := d.GetChange("object_lock_retain_until_date")
+			o := expandS3ObjectDate(oraw.(string))
+			n := expandS3ObjectDate(nraw.(string))
+			if n == nil || (o != nil && n.Before(*o)) {
+				input.BypassGovernanceRetention = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.PutObjectRetentionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error putting S3 Object (%s) lock retention: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := ObjectUpdateTags(conn, bucket, key, o, n); err != nil {
+			return diag.Errorf("error updating S3 Object (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceObjectRead(ctx, d, meta)
+}
+
+func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	// We are effectively ignoring all leading '/'s in the key name and
+	// treating multiple '/'s as a single '/' as aws.Config.DisableRestProtocolURICleaning is false
+	key = strings.TrimLeft(key, "/")
+	key = regexp.MustCompile(`/+`).ReplaceAllString(key, "/")
+
+	var err error
+	if _, ok := d.GetOk("version_id"); ok {
+		err = DeleteAllObjectVersions(conn, bucket, key, d.Get("force_destroy").(bool), false)
+	} else {
+		err = deleteS3ObjectVersion(conn, bucket, key, "", false)
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	return nil
+}
+
+func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	id = strings.TrimPrefix(id, "s3://")
+	parts := strings.Split(id, "/")
+
+	if len(parts) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("id %s should be in format <bucket>/<key> or s3://<bucket>/<key>", id)
+	}
+
+	bucket := parts[0]
+	key := strings.Join(parts[1:], "/")
+
+	d.SetId(key)
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceObjectUpload uploads the object's content, streaming from disk when the
+// content is read from a local file. Content larger than part_size is uploaded in
+// parts, up to upload_concurrency parts at a time.
+func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+	defaultTagsConfig := objectDefaultTagsConfig(d.Get("override_provider").([]interface{}), meta)
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	body, err := objectContentBody(d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	defer func() {
+		if err := body.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 Object content: %s", err)
+		}
+	}()
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	input := &s3manager.UploadInput{
+		ACL:      aws.String(d.Get("acl").(string)),
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		Metadata: flex.ExpandStringMap(d.Get("metadata").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("bucket_key_enabled"); ok {
+		input.BucketKeyEnabled = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_disposition"); ok {
+		input.ContentDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_encoding"); ok {
+		input.ContentEncoding = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_language"); ok {
+		input.ContentLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		input.ContentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		input.ObjectLockLegalHoldStatus = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		input.ObjectLockMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+		input.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		// The tag-set must be encoded as URL Query parameters.
+		input.Tagging = aws.String(tags.IgnoreAWS().UrlEncode())
+	}
+
+	if v, ok := d.GetOk("website_redirect"); ok {
+		input.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	uploader := newObjectUploader(conn, d.Get("part_size").(int), d.Get("upload_concurrency").(int))
+
+	log.Printf("[DEBUG] Uploading S3 Object: %s/%s", bucket, key)
+	if _, err := uploadObject(ctx, uploader, input, body); err != nil {
+		return diag.Errorf("error uploading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	d.SetId(key)
+
+	return resourceObjectRead(ctx, d, meta)
+}
+
+func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("source_hash") || !d.NewValueKnown("content") || !d.NewValueKnown("content_base64") {
+		return d.SetNewComputed("checksum_sha256")
+	}
+
+	upload := hasObjectContentChanges(d)
+
+	if d.Get("source").(string) != "" {
+		// A local file is only read when it is uploaded, as it may be large.
+		// Changes to its content are detected through source_hash, and an object
+		// overwritten outside of Terraform has no recorded checksum.
+		if d.HasChange("source") || d.HasChange("source_hash") || d.Get("checksum_sha256").(string) == "" {
+			if err := d.SetNewComputed("checksum_sha256"); err != nil {
+				return err
+			}
+
+			upload = true
+		}
+	} else {
+		// Calculate the checksum of the configured content so that changes made
+		// to either the content or the remote object are detected.
+		checksum, err := objectChecksum("", d.Get("content").(string), d.Get("content_base64").(string))
+
+		if err != nil {
+			return err
+		}
+
+		if d.Get("checksum_sha256").(string) != checksum {
+			if err := d.SetNew("checksum_sha256", checksum); err != nil {
+				return fmt.Errorf("error setting checksum_sha256 diff: %w", err)
+			}
+
+			upload = true
+		}
+	}
+
+	if upload {
+		if err := d.SetNewComputed("etag"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("version_id")
+	}
+
+	return nil
+}
+
+// resourceObjectSetTagsDiff is verify.SetTagsDiff using any default tags
+// configured in the resource's override_provider block.
+func resourceObjectSetTagsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return verify.SetTagsDiffWithDefaultTagsConfig(ctx, d, meta, objectDefaultTagsConfig(d.Get("override_provider").([]interface{}), meta))
+}
+
+func hasObjectContentChanges(d verify.ResourceDiffer) bool {
+	for _, key := range []string{
+		"bucket_key_enabled",
+		"cache_control",
+		"checksum_sha256",
+		"content_disposition",
+		"content_encoding",
+		"content_language",
+		"content_type",
+		"kms_key_id",
+		"metadata",
+		"server_side_encryption",
+		"source",
+		"source_hash",
+		"storage_class",
+		"website_redirect",
+	} {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// objectDefaultTagsConfig returns the default tags configuration for the object.
+// Default tags configured in an override_provider block replace those of the provider;
+// an empty default_tags block disables the provider's default tags.
+func objectDefaultTagsConfig(tfList []interface{}, meta interface{}) *tftags.DefaultConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return meta.(*conns.AWSClient).DefaultTagsConfig
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	v, ok := tfMap["default_tags"].([]interface{})
+
+	if !ok || len(v) == 0 {
+		return meta.(*conns.AWSClient).DefaultTagsConfig
+	}
+
+	if v[0] == nil {
+		return &tftags.DefaultConfig{}
+	}
+
+	tags := tftags.New(v[0].(map[string]interface{})["tags"])
+
+	if len(tags) == 0 {
+		return &tftags.DefaultConfig{}
+	}
+
+	return &tftags.DefaultConfig{Tags: tags}
+}
+
+func newObjectUploader(conn *s3.S3, partSize, concurrency int) *s3manager.Uploader {
+	return s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.Concurrency = concurrency
+		u.PartSize = int64(partSize)
+	})
+}
+
+// uploadObject uploads the object content read from body, recording the content's
+// SHA-256 checksum in the object metadata, and returns the checksum.
+func uploadObject(ctx context.Context, uploader *s3manager.Uploader, input *s3manager.UploadInput, body io.ReadSeeker) (string, error) {
+	// The checksum is calculated in a separate pass as the content must be
+	// read in full before the checksum can be sent with the object metadata.
+	checksum, err := objectContentChecksum(body)
+
+	if err != nil {
+		return "", fmt.Errorf("calculating content checksum: %w", err)
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("rewinding content: %w", err)
+	}
+
+	if input.Metadata == nil {
+		input.Metadata = make(map[string]*string)
+	}
+
+	input.Body = body
+	input.Metadata[objectChecksumSHA256MetadataKey] = aws.String(checksum)
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return "", err
+	}
+
+	return checksum, nil
+}
+
+type objectContent interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+type nopCloserReadSeeker struct {
+	io.ReadSeeker
+}
+
+func (nopCloserReadSeeker) Close() error {
+	return nil
+}
+
+// objectContentBody returns the object's content from exactly one of a local file,
+// a string or a base64-encoded string. A local file is read on demand rather than
+// loaded into memory.
+func objectContentBody(source, content, contentBase64 string) (objectContent, error) {
+	switch {
+	case source != "":
+		path, err := homedir.Expand(source)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+		}
+
+		file, err := os.Open(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("error opening S3 Object source (%s): %w", path, err)
+		}
+
+		return file, nil
+	case contentBase64 != "":
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(contentBase64)
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding content_base64: %w", err)
+		}
+
+		return nopCloserReadSeeker{bytes.NewReader(contentRaw)}, nil
+	default:
+		return nopCloserReadSeeker{bytes.NewReader([]byte(content))}, nil
+	}
+}
+
+// objectChecksum returns the hex-encoded SHA-256 checksum of the object's content.
+func objectChecksum(source, content, contentBase64 string) (string, error) {
+	body, err := objectContentBody(source, content, contentBase64)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer body.Close()
+
+	return objectContentChecksum(body)
+}
+
+func objectContentChecksum(r io.Reader) (string, error) {
+	h := sha256.New()
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// flattenObjectMetadata returns an object's user-defined metadata, with keys in lower case,
+// and the content checksum recorded in the metadata, if any.
+func flattenObjectMetadata(apiObject map[string]*string) (map[string]string, string) {
+	metadata := make(map[string]string, len(apiObject))
+	var checksum string
+
+	// AWS Go SDK capitalizes metadata, this is a workaround. https://github.com/aws/aws-sdk-go/issues/445
+	for k, v := range apiObject {
+		k = strings.ToLower(k)
+
+		if k == objectChecksumSHA256MetadataKey {
+			checksum = aws.StringValue(v)
+			continue
+		}
+
+		metadata[k] = aws.StringValue(v)
+	}
+
+	return metadata, checksum
+}
+
+func validateObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = validateMetadataIsLowerCase(v, k)
+
+	if _, ok := v.(map[string]interface{})[objectChecksumSHA256MetadataKey]; ok {
+		errors = append(errors, fmt.Errorf("Metadata key %q is reserved", objectChecksumSHA256MetadataKey))
+	}
+
+	return
+}
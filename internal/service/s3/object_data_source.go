@@ -0,0 +1,245 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceObject() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"bucket_key_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_language": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"object_lock_legal_hold_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_lock_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_lock_retain_until_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"range": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sse_kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"website_redirect_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if v, ok := d.GetOk("range"); ok {
+		input.Range = aws.String(v.(string))
+	}
+
+	id := bucket + "/" + key
+
+	if v, ok := d.GetOk("version_id"); ok {
+		input.VersionId = aws.String(v.(string))
+		id += "@" + v.(string)
+	}
+
+	output, err := conn.HeadObjectWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Object (%s): %s", id, err)
+	}
+
+	if aws.BoolValue(output.DeleteMarker) {
+		return diag.Errorf("S3 Object (%s) has been deleted", id)
+	}
+
+	d.SetId(id)
+
+	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
+	d.Set("cache_control", output.CacheControl)
+	d.Set("content_disposition", output.ContentDisposition)
+	d.Set("content_encoding", output.ContentEncoding)
+	d.Set("content_language", output.ContentLanguage)
+	d.Set("content_length", output.ContentLength)
+	d.Set("content_type", output.ContentType)
+	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+	d.Set("etag", strings.Trim(aws.StringValue(output.ETag), `"`))
+	d.Set("expiration", output.Expiration)
+	d.Set("expires", output.Expires)
+	if output.LastModified != nil {
+		d.Set("last_modified", output.LastModified.Format(time.RFC1123))
+	} else {
+		d.Set("last_modified", "")
+	}
+
+	metadata, checksum := flattenObjectMetadata(output.Metadata)
+
+	d.Set("checksum_sha256", checksum)
+
+	if err := d.Set("metadata", metadata); err != nil {
+		return diag.Errorf("error setting metadata: %s", err)
+	}
+
+	d.Set("object_lock_legal_hold_status", output.ObjectLockLegalHoldStatus)
+	d.Set("object_lock_mode", output.ObjectLockMode)
+	d.Set("object_lock_retain_until_date", flattenS3ObjectDate(output.ObjectLockRetainUntilDate))
+	d.Set("server_side_encryption", output.ServerSideEncryption)
+	d.Set("sse_kms_key_id", output.SSEKMSKeyId)
+	// The "STANDARD" (which is also the default) storage
+	// class when set would not be included in the results.
+	d.Set("storage_class", s3.StorageClassStandard)
+	if output.StorageClass != nil {
+		d.Set("storage_class", output.StorageClass)
+	}
+	d.Set("version_id", output.VersionId)
+	d.Set("website_redirect_location", output.WebsiteRedirectLocation)
+
+	if isContentTypeAllowed(output.ContentType) {
+		input := &s3.GetObjectInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: output.VersionId,
+		}
+
+		if v, ok := d.GetOk("range"); ok {
+			input.Range = aws.String(v.(string))
+		}
+
+		output, err := conn.GetObjectWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error getting S3 Object (%s): %s", id, err)
+		}
+
+		defer output.Body.Close()
+
+		buf := new(bytes.Buffer)
+
+		if _, err := buf.ReadFrom(output.Body); err != nil {
+			return diag.Errorf("error reading S3 Object (%s) body: %s", id, err)
+		}
+
+		d.Set("body", buf.String())
+	} else {
+		log.Printf("[INFO] Ignoring body of S3 Object (%s) with Content-Type %q", id, aws.StringValue(output.ContentType))
+	}
+
+	tags, err := ObjectListTags(conn, bucket, key)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
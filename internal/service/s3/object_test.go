@@ -0,0 +1,415 @@
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3Object_basic(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectContentConfig(rName, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", testAccObjectChecksum([]byte("some_bucket_content"))),
+					resource.TestCheckResourceAttr(resourceName, "key", "test-key"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", s3.StorageClassStandard),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "content", "force_destroy", "part_size", "upload_concurrency"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectContentConfig(rName, "updated_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", testAccObjectChecksum([]byte("updated_bucket_content"))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_disappears(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectContentConfig(rName, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceObject(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_multipartSource(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+
+	// Larger than the minimum part size so that the content is uploaded in multiple parts.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 768*1024)
+	source := testAccBucketObjectCreateTempFile(t, string(content))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSourceConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", testAccObjectChecksum(content)),
+					resource.TestCheckResourceAttr(resourceName, "part_size", "5242880"),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					// The ETag of an object uploaded in parts is suffixed with the number of parts.
+					resource.TestCheckResourceAttr(resourceName, "etag", fmt.Sprintf("%s-3", testAccObjectMultipartETag(content, 5*1024*1024))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceHash(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+
+	source := testAccBucketObjectCreateTempFile(t, "some_bucket_content")
+	defer os.Remove(source)
+
+	rewriteFile := func(*terraform.State) error {
+		if err := os.WriteFile(source, []byte("updated_bucket_content"), 0644); err != nil {
+			os.Remove(source)
+			t.Fatal(err)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSourceHashConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", testAccObjectChecksum([]byte("some_bucket_content"))),
+					rewriteFile,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectSourceHashConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", testAccObjectChecksum([]byte("updated_bucket_content"))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumDrift(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectContentConfig(rName, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					testAccCheckObjectOverwrite(resourceName, "overwritten_content"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectContentConfig(rName, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", testAccObjectChecksum([]byte("some_bucket_content"))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_overrideProviderDefaultTags(t *testing.T) {
+	var providers []*schema.Provider
+	var obj s3.HeadObjectOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectContentConfig(rName, "some_bucket_content"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectOverrideProviderDefaultTagsConfig(rName, "overridekey1", "overridevalue1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overridekey1", "overridevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectOverrideProviderNoDefaultTagsConfig(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_object" {
+			continue
+		}
+
+		_, err := tfs3.FindObjectByBucketAndKey(context.Background(), conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckObjectExists(n string, v *s3.HeadObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Object ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		output, err := tfs3.FindObjectByBucketAndKey(context.Background(), conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckObjectOverwrite replaces the object's content outside of Terraform.
+func testAccCheckObjectOverwrite(n, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err := conn.PutObject(&s3.PutObjectInput{
+			Body:   bytes.NewReader([]byte(content)),
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key"]),
+		})
+
+		return err
+	}
+}
+
+func testAccObjectChecksum(content []byte) string {
+	h := sha256.Sum256(content)
+
+	return hex.EncodeToString(h[:])
+}
+
+// testAccObjectMultipartETag returns the hex-encoded MD5 of the concatenated MD5s of each part.
+func testAccObjectMultipartETag(content []byte, partSize int) string {
+	var sums []byte
+
+	for i := 0; i < len(content); i += partSize {
+		end := i + partSize
+		if end > len(content) {
+			end = len(content)
+		}
+
+		sum := md5.Sum(content[i:end])
+		sums = append(sums, sum[:]...)
+	}
+
+	sum := md5.Sum(sums)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func testAccObjectConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccObjectContentConfig(rName, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[1]q
+}
+`, content))
+}
+
+func testAccObjectSourceConfig(rName, source string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[1]q
+
+  part_size          = 5242880
+  upload_concurrency = 2
+}
+`, source))
+}
+
+func testAccObjectSourceHashConfig(rName, source string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = "test-key"
+  source      = %[1]q
+  source_hash = filemd5(%[1]q)
+}
+`, source))
+}
+
+func testAccObjectOverrideProviderDefaultTagsConfig(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "some_bucket_content"
+
+  override_provider {
+    default_tags {
+      tags = {
+        %[1]q = %[2]q
+      }
+    }
+  }
+
+  tags = {
+    resourcekey1 = "resourcevalue1"
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccObjectOverrideProviderNoDefaultTagsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccObjectConfigBase(rName), `
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "some_bucket_content"
+
+  override_provider {
+    default_tags {
+      tags = {}
+    }
+  }
+}
+`)
+}
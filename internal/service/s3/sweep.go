@@ -22,12 +22,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_bucket_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket_object", &resource.Sweeper{
 		Name: "aws_s3_bucket_object",
 		F:    sweepBucketObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -43,7 +43,7 @@ func sweepBucketObjects(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).S3ConnURICleaningDisabled
+	conn := client.(*conns.AWSClient).S3ConnURICleaningDisabled()
 	input := &s3.ListBucketsInput{}
 
 	output, err := conn.ListBuckets(input)
@@ -116,7 +116,7 @@ func sweepBuckets(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).S3Conn
+	conn := client.(*conns.AWSClient).S3Conn()
 	input := &s3.ListBucketsInput{}
 
 	output, err := conn.ListBuckets(input)
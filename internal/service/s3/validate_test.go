@@ -1,7 +1,10 @@
 package s3
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestValidBucketLifecycleTimestamp(t *testing.T) {
@@ -29,3 +32,88 @@ func TestValidBucketLifecycleTimestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDirectorySyncGlob(t *testing.T) {
+	validGlobs := []string{
+		"*.html",
+		"**/*.css",
+		"assets/**",
+		"img/[a-z]?.png",
+		"[!.]*",
+		"文档/*.md",
+	}
+
+	for _, v := range validGlobs {
+		_, errors := validateDirectorySyncGlob(v, "include")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid glob: %q", v, errors)
+		}
+	}
+
+	invalidGlobs := []string{
+		"img/[a-z.png",
+		"[]",
+		"[!]",
+	}
+
+	for _, v := range invalidGlobs {
+		_, errors := validateDirectorySyncGlob(v, "include")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid glob", v)
+		}
+	}
+}
+
+func TestDirectorySyncGlobRegexp(t *testing.T) {
+	testCases := []struct {
+		glob     string
+		path     string
+		expected bool
+	}{
+		{glob: "*.html", path: "index.html", expected: true},
+		{glob: "*.html", path: "docs/index.html", expected: false},
+		{glob: "**/*.html", path: "index.html", expected: true},
+		{glob: "**/*.html", path: "docs/v1/index.html", expected: true},
+		{glob: "docs/**", path: "docs/v1/index.html", expected: true},
+		{glob: "docs/**", path: "docsindex.html", expected: false},
+		{glob: "img/?.png", path: "img/a.png", expected: true},
+		{glob: "img/?.png", path: "img/ab.png", expected: false},
+		{glob: "[!.]*", path: ".hidden", expected: false},
+		{glob: "[!.]*", path: "visible", expected: true},
+		{glob: "a+b.txt", path: "a+b.txt", expected: true},
+		{glob: "a+b.txt", path: "aab.txt", expected: false},
+		{glob: "café/*.html", path: "café/menu.html", expected: true},
+		{glob: "café/*.html", path: "cafe/menu.html", expected: false},
+		{glob: "文档/?.md", path: "文档/说.md", expected: true},
+		{glob: "文档/?.md", path: "文档/说明.md", expected: false},
+		{glob: "[éè]t?.txt", path: "été.txt", expected: true},
+		{glob: "[!é]*", path: "été.txt", expected: false},
+		{glob: "🚀+*.png", path: "🚀+launch.png", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		re, err := directorySyncGlobRegexp(testCase.glob)
+
+		if err != nil {
+			t.Fatalf("%q: %s", testCase.glob, err)
+		}
+
+		if got := re.MatchString(testCase.path); got != testCase.expected {
+			t.Errorf("%q matching %q: got %t, expected %t", testCase.glob, testCase.path, got, testCase.expected)
+		}
+	}
+}
+
+func TestDirectorySyncPartitionUnmanagedPaths(t *testing.T) {
+	unmanaged := schema.NewSet(schema.HashString, []interface{}{"adopted.html", "img/adopted.png"})
+
+	managedPaths, unmanagedPaths := directorySyncPartitionUnmanagedPaths([]string{"adopted.html", "index.html", "img/adopted.png", "img/logo.png"}, unmanaged)
+
+	if expected := []string{"index.html", "img/logo.png"}; !reflect.DeepEqual(managedPaths, expected) {
+		t.Errorf("got managed paths %v, expected %v", managedPaths, expected)
+	}
+
+	if expected := []string{"adopted.html", "img/adopted.png"}; !reflect.DeepEqual(unmanagedPaths, expected) {
+		t.Errorf("got unmanaged paths %v, expected %v", unmanagedPaths, expected)
+	}
+}
@@ -123,7 +123,7 @@ func ResourceAccessPoint() *schema.Resource {
 }
 
 func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	accountId := meta.(*conns.AWSClient).AccountID
 	if v, ok := d.GetOk("account_id"); ok {
@@ -176,7 +176,7 @@ func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	accountId, name, err := AccessPointParseID(d.Id())
 	if err != nil {
@@ -289,7 +289,7 @@ func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	accountId, name, err := AccessPointParseID(d.Id())
 	if err != nil {
@@ -325,7 +325,7 @@ func resourceAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAccessPointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	accountId, name, err := AccessPointParseID(d.Id())
 	if err != nil {
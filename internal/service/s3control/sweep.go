@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 	})
@@ -30,7 +30,7 @@ func sweepAccessPoints(region string) error {
 	}
 
 	accountId := client.(*conns.AWSClient).AccountID
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountId),
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccSageMakerCodeRepository_basic(t *testing.T) {
 	var repo sagemaker.DescribeCodeRepositoryOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_code_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -48,7 +47,7 @@ func TestAccSageMakerCodeRepository_basic(t *testing.T) {
 
 func TestAccSageMakerCodeRepository_Git_branch(t *testing.T) {
 	var repo sagemaker.DescribeCodeRepositoryOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_code_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -79,7 +78,7 @@ func TestAccSageMakerCodeRepository_Git_branch(t *testing.T) {
 
 func TestAccSageMakerCodeRepository_Git_secret(t *testing.T) {
 	var repo sagemaker.DescribeCodeRepositoryOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_code_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -121,7 +120,7 @@ func TestAccSageMakerCodeRepository_Git_secret(t *testing.T) {
 
 func TestAccSageMakerCodeRepository_tags(t *testing.T) {
 	var repo sagemaker.DescribeCodeRepositoryOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_code_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -166,7 +165,7 @@ func TestAccSageMakerCodeRepository_tags(t *testing.T) {
 
 func TestAccSageMakerCodeRepository_disappears(t *testing.T) {
 	var repo sagemaker.DescribeCodeRepositoryOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_code_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -189,7 +188,7 @@ func TestAccSageMakerCodeRepository_disappears(t *testing.T) {
 }
 
 func testAccCheckCodeRepositoryDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_code_repository" {
@@ -225,7 +224,7 @@ func testAccCheckCodeRepositoryExists(n string, codeRepo *sagemaker.DescribeCode
 			return fmt.Errorf("No sagmaker Code Repository ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 		resp, err := tfsagemaker.FindCodeRepositoryByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
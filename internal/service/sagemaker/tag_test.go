@@ -0,0 +1,27 @@
+package sagemaker_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_code_repository" "test" {
+  code_repository_name = %[1]q
+
+  git_config {
+    repository_url = "https://github.com/hashicorp/terraform-provider-aws.git"
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_sagemaker_tag" "test" {
+  resource_arn = aws_sagemaker_code_repository.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
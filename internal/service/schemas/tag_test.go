@@ -0,0 +1,23 @@
+package schemas_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_schemas_registry" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_schemas_tag" "test" {
+  resource_arn = aws_schemas_registry.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
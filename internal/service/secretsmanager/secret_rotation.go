@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -52,13 +51,18 @@ func ResourceSecretRotation() *schema.Resource {
 					},
 				},
 			},
-			"tags": tftags.TagsSchema(),
+			"tags": {
+				Type:       schema.TypeMap,
+				Optional:   true,
+				Elem:       &schema.Schema{Type: schema.TypeString},
+				Deprecated: "Tags are not supported by this resource. Use the tags argument of the aws_secretsmanager_secret resource instead",
+			},
 		},
 	}
 }
 
 func resourceSecretRotationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 	secretID := d.Get("secret_id").(string)
 
 	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
@@ -99,7 +103,7 @@ func resourceSecretRotationCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSecretRotationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	input := &secretsmanager.DescribeSecretInput{
 		SecretId: aws.String(d.Id()),
@@ -107,7 +111,7 @@ func resourceSecretRotationRead(d *schema.ResourceData, meta interface{}) error
 
 	var output *secretsmanager.DescribeSecretOutput
 
-	err := resource.Retry(PropagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(meta.(*conns.AWSClient).ConsistencyPolicy(conns.SecretsManager).Timeout, func() *resource.RetryError {
 		var err error
 
 		output, err = conn.DescribeSecret(input)
@@ -158,7 +162,7 @@ func resourceSecretRotationRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSecretRotationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 	secretID := d.Get("secret_id").(string)
 
 	if d.HasChanges("rotation_lambda_arn", "rotation_rules") {
@@ -206,7 +210,7 @@ func resourceSecretRotationUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSecretRotationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 	secretID := d.Get("secret_id").(string)
 
 	input := &secretsmanager.CancelRotateSecretInput{
@@ -0,0 +1,24 @@
+package secretsmanager_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_secretsmanager_tag" "test" {
+  resource_arn = aws_secretsmanager_secret.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -18,31 +18,31 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_budget_resource_association",
 		Dependencies: []string{},
 		F:            sweepBudgetResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
 		Name:         "aws_servicecatalog_constraint",
 		Dependencies: []string{},
 		F:            sweepConstraints,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_principal_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepPrincipalPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_product_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepProductPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
 		Name: "aws_servicecatalog_product",
 		Dependencies: []string{
 			"aws_servicecatalog_provisioning_artifact",
@@ -50,31 +50,31 @@ func init() {
 		F: sweepProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioned_product",
 		Dependencies: []string{},
 		F:            sweepProvisionedProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioning_artifact",
 		Dependencies: []string{},
 		F:            sweepProvisioningArtifacts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
 		Name:         "aws_servicecatalog_service_action",
 		Dependencies: []string{},
 		F:            sweepServiceActions,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_tag_option_resource_association",
 		Dependencies: []string{},
 		F:            sweepTagOptionResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
 		Name:         "aws_servicecatalog_tag_option",
 		Dependencies: []string{},
 		F:            sweepTagOptions,
@@ -88,7 +88,7 @@ func sweepBudgetResourceAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -199,7 +199,7 @@ func sweepConstraints(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -268,7 +268,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -340,7 +340,7 @@ func sweepProductPortfolioAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -432,7 +432,7 @@ func sweepProducts(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -483,7 +483,7 @@ func sweepProvisionedProducts(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -537,7 +537,7 @@ func sweepProvisioningArtifacts(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -618,7 +618,7 @@ func sweepServiceActions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -669,7 +669,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -736,7 +736,7 @@ func sweepTagOptions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
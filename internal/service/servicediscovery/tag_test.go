@@ -0,0 +1,23 @@
+package servicediscovery_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_service_discovery_tag" "test" {
+  resource_arn = aws_service_discovery_http_namespace.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -157,7 +157,7 @@ func TestAccServiceQuotasServiceQuota_permissionError(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn()
 
 	input := &servicequotas.ListServicesInput{}
 
@@ -44,7 +44,7 @@ func DataSourceActivity() *schema.Resource {
 
 func dataSourceActivityRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*conns.AWSClient)
-	conn := client.SFNConn
+	conn := client.SFNConn()
 	log.Print("[DEBUG] Reading Step Function Activity")
 
 	if nm, ok := d.GetOk("name"); ok {
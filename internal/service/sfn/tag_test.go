@@ -0,0 +1,23 @@
+package sfn_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sfn_activity" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_sfn_tag" "test" {
+  resource_arn = aws_sfn_activity.test.id
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
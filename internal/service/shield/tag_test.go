@@ -0,0 +1,30 @@
+package shield_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_route53_zone" "test" {
+  name = "%[1]s.com."
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:route53:::hostedzone/${aws_route53_zone.test.zone_id}"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_shield_tag" "test" {
+  resource_arn = aws_shield_protection.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -0,0 +1,24 @@
+package signer_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test" {
+  name        = replace(%[1]q, "-", "_")
+  platform_id = "AWSLambda-SHA384-ECDSA"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_signer_tag" "test" {
+  resource_arn = aws_signer_signing_profile.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
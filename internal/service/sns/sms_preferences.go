@@ -91,7 +91,7 @@ var smsAttributeDefaultValues = map[string]string{
 }
 
 func resourceSMSPreferencesSet(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	log.Printf("[DEBUG] SNS Set SMS preferences")
 
@@ -124,7 +124,7 @@ func resourceSMSPreferencesSet(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSMSPreferencesGet(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	// Fetch ALL attributes
 	attrs, err := conn.GetSMSAttributes(&sns.GetSMSAttributesInput{})
@@ -149,7 +149,7 @@ func resourceSMSPreferencesGet(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSMSPreferencesDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	// Reset the attributes to their default value
 	attrs := map[string]*string{}
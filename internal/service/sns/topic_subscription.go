@@ -122,7 +122,7 @@ func ResourceTopicSubscription() *schema.Resource {
 }
 
 func resourceTopicSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	input := &sns.SubscribeInput{
 		Attributes:            expandSNSSubscriptionAttributes(d),
@@ -169,7 +169,7 @@ func resourceTopicSubscriptionCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	var output *sns.GetSubscriptionAttributesOutput
 
@@ -240,7 +240,7 @@ func resourceTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	if d.HasChange("raw_message_delivery") {
 		if err := snsSubscriptionAttributeUpdate(conn, d.Id(), "RawMessageDelivery", fmt.Sprintf("%t", d.Get("raw_message_delivery").(bool))); err != nil {
@@ -292,7 +292,7 @@ func resourceTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTopicSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	log.Printf("[DEBUG] SNS delete topic subscription: %s", d.Id())
 	_, err := conn.Unsubscribe(&sns.UnsubscribeInput{
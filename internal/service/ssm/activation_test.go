@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 
 func TestAccSSMActivation_basic(t *testing.T) {
 	var ssmActivation ssm.Activation
-	name := sdkacctest.RandomWithPrefix("tf-acc")
-	tag := sdkacctest.RandomWithPrefix("tf-acc")
+	name := acctest.RandomWithPrefix(t, "tf-acc")
+	tag := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccSSMActivation_basic(t *testing.T) {
 
 func TestAccSSMActivation_update(t *testing.T) {
 	var ssmActivation1, ssmActivation2 ssm.Activation
-	name := sdkacctest.RandomWithPrefix("tf-acc")
+	name := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -99,7 +98,7 @@ func TestAccSSMActivation_update(t *testing.T) {
 
 func TestAccSSMActivation_expirationDate(t *testing.T) {
 	var ssmActivation ssm.Activation
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	expirationTime := time.Now().Add(48 * time.Hour).UTC()
 	expirationDateS := expirationTime.Format(time.RFC3339)
 	resourceName := "aws_ssm_activation.test"
@@ -131,8 +130,8 @@ func TestAccSSMActivation_expirationDate(t *testing.T) {
 
 func TestAccSSMActivation_disappears(t *testing.T) {
 	var ssmActivation ssm.Activation
-	name := sdkacctest.RandomWithPrefix("tf-acc")
-	tag := sdkacctest.RandomWithPrefix("tf-acc")
+	name := acctest.RandomWithPrefix(t, "tf-acc")
+	tag := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,7 +172,7 @@ func testAccCheckActivationExists(n string, ssmActivation *ssm.Activation) resou
 			return fmt.Errorf("No SSM Activation ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		resp, err := conn.DescribeActivations(&ssm.DescribeActivationsInput{
 			Filters: []*ssm.DescribeActivationsFilter{
@@ -199,7 +198,7 @@ func testAccCheckActivationExists(n string, ssmActivation *ssm.Activation) resou
 
 func testAccCheckActivationDisappears(a *ssm.Activation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		input := &ssm.DeleteActivationInput{ActivationId: a.ActivationId}
 		_, err := conn.DeleteActivation(input)
@@ -211,7 +210,7 @@ func testAccCheckActivationDisappears(a *ssm.Activation) resource.TestCheckFunc
 }
 
 func testAccCheckActivationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_activation" {
@@ -42,7 +42,7 @@ func TestAccSSMAssociation_basic(t *testing.T) {
 }
 
 func TestAccSSMAssociation_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -523,7 +523,7 @@ func testAccCheckAssociationExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SSM Assosciation ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		_, err := conn.DescribeAssociation(&ssm.DescribeAssociationInput{
 			AssociationId: aws.String(rs.Primary.Attributes["association_id"]),
@@ -541,7 +541,7 @@ func testAccCheckAssociationExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_association" {
@@ -544,7 +544,7 @@ func TestAccSSMParameter_secure(t *testing.T) {
 
 func TestAccSSMParameter_DataType_awsEC2Image(t *testing.T) {
 	var param ssm.Parameter
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -662,7 +662,7 @@ func testAccCheckParameterExists(n string, param *ssm.Parameter) resource.TestCh
 			return fmt.Errorf("No SSM Parameter ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		paramInput := &ssm.GetParametersInput{
 			Names: []*string{
@@ -687,7 +687,7 @@ func testAccCheckParameterExists(n string, param *ssm.Parameter) resource.TestCh
 }
 
 func testAccCheckParameterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_parameter" {
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -31,7 +30,7 @@ func TestAccSSMPatchBaselineDataSource_existingBaseline(t *testing.T) {
 
 func TestAccSSMPatchBaselineDataSource_newBaseline(t *testing.T) {
 	resourceName := "data.aws_ssm_patch_baseline.test_new"
-	rName := sdkacctest.RandomWithPrefix("tf-bl-test")
+	rName := acctest.RandomWithPrefix(t, "tf-bl-test")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
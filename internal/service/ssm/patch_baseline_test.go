@@ -354,7 +354,7 @@ func testAccCheckPatchBaselineExists(n string, patch *ssm.PatchBaselineIdentity)
 			return fmt.Errorf("No SSM Patch Baseline ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		resp, err := conn.DescribePatchBaselines(&ssm.DescribePatchBaselinesInput{
 			Filters: []*ssm.PatchOrchestratorFilter{
@@ -380,7 +380,7 @@ func testAccCheckPatchBaselineExists(n string, patch *ssm.PatchBaselineIdentity)
 }
 
 func testAccCheckPatchBaselineDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_patch_baseline" {
@@ -69,7 +69,7 @@ func ResourceResourceDataSync() *schema.Resource {
 }
 
 func resourceResourceDataSyncCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 	input := &ssm.CreateResourceDataSyncInput{
 		S3Destination: expandSsmResourceDataSyncS3Destination(d),
 		SyncName:      aws.String(d.Get("name").(string)),
@@ -98,7 +98,7 @@ func resourceResourceDataSyncCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceResourceDataSyncRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	syncItem, err := FindResourceDataSyncItem(conn, d.Id())
 	if err != nil {
@@ -114,7 +114,7 @@ func resourceResourceDataSyncRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceResourceDataSyncDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	input := &ssm.DeleteResourceDataSyncInput{
 		SyncName: aws.String(d.Get("name").(string)),
@@ -0,0 +1,24 @@
+package storagegateway_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_storagegateway_tape_pool" "test" {
+  pool_name     = %[1]q
+  storage_class = "GLACIER"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_storagegateway_tag" "test" {
+  resource_arn = aws_storagegateway_tape_pool.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -0,0 +1,24 @@
+package swf_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_swf_tag" "test" {
+  resource_arn = aws_swf_domain.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -467,7 +467,7 @@ func TestAccSyntheticsCanary_disappears(t *testing.T) {
 }
 
 func testAccCheckCanaryDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_synthetics_canary" {
@@ -501,7 +501,7 @@ func testAccCheckCanaryExists(n string, canary *synthetics.Canary) resource.Test
 			return fmt.Errorf("No Synthetics Canary ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn()
 
 		output, err := tfsynthetics.FindCanaryByName(conn, rs.Primary.ID)
 
@@ -526,7 +526,7 @@ func testAccCheckCanaryDeleteImplicitResources(n string) resource.TestCheckFunc
 			return fmt.Errorf("synthetics Canary name not set")
 		}
 
-		lambdaConn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		lambdaConn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		layerArn := rs.Primary.Attributes["source_location_arn"]
 		layerArnObj, err := arn.Parse(layerArn)
@@ -18,13 +18,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
 		Name:         "aws_timestreamwrite_database",
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
 
-	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
@@ -35,7 +35,7 @@ func sweepDatabases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -92,7 +92,7 @@ func sweepTables(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -0,0 +1,23 @@
+package timestreamwrite_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_timestreamwrite_tag" "test" {
+  resource_arn = aws_timestreamwrite_database.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
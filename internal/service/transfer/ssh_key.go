@@ -51,7 +51,7 @@ func ResourceSSHKey() *schema.Resource {
 }
 
 func resourceSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 	userName := d.Get("user_name").(string)
 	serverID := d.Get("server_id").(string)
 
@@ -74,7 +74,7 @@ func resourceSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 	serverID, userName, sshKeyID, err := DecodeSSHKeyID(d.Id())
 	if err != nil {
 		return fmt.Errorf("error parsing Transfer SSH Public Key ID: %s", err)
@@ -117,7 +117,7 @@ func resourceSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 	serverID, userName, sshKeyID, err := DecodeSSHKeyID(d.Id())
 	if err != nil {
 		return fmt.Errorf("error parsing Transfer SSH Public Key ID: %s", err)
@@ -17,7 +17,7 @@ import (
 
 func testAccSSHKey_basic(t *testing.T) {
 	var conf transfer.SshPublicKey
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resourceName := "aws_transfer_ssh_key.test"
 
@@ -61,7 +61,7 @@ func testAccCheckSSHKeyExists(n string, res *transfer.SshPublicKey) resource.Tes
 			return fmt.Errorf("No Transfer Ssh Public Key ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
 		serverID, userName, sshKeyID, err := tftransfer.DecodeSSHKeyID(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error parsing Transfer SSH Public Key ID: %s", err)
@@ -88,7 +88,7 @@ func testAccCheckSSHKeyExists(n string, res *transfer.SshPublicKey) resource.Tes
 }
 
 func testAccCheckSSHKeyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_ssh_key" {
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_transfer_server", &resource.Sweeper{
 		Name: "aws_transfer_server",
 		F:    sweepServers,
 	})
@@ -26,7 +26,7 @@ func sweepServers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TransferConn
+	conn := client.(*conns.AWSClient).TransferConn()
 	input := &transfer.ListServersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
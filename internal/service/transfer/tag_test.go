@@ -0,0 +1,25 @@
+package transfer_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_transfer_tag" "test" {
+  resource_arn = aws_transfer_server.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
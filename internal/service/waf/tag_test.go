@@ -0,0 +1,24 @@
+package waf_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_rule" "test" {
+  name        = %[1]q
+  metric_name = replace(%[1]q, "-", "")
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_waf_tag" "test" {
+  resource_arn = aws_waf_rule.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -18,7 +18,7 @@ import (
 
 func TestAccWAFV2RegexPatternSet_basic(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -78,7 +78,7 @@ func TestAccWAFV2RegexPatternSet_basic(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_disappears(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -101,7 +101,7 @@ func TestAccWAFV2RegexPatternSet_disappears(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_minimal(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -127,7 +127,7 @@ func TestAccWAFV2RegexPatternSet_minimal(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_changeNameForceNew(t *testing.T) {
 	var before, after wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	rNewName := fmt.Sprintf("regex-pattern-set-%s", sdkacctest.RandString(5))
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
@@ -165,7 +165,7 @@ func TestAccWAFV2RegexPatternSet_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -218,7 +218,7 @@ func testAccCheckRegexPatternSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetRegexPatternSet(
 			&wafv2.GetRegexPatternSetInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -255,7 +255,7 @@ func testAccCheckRegexPatternSetExists(n string, v *wafv2.RegexPatternSet) resou
 			return fmt.Errorf("No WAFv2 RegexPatternSet ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetRegexPatternSet(&wafv2.GetRegexPatternSetInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
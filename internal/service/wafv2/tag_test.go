@@ -0,0 +1,26 @@
+package wafv2_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_ip_set" "test" {
+  name               = %[1]q
+  scope              = "REGIONAL"
+  ip_address_version = "IPV4"
+  addresses          = []
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_wafv2_tag" "test" {
+  resource_arn = aws_wafv2_ip_set.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
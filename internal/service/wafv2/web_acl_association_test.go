@@ -89,7 +89,7 @@ func testAccCheckWebACLAssociationDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
 			ResourceArn: aws.String(rs.Primary.Attributes["resource_arn"]),
 		})
@@ -0,0 +1,19 @@
+package worklink_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_worklink_fleet" "test" {
+  name = %[1]q
+}
+
+resource "aws_worklink_tag" "test" {
+  resource_arn = aws_worklink_fleet.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
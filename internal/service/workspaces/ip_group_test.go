@@ -17,8 +17,8 @@ import (
 
 func testAccIPGroup_basic(t *testing.T) {
 	var v workspaces.IpGroup
-	ipGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	ipGroupNewName := sdkacctest.RandomWithPrefix("tf-acc-test-upd")
+	ipGroupName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	ipGroupNewName := acctest.RandomWithPrefix(t, "tf-acc-test-upd")
 	ipGroupDescription := fmt.Sprintf("Terraform Acceptance Test %s", strings.Title(sdkacctest.RandString(20)))
 	resourceName := "aws_workspaces_ip_group.test"
 
@@ -64,7 +64,7 @@ func testAccIPGroup_basic(t *testing.T) {
 func testAccIPGroup_tags(t *testing.T) {
 	var v workspaces.IpGroup
 	resourceName := "aws_workspaces_ip_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -108,7 +108,7 @@ func testAccIPGroup_tags(t *testing.T) {
 
 func testAccIPGroup_disappears(t *testing.T) {
 	var v workspaces.IpGroup
-	ipGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ipGroupName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	ipGroupDescription := fmt.Sprintf("Terraform Acceptance Test %s", strings.Title(sdkacctest.RandString(20)))
 	resourceName := "aws_workspaces_ip_group.test"
 
@@ -134,7 +134,7 @@ func testAccIPGroup_MultipleDirectories(t *testing.T) {
 	var v workspaces.IpGroup
 	var d1, d2 workspaces.WorkspaceDirectory
 
-	ipGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ipGroupName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_ip_group.test"
@@ -167,7 +167,7 @@ func testAccCheckIPGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 		resp, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
 			GroupIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -200,7 +200,7 @@ func testAccCheckIPGroupExists(n string, v *workspaces.IpGroup) resource.TestChe
 			return fmt.Errorf("No Workpsaces IP Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 		resp, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
 			GroupIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -0,0 +1,24 @@
+package xray_test
+
+import (
+	"fmt"
+)
+
+func testAccTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_group" "test" {
+  group_name        = %[1]q
+  filter_expression = "responsetime > 5"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_xray_tag" "test" {
+  resource_arn = aws_xray_group.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
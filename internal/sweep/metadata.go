@@ -0,0 +1,97 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// creationTimeAttributeNames are the names of resource attributes holding an RFC3339 creation timestamp, in order of preference.
+var creationTimeAttributeNames = []string{
+	"created_date",
+	"created_at",
+	"created_time",
+	"creation_date",
+	"create_date",
+	"creation_time",
+	"create_time",
+	"creation_timestamp",
+	"create_timestamp",
+	"created",
+}
+
+// resourceMetadata holds the resource attributes used to filter swept resources.
+// A nil field means that the value is unknown.
+type resourceMetadata struct {
+	created *time.Time
+	tags    map[string]string
+}
+
+// ReadResource reads the current state of the resource into a copy of the specified resource data.
+// A nil result and error means that the resource no longer exists.
+func ReadResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) (*schema.ResourceData, error) {
+	rd := resource.Data(d.State())
+
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(ctx, rd, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(ctx, rd, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return nil, fmt.Errorf("error reading resource: %s", diags[i].Summary)
+			}
+		}
+	} else if err := resource.Read(rd, meta); err != nil {
+		return nil, err
+	}
+
+	if rd.Id() == "" {
+		return nil, nil
+	}
+
+	return rd, nil
+}
+
+// metadataFromResourceData returns the creation timestamp and tags of a resource
+// for the attributes its schema defines.
+func metadataFromResourceData(resource *schema.Resource, d *schema.ResourceData) *resourceMetadata {
+	m := &resourceMetadata{}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if s, ok := resource.Schema[k]; !ok || s.Type != schema.TypeMap {
+			continue
+		}
+
+		m.tags = make(map[string]string)
+
+		for key, value := range d.Get(k).(map[string]interface{}) {
+			m.tags[key], _ = value.(string)
+		}
+
+		break
+	}
+
+	for _, k := range creationTimeAttributeNames {
+		if s, ok := resource.Schema[k]; !ok || s.Type != schema.TypeString {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339, d.Get(k).(string)); err == nil {
+			m.created = &t
+			break
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,273 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Options controls which of the resources passed to SweepOrchestratorContext are deleted
+// and where the sweep report is written.
+type Options struct {
+	// DryRun reports the resources that would be deleted without deleting them.
+	DryRun bool
+
+	// MinAge is the minimum age of resources to delete.
+	// If set, resources that do not expose a creation timestamp are not deleted.
+	MinAge time.Duration
+
+	// AllowTags, if non-empty, restricts deletion to resources with at least one matching tag.
+	AllowTags []TagFilter
+
+	// DenyTags protects resources with any matching tag from deletion.
+	DenyTags []TagFilter
+
+	// ReportFile is the path of the JSON report file. No report is written if empty.
+	ReportFile string
+
+	// Parallelism is the maximum number of sweepers run concurrently in a region.
+	Parallelism int
+
+	// ServiceConcurrency caps the number of concurrent deletions by service package name.
+	// Services without an entry are capped at DefaultServiceConcurrency.
+	ServiceConcurrency map[string]int
+}
+
+const (
+	DefaultParallelism        = 10
+	DefaultServiceConcurrency = 10
+)
+
+// serviceConcurrency returns the maximum number of concurrent deletions for the specified service.
+func (o *Options) serviceConcurrency(service string) int {
+	if v, ok := o.ServiceConcurrency[service]; ok {
+		return v
+	}
+
+	return DefaultServiceConcurrency
+}
+
+// ParseServiceConcurrency parses a comma-separated list of service=limit pairs.
+func ParseServiceConcurrency(s string) (map[string]int, error) {
+	concurrency := make(map[string]int)
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+
+		if v == "" {
+			continue
+		}
+
+		parts := strings.SplitN(v, "=", 2)
+
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid service concurrency (%s): expected service=limit", v)
+		}
+
+		limit, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+
+		if err != nil || limit < 1 {
+			return nil, fmt.Errorf("invalid service concurrency (%s): limit must be a positive integer", v)
+		}
+
+		concurrency[strings.TrimSpace(parts[0])] = limit
+	}
+
+	return concurrency, nil
+}
+
+// TagFilter matches a resource tag by key and, if Value is non-empty, by value.
+type TagFilter struct {
+	Key   string
+	Value string
+}
+
+func (f TagFilter) String() string {
+	if f.Value == "" {
+		return f.Key
+	}
+
+	return f.Key + "=" + f.Value
+}
+
+func (f TagFilter) match(tags map[string]string) bool {
+	v, ok := tags[f.Key]
+
+	if !ok {
+		return false
+	}
+
+	return f.Value == "" || v == f.Value
+}
+
+// ParseTagFilters parses a comma-separated list of tag keys or key=value pairs.
+func ParseTagFilters(s string) ([]TagFilter, error) {
+	var filters []TagFilter
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+
+		if v == "" {
+			continue
+		}
+
+		parts := strings.SplitN(v, "=", 2)
+		filter := TagFilter{Key: strings.TrimSpace(parts[0])}
+
+		if filter.Key == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s): empty key", v)
+		}
+
+		if len(parts) == 2 {
+			filter.Value = strings.TrimSpace(parts[1])
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// OptionsFromEnv returns the sweep options configured by environment variables.
+func OptionsFromEnv() (*Options, error) {
+	options := &Options{
+		Parallelism: DefaultParallelism,
+		ReportFile:  os.Getenv(conns.EnvVarSweepReportFile),
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepDryRun, err)
+		}
+
+		options.DryRun = dryRun
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepMinAge, err)
+		}
+
+		options.MinAge = minAge
+	}
+
+	allowTags, err := ParseTagFilters(os.Getenv(conns.EnvVarSweepAllowTags))
+
+	if err != nil {
+		return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepAllowTags, err)
+	}
+
+	options.AllowTags = allowTags
+
+	denyTags, err := ParseTagFilters(os.Getenv(conns.EnvVarSweepDenyTags))
+
+	if err != nil {
+		return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepDenyTags, err)
+	}
+
+	options.DenyTags = denyTags
+
+	if v := os.Getenv(conns.EnvVarSweepParallelism); v != "" {
+		parallelism, err := strconv.Atoi(v)
+
+		if err != nil || parallelism < 1 {
+			return nil, fmt.Errorf("environment variable %s: must be a positive integer", conns.EnvVarSweepParallelism)
+		}
+
+		options.Parallelism = parallelism
+	}
+
+	serviceConcurrency, err := ParseServiceConcurrency(os.Getenv(conns.EnvVarSweepServiceConcurrency))
+
+	if err != nil {
+		return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepServiceConcurrency, err)
+	}
+
+	options.ServiceConcurrency = serviceConcurrency
+
+	return options, nil
+}
+
+var (
+	envOptions     *Options
+	envOptionsErr  error
+	envOptionsOnce sync.Once
+)
+
+// optionsFromEnv returns the sweep options configured by environment variables, parsed once per run.
+func optionsFromEnv() (*Options, error) {
+	envOptionsOnce.Do(func() {
+		envOptions, envOptionsErr = OptionsFromEnv()
+	})
+
+	return envOptions, envOptionsErr
+}
+
+// filtered returns whether resource metadata is needed to decide if a resource is deleted.
+func (o *Options) filtered() bool {
+	return o.MinAge > 0 || len(o.AllowTags) > 0 || len(o.DenyTags) > 0
+}
+
+// skipReason returns why a resource must not be deleted, or an empty string if it may be deleted.
+// Resources with unknown tags are never deleted when an allow list is configured, but are not protected by the deny list.
+func (o *Options) skipReason(now time.Time, m *resourceMetadata) string {
+	if m.tags != nil {
+		for _, filter := range o.DenyTags {
+			if filter.match(m.tags) {
+				return fmt.Sprintf("has denied tag %s", filter)
+			}
+		}
+	}
+
+	if len(o.AllowTags) > 0 {
+		if m.tags == nil {
+			return "tags unknown"
+		}
+
+		allowed := false
+
+		for _, filter := range o.AllowTags {
+			if filter.match(m.tags) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			filters := make([]string, 0, len(o.AllowTags))
+
+			for _, filter := range o.AllowTags {
+				filters = append(filters, filter.String())
+			}
+
+			sort.Strings(filters)
+
+			return fmt.Sprintf("has none of allowed tags %s", strings.Join(filters, ", "))
+		}
+	}
+
+	if o.MinAge > 0 {
+		if m.created == nil {
+			return "creation time unknown"
+		}
+
+		if age := now.Sub(*m.created); age < o.MinAge {
+			return fmt.Sprintf("age %s is less than minimum %s", age.Truncate(time.Second), o.MinAge)
+		}
+	}
+
+	return ""
+}
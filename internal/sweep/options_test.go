@@ -0,0 +1,159 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseTagFilters(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         string
+		Expected      []TagFilter
+		ExpectedError bool
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:  "keys and values",
+			Input: "Owner, Environment=shared ,Empty=",
+			Expected: []TagFilter{
+				{Key: "Owner"},
+				{Key: "Environment", Value: "shared"},
+				{Key: "Empty"},
+			},
+		},
+		{
+			Name:  "value containing separator",
+			Input: "Expression=a=b",
+			Expected: []TagFilter{
+				{Key: "Expression", Value: "a=b"},
+			},
+		},
+		{
+			Name:          "empty key",
+			Input:         "Owner,=shared",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ParseTagFilters(testCase.Input)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestOptionsSkipReason(t *testing.T) {
+	now := time.Date(2021, time.November, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	testCases := []struct {
+		Name           string
+		Options        Options
+		Metadata       resourceMetadata
+		Skipped        bool
+		ExpectedReason string
+	}{
+		{
+			Name:     "no filters",
+			Metadata: resourceMetadata{},
+		},
+		{
+			Name:     "min age old",
+			Options:  Options{MinAge: 24 * time.Hour},
+			Metadata: resourceMetadata{created: &old},
+		},
+		{
+			Name:     "min age recent",
+			Options:  Options{MinAge: 24 * time.Hour},
+			Metadata: resourceMetadata{created: &recent},
+			Skipped:  true,
+		},
+		{
+			Name:           "min age unknown creation time",
+			Options:        Options{MinAge: 24 * time.Hour},
+			Metadata:       resourceMetadata{},
+			Skipped:        true,
+			ExpectedReason: "creation time unknown",
+		},
+		{
+			Name:     "deny key",
+			Options:  Options{DenyTags: []TagFilter{{Key: "DoNotSweep"}}},
+			Metadata: resourceMetadata{tags: map[string]string{"DoNotSweep": ""}},
+			Skipped:  true,
+		},
+		{
+			Name:     "deny value mismatch",
+			Options:  Options{DenyTags: []TagFilter{{Key: "Team", Value: "other"}}},
+			Metadata: resourceMetadata{tags: map[string]string{"Team": "provider"}},
+		},
+		{
+			Name:     "deny unknown tags",
+			Options:  Options{DenyTags: []TagFilter{{Key: "DoNotSweep"}}},
+			Metadata: resourceMetadata{},
+		},
+		{
+			Name:     "allow value match",
+			Options:  Options{AllowTags: []TagFilter{{Key: "Team", Value: "provider"}}},
+			Metadata: resourceMetadata{tags: map[string]string{"Team": "provider"}},
+		},
+		{
+			Name:     "allow no match",
+			Options:  Options{AllowTags: []TagFilter{{Key: "Team", Value: "provider"}}},
+			Metadata: resourceMetadata{tags: map[string]string{"Team": "other"}},
+			Skipped:  true,
+		},
+		{
+			Name:     "allow unknown tags",
+			Options:  Options{AllowTags: []TagFilter{{Key: "Team"}}},
+			Metadata: resourceMetadata{},
+			Skipped:  true,
+		},
+		{
+			Name: "deny takes precedence over allow",
+			Options: Options{
+				AllowTags: []TagFilter{{Key: "Team"}},
+				DenyTags:  []TagFilter{{Key: "DoNotSweep"}},
+			},
+			Metadata: resourceMetadata{tags: map[string]string{"Team": "provider", "DoNotSweep": "true"}},
+			Skipped:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			reason := testCase.Options.skipReason(now, &testCase.Metadata)
+
+			if got := reason != ""; got != testCase.Skipped {
+				t.Errorf("got skipped %t (%q), expected %t", got, reason, testCase.Skipped)
+			}
+
+			if testCase.ExpectedReason != "" && reason != testCase.ExpectedReason {
+				t.Errorf("got reason %q, expected %q", reason, testCase.ExpectedReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Actions recorded in the sweep report.
+const (
+	ReportActionDeleted     = "deleted"
+	ReportActionFailed      = "failed"
+	ReportActionSkipped     = "skipped"
+	ReportActionWouldDelete = "would_delete"
+)
+
+// ReportEntry records what was done with a single swept resource.
+type ReportEntry struct {
+	ID      string            `json:"id"`
+	Action  string            `json:"action"`
+	Reason  string            `json:"reason,omitempty"`
+	Created *time.Time        `json:"created,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+
+	ThrottledRetries int `json:"throttled_retries,omitempty"`
+}
+
+// Report records the swept resources by region and resource type.
+type Report struct {
+	DryRun  bool                                `json:"dry_run"`
+	Regions map[string]map[string][]ReportEntry `json:"regions"`
+
+	mu      sync.Mutex
+	writeMu sync.Mutex
+}
+
+func NewReport(dryRun bool) *Report {
+	return &Report{
+		DryRun:  dryRun,
+		Regions: make(map[string]map[string][]ReportEntry),
+	}
+}
+
+// Add records an entry for a resource of the specified type in the specified region.
+func (r *Report) Add(region, resourceType string, entry ReportEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.Regions[region]; !ok {
+		r.Regions[region] = make(map[string][]ReportEntry)
+	}
+
+	r.Regions[region][resourceType] = append(r.Regions[region][resourceType], entry)
+}
+
+// reportThrottledRetries is the key of the total number of throttled deletion retries in the result of counts.
+const reportThrottledRetries = "throttled_retries"
+
+// counts returns the number of entries by action, and the total number of throttled deletion retries,
+// for resources of the specified type in the specified region.
+func (r *Report) counts(region, resourceType string) map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[string]int)
+
+	for _, entry := range r.Regions[region][resourceType] {
+		counts[entry.Action]++
+		counts[reportThrottledRetries] += entry.ThrottledRetries
+	}
+
+	return counts
+}
+
+// MarshalJSON returns the report with entries sorted by ID so that reports from different runs can be compared.
+func (r *Report) MarshalJSON() ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, resourceTypes := range r.Regions {
+		for _, entries := range resourceTypes {
+			sort.SliceStable(entries, func(i, j int) bool {
+				return entries[i].ID < entries[j].ID
+			})
+		}
+	}
+
+	return json.Marshal(&struct {
+		DryRun  bool                                `json:"dry_run"`
+		Regions map[string]map[string][]ReportEntry `json:"regions"`
+	}{
+		DryRun:  r.DryRun,
+		Regions: r.Regions,
+	})
+}
+
+// WriteFile writes the report as JSON to the specified file, replacing any existing content.
+func (r *Report) WriteFile(filename string) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding sweep report: %w", err)
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("error writing sweep report (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+var (
+	sharedReport     *Report
+	sharedReportOnce sync.Once
+)
+
+// SharedReport returns the report shared by all sweepers in this run.
+func SharedReport(options *Options) *Report {
+	sharedReportOnce.Do(func() {
+		sharedReport = NewReport(options.DryRun)
+	})
+
+	return sharedReport
+}
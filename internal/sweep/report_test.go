@@ -0,0 +1,168 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testSweepResourceThing(deleted *sync.Map) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			switch d.Id() {
+			case "gone":
+				d.SetId("")
+			case "protected":
+				d.Set("created_date", "2021-01-01T00:00:00Z")
+				d.Set("tags_all", map[string]interface{}{"DoNotSweep": "true"})
+			case "recent":
+				d.Set("created_date", time.Now().Format(time.RFC3339))
+			default:
+				d.Set("created_date", "2021-01-01T00:00:00Z")
+			}
+
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted.Store(d.Id(), true)
+
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func TestSweepOrchestratorReport(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Options         Options
+		ExpectedActions map[string]string
+	}{
+		{
+			Name: "no filters",
+			ExpectedActions: map[string]string{
+				"gone":      ReportActionDeleted,
+				"old":       ReportActionDeleted,
+				"protected": ReportActionDeleted,
+				"recent":    ReportActionDeleted,
+			},
+		},
+		{
+			Name: "filters",
+			Options: Options{
+				DenyTags: []TagFilter{{Key: "DoNotSweep"}},
+				MinAge:   24 * time.Hour,
+			},
+			ExpectedActions: map[string]string{
+				"old":       ReportActionDeleted,
+				"protected": ReportActionSkipped,
+				"recent":    ReportActionSkipped,
+			},
+		},
+		{
+			Name: "dry run",
+			Options: Options{
+				DryRun: true,
+				MinAge: 24 * time.Hour,
+			},
+			ExpectedActions: map[string]string{
+				"old":       ReportActionWouldDelete,
+				"protected": ReportActionWouldDelete,
+				"recent":    ReportActionSkipped,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			deleted := &sync.Map{}
+			report := NewReport(testCase.Options.DryRun)
+			client := &conns.AWSClient{Region: "us-west-2"} //lintignore:AWSAT003
+			r := testSweepResourceThing(deleted)
+
+			var sweepResources []*SweepResource
+
+			for _, id := range []string{"gone", "old", "protected", "recent"} {
+				d := r.Data(nil)
+				d.SetId(id)
+
+				sweepResources = append(sweepResources, NewSweepResource(r, d, client))
+			}
+
+			sweeper := func(region string) error {
+				return sweepOrchestrator(context.Background(), &testCase.Options, report, callerSweeperName(), sweepResources, 0, 0, 0, 0, time.Minute)
+			}
+
+			registerSweeper("aws_test_thing", &resource.Sweeper{Name: "aws_test_thing", F: sweeper}, true)
+
+			if err := sweeper(client.Region); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			filename := filepath.Join(t.TempDir(), "report.json")
+
+			if err := report.WriteFile(filename); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := os.ReadFile(filename)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got struct {
+				DryRun  bool                                `json:"dry_run"`
+				Regions map[string]map[string][]ReportEntry `json:"regions"`
+			}
+
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.DryRun != testCase.Options.DryRun {
+				t.Errorf("got dry_run %t, expected %t", got.DryRun, testCase.Options.DryRun)
+			}
+
+			entries := got.Regions[client.Region]["aws_test_thing"]
+
+			if len(entries) != len(testCase.ExpectedActions) {
+				t.Fatalf("got %d report entries, expected %d: %s", len(entries), len(testCase.ExpectedActions), string(b))
+			}
+
+			for _, entry := range entries {
+				if expected := testCase.ExpectedActions[entry.ID]; entry.Action != expected {
+					t.Errorf("got action %q for %s, expected %q", entry.Action, entry.ID, expected)
+				}
+
+				_, ok := deleted.Load(entry.ID)
+
+				if expected := entry.Action == ReportActionDeleted; ok != expected {
+					t.Errorf("got deleted %t for %s, expected %t", ok, entry.ID, expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,414 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Sweeper run statuses reported in the summary table.
+const (
+	SweeperStatusFailed = "failed"
+	SweeperStatusNotRun = "not run"
+	SweeperStatusOK     = "ok"
+)
+
+// SweeperResult records the outcome of running a sweeper in a region.
+type SweeperResult struct {
+	Duration time.Duration
+	Err      error
+	Name     string
+	Reason   string
+	Region   string
+	Service  string
+	Status   string
+}
+
+// sweeperGraph is a directed acyclic graph of sweepers.
+// Each sweeper runs after the sweepers it declares as Dependencies.
+type sweeperGraph struct {
+	sweepers   map[string]*resource.Sweeper
+	dependents map[string][]string
+	inDegree   map[string]int
+
+	// notRun holds the reasons why sweepers must not be run, by name.
+	// Sweepers that are not run do not prevent their dependents from running.
+	notRun map[string]string
+}
+
+// newSweeperGraph returns the graph of the specified sweepers.
+// An error is returned if a dependency is not one of the sweepers or if the dependencies form a cycle.
+func newSweeperGraph(sweepers map[string]*resource.Sweeper) (*sweeperGraph, error) {
+	g := &sweeperGraph{
+		sweepers:   sweepers,
+		dependents: make(map[string][]string),
+		inDegree:   make(map[string]int),
+	}
+
+	for name, s := range sweepers {
+		for _, dependency := range s.Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+
+			g.dependents[dependency] = append(g.dependents[dependency], name)
+		}
+
+		g.inDegree[name] = len(s.Dependencies)
+	}
+
+	// Kahn's algorithm: any sweeper that is never ready is part of, or depends on, a cycle.
+	inDegree := make(map[string]int, len(g.inDegree))
+	var ready []string
+
+	for name, n := range g.inDegree {
+		inDegree[name] = n
+
+		if n == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	for len(ready) > 0 {
+		name := ready[0]
+		ready = ready[1:]
+
+		for _, dependent := range g.dependents[name] {
+			inDegree[dependent]--
+
+			if inDegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+
+		delete(inDegree, name)
+	}
+
+	if len(inDegree) > 0 {
+		var names []string
+
+		for name := range inDegree {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("sweeper dependency cycle involving: %s", strings.Join(names, ", "))
+	}
+
+	return g, nil
+}
+
+// run runs the sweepers in the specified region, at most parallelism at a time.
+// A sweeper is started once all of its dependencies have completed.
+// Unless allowFailures is set, no further sweepers are started after a sweeper fails.
+func (g *sweeperGraph) run(region string, parallelism int, allowFailures bool) []*SweeperResult {
+	inDegree := make(map[string]int, len(g.inDegree))
+	var ready []string
+
+	for name, n := range g.inDegree {
+		inDegree[name] = n
+
+		if n == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	done := make(chan *SweeperResult)
+	results := make(map[string]*SweeperResult, len(g.sweepers))
+	running := 0
+	failed := false
+
+	for {
+		sort.Strings(ready)
+
+		for len(ready) > 0 && running < parallelism && (allowFailures || !failed) {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			if reason, ok := g.notRun[name]; ok {
+				go func() {
+					log.Printf("[WARN] Not running Sweeper (%s) in region (%s): %s", name, region, reason)
+					done <- &SweeperResult{
+						Name:    name,
+						Reason:  reason,
+						Region:  region,
+						Service: sweeperService(name),
+						Status:  SweeperStatusNotRun,
+					}
+				}()
+
+				continue
+			}
+
+			go func() {
+				done <- runSweeper(region, name, g.sweepers[name])
+			}()
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-done
+		running--
+		results[result.Name] = result
+
+		if result.Err != nil {
+			failed = true
+		}
+
+		for _, dependent := range g.dependents[result.Name] {
+			inDegree[dependent]--
+
+			if inDegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(g.sweepers))
+
+	for name := range g.sweepers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	output := make([]*SweeperResult, 0, len(names))
+
+	for _, name := range names {
+		result, ok := results[name]
+
+		if !ok {
+			result = &SweeperResult{
+				Name:    name,
+				Region:  region,
+				Service: sweeperService(name),
+				Status:  SweeperStatusNotRun,
+			}
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
+
+func runSweeper(region, name string, s *resource.Sweeper) *SweeperResult {
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+	start := time.Now()
+	err := s.F(region)
+	elapsed := time.Since(start)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, elapsed)
+
+	result := &SweeperResult{
+		Duration: elapsed,
+		Name:     name,
+		Region:   region,
+		Service:  sweeperService(name),
+		Status:   SweeperStatusOK,
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+		result.Err = err
+		result.Status = SweeperStatusFailed
+	}
+
+	return result
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated filters, with their dependencies.
+// This matches the Terraform Plugin SDK's handling of the -sweep-run flag.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	filters := strings.Split(strings.ToLower(filter), ",")
+
+	if len(filters) == 1 && filters[0] == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		s, ok := source[name]
+
+		if !ok {
+			log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+			return
+		}
+
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, f := range filters {
+			if strings.Contains(strings.ToLower(name), f) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// unorchestratedSweepers returns the reasons why the sweepers in the graph that were not registered
+// with AddOrchestratedTestSweepers must not be run, by name.
+func unorchestratedSweepers(g *sweeperGraph) map[string]string {
+	result := make(map[string]string)
+
+	for name := range g.sweepers {
+		if s, ok := sweepers[name]; !ok || !s.orchestrated {
+			result[name] = "dry run and filter options not supported: sweeper not registered with AddOrchestratedTestSweepers"
+		}
+	}
+
+	return result
+}
+
+// RunSweepers runs the registered sweepers matching the filter in each region in turn and writes a summary table to stdout.
+func RunSweepers(regions []string, filter string, allowFailures bool) error {
+	options, err := optionsFromEnv()
+
+	if err != nil {
+		return err
+	}
+
+	source := make(map[string]*resource.Sweeper, len(sweepers))
+
+	for name, s := range sweepers {
+		source[name] = s.Sweeper
+	}
+
+	g, err := newSweeperGraph(filterSweepers(filter, source))
+
+	if err != nil {
+		return err
+	}
+
+	// Only SweepOrchestrator applies the dry run and filter options, so sweepers that
+	// have not declared that they delete resources only through it must not be run.
+	if options.DryRun || options.filtered() {
+		g.notRun = unorchestratedSweepers(g)
+	}
+
+	var results []*SweeperResult
+	failed := false
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+		regionResults := g.run(region, options.Parallelism, allowFailures)
+		results = append(results, regionResults...)
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		for _, result := range regionResults {
+			if result.Status == SweeperStatusFailed {
+				failed = true
+			}
+		}
+
+		if failed && !allowFailures {
+			break
+		}
+	}
+
+	writeSweeperSummary(os.Stdout, SharedReport(options), results)
+
+	if failed {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// writeSweeperSummary writes a table of sweeper results, with the number of resources each sweeper
+// deleted (or would delete), skipped and failed to delete, and the number of throttled deletion retries.
+func writeSweeperSummary(w io.Writer, report *Report, results []*SweeperResult) {
+	deleted := "DELETED"
+
+	if report.DryRun {
+		deleted = "WOULD DELETE"
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "REGION\tSWEEPER\tSERVICE\tSTATUS\tDURATION\t%s\tSKIPPED\tFAILED\tTHROTTLED\n", deleted)
+
+	for _, result := range results {
+		counts := report.counts(result.Region, result.Name)
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\n",
+			result.Region,
+			result.Name,
+			result.Service,
+			result.Status,
+			result.Duration.Truncate(time.Millisecond),
+			counts[ReportActionDeleted]+counts[ReportActionWouldDelete],
+			counts[ReportActionSkipped],
+			counts[ReportActionFailed],
+			counts[reportThrottledRetries],
+		)
+	}
+
+	tw.Flush()
+
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			fmt.Fprintf(w, "%s: %s: %s\n", result.Region, result.Name, result.Err)
+		case result.Reason != "":
+			fmt.Fprintf(w, "%s: %s: not run: %s\n", result.Region, result.Name, result.Reason)
+		}
+	}
+}
+
+// TestMain runs the sweepers selected by the Terraform Plugin SDK's -sweep, -sweep-run and
+// -sweep-allow-failures flags with the sweeper scheduler, or runs the tests if no regions are specified.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flag.Lookup("sweep").Value.String()
+
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	filter := flag.Lookup("sweep-run").Value.String()
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+
+	if err := RunSweepers(strings.Split(regions, ","), filter, allowFailures); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,466 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testSweeperRecorder records the order in which test sweepers complete and their maximum concurrency.
+type testSweeperRecorder struct {
+	mu             sync.Mutex
+	completed      []string
+	running        int
+	maxConcurrency int
+}
+
+func (r *testSweeperRecorder) sweeper(name string, err error, dependencies ...string) *resource.Sweeper {
+	return &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			r.mu.Lock()
+			r.running++
+			if r.running > r.maxConcurrency {
+				r.maxConcurrency = r.running
+			}
+			r.mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			r.mu.Lock()
+			r.running--
+			r.completed = append(r.completed, name)
+			r.mu.Unlock()
+
+			return err
+		},
+	}
+}
+
+func testSweepers(sweepers ...*resource.Sweeper) map[string]*resource.Sweeper {
+	m := make(map[string]*resource.Sweeper, len(sweepers))
+
+	for _, s := range sweepers {
+		m[s.Name] = s
+	}
+
+	return m
+}
+
+func TestNewSweeperGraph(t *testing.T) {
+	r := &testSweeperRecorder{}
+
+	testCases := []struct {
+		Name          string
+		Sweepers      map[string]*resource.Sweeper
+		ExpectedError string
+	}{
+		{
+			Name: "valid",
+			Sweepers: testSweepers(
+				r.sweeper("aws_a", nil),
+				r.sweeper("aws_b", nil, "aws_a"),
+				r.sweeper("aws_c", nil, "aws_a", "aws_b"),
+			),
+		},
+		{
+			Name: "missing dependency",
+			Sweepers: testSweepers(
+				r.sweeper("aws_a", nil, "aws_missing"),
+			),
+			ExpectedError: "sweeper (aws_a) has dependency (aws_missing), but that sweeper was not found",
+		},
+		{
+			Name: "cycle",
+			Sweepers: testSweepers(
+				r.sweeper("aws_a", nil),
+				r.sweeper("aws_b", nil, "aws_a", "aws_d"),
+				r.sweeper("aws_c", nil, "aws_b"),
+				r.sweeper("aws_d", nil, "aws_c"),
+			),
+			ExpectedError: "sweeper dependency cycle involving: aws_b, aws_c, aws_d",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := newSweeperGraph(testCase.Sweepers)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got := err.Error(); got != testCase.ExpectedError {
+				t.Errorf("got error %q, expected %q", got, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestSweeperGraphRun(t *testing.T) {
+	r := &testSweeperRecorder{}
+	g, err := newSweeperGraph(testSweepers(
+		r.sweeper("aws_instance", nil),
+		r.sweeper("aws_lambda_function", nil),
+		r.sweeper("aws_security_group", nil, "aws_instance", "aws_lambda_function"),
+		r.sweeper("aws_sns_topic", nil),
+		r.sweeper("aws_sqs_queue", nil),
+		r.sweeper("aws_subnet", nil, "aws_instance"),
+		r.sweeper("aws_vpc", nil, "aws_security_group", "aws_subnet"),
+	))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	results := g.run("us-west-2", 2, false) //lintignore:AWSAT003
+
+	for _, result := range results {
+		if result.Status != SweeperStatusOK {
+			t.Errorf("got status %q for %s, expected %q", result.Status, result.Name, SweeperStatusOK)
+		}
+	}
+
+	if len(r.completed) != len(g.sweepers) {
+		t.Fatalf("got %d sweepers completed, expected %d", len(r.completed), len(g.sweepers))
+	}
+
+	position := make(map[string]int)
+
+	for i, name := range r.completed {
+		position[name] = i
+	}
+
+	for name, s := range g.sweepers {
+		for _, dependency := range s.Dependencies {
+			if position[dependency] > position[name] {
+				t.Errorf("sweeper %s completed before its dependency %s: %v", name, dependency, r.completed)
+			}
+		}
+	}
+
+	if r.maxConcurrency != 2 {
+		t.Errorf("got maximum concurrency %d, expected 2", r.maxConcurrency)
+	}
+}
+
+func TestSweeperGraphRunFailure(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		AllowFailures    bool
+		ExpectedStatuses map[string]string
+	}{
+		{
+			Name: "stop",
+			ExpectedStatuses: map[string]string{
+				"aws_a": SweeperStatusFailed,
+				"aws_b": SweeperStatusNotRun,
+				"aws_c": SweeperStatusNotRun,
+			},
+		},
+		{
+			Name:          "allow failures",
+			AllowFailures: true,
+			ExpectedStatuses: map[string]string{
+				"aws_a": SweeperStatusFailed,
+				"aws_b": SweeperStatusOK,
+				"aws_c": SweeperStatusOK,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &testSweeperRecorder{}
+			g, err := newSweeperGraph(testSweepers(
+				r.sweeper("aws_a", errors.New("failed")),
+				r.sweeper("aws_b", nil, "aws_a"),
+				r.sweeper("aws_c", nil, "aws_b"),
+			))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, result := range g.run("us-west-2", 1, testCase.AllowFailures) { //lintignore:AWSAT003
+				if expected := testCase.ExpectedStatuses[result.Name]; result.Status != expected {
+					t.Errorf("got status %q for %s, expected %q", result.Status, result.Name, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestSweeperGraphRunNotRun(t *testing.T) {
+	r := &testSweeperRecorder{}
+	g, err := newSweeperGraph(testSweepers(
+		r.sweeper("aws_a", nil),
+		r.sweeper("aws_b", nil, "aws_a"),
+		r.sweeper("aws_c", nil, "aws_b"),
+	))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	g.notRun = map[string]string{"aws_b": "not supported"}
+
+	expectedStatuses := map[string]string{
+		"aws_a": SweeperStatusOK,
+		"aws_b": SweeperStatusNotRun,
+		"aws_c": SweeperStatusOK,
+	}
+
+	for _, result := range g.run("us-west-2", 1, false) { //lintignore:AWSAT003
+		if expected := expectedStatuses[result.Name]; result.Status != expected {
+			t.Errorf("got status %q for %s, expected %q", result.Status, result.Name, expected)
+		}
+
+		if result.Name == "aws_b" && result.Reason != "not supported" {
+			t.Errorf("got reason %q for %s, expected %q", result.Reason, result.Name, "not supported")
+		}
+	}
+
+	if expected := []string{"aws_a", "aws_c"}; !reflect.DeepEqual(r.completed, expected) {
+		t.Errorf("got sweepers completed %v, expected %v", r.completed, expected)
+	}
+}
+
+func TestUnorchestratedSweepers(t *testing.T) {
+	noop := func(region string) error { return nil }
+
+	defer func(s map[string]*registeredSweeper, n map[string]string) {
+		sweepers, sweeperNames = s, n
+	}(sweepers, sweeperNames)
+
+	sweepers = make(map[string]*registeredSweeper)
+	sweeperNames = make(map[string]string)
+
+	registerSweeper("aws_a", &resource.Sweeper{Name: "aws_a", F: noop}, true)
+	registerSweeper("aws_b", &resource.Sweeper{Name: "aws_b", F: noop}, false)
+
+	g, err := newSweeperGraph(testSweepers(
+		&resource.Sweeper{Name: "aws_a", F: noop},
+		&resource.Sweeper{Name: "aws_b", F: noop},
+		&resource.Sweeper{Name: "aws_c", F: noop},
+	))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := unorchestratedSweepers(g)
+
+	if _, ok := got["aws_a"]; ok {
+		t.Error("got aws_a not run, expected it to run")
+	}
+
+	for _, name := range []string{"aws_b", "aws_c"} {
+		if _, ok := got[name]; !ok {
+			t.Errorf("got %s run, expected it not to run", name)
+		}
+	}
+}
+
+// TestSweeperGraphRunSharedClient verifies that sweepers running in parallel share a single client per region.
+// Run with -race to detect unsynchronized access to the client cache.
+func TestSweeperGraphRunSharedClient(t *testing.T) {
+	var mu sync.Mutex
+	created := 0
+
+	defer func(f func(string) (interface{}, error)) {
+		newSweeperClient = f
+	}(newSweeperClient)
+
+	newSweeperClient = func(region string) (interface{}, error) {
+		mu.Lock()
+		created++
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		return &struct{ region string }{region: region}, nil
+	}
+
+	sweeperClientsMu.Lock()
+	sweeperClients = make(map[string]*sweeperClient)
+	sweeperClientsMu.Unlock()
+
+	clients := make(chan interface{}, 2)
+	sweeper := func(name string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name: name,
+			F: func(region string) error {
+				client, err := SharedRegionalSweepClient(region)
+
+				if err != nil {
+					return err
+				}
+
+				clients <- client
+
+				return nil
+			},
+		}
+	}
+
+	g, err := newSweeperGraph(testSweepers(sweeper("aws_a"), sweeper("aws_b")))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, result := range g.run("us-west-2", 2, false) { //lintignore:AWSAT003
+		if result.Status != SweeperStatusOK {
+			t.Errorf("got status %q for %s, expected %q: %v", result.Status, result.Name, SweeperStatusOK, result.Err)
+		}
+	}
+
+	close(clients)
+
+	var first interface{}
+
+	for client := range clients {
+		if first == nil {
+			first = client
+		}
+
+		if client != first {
+			t.Error("got different clients for the same region")
+		}
+	}
+
+	if created != 1 {
+		t.Errorf("got %d clients created, expected 1", created)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	r := &testSweeperRecorder{}
+	source := testSweepers(
+		r.sweeper("aws_instance", nil),
+		r.sweeper("aws_security_group", nil, "aws_instance"),
+		r.sweeper("aws_sns_topic", nil),
+		r.sweeper("aws_vpc", nil, "aws_security_group"),
+	)
+
+	testCases := []struct {
+		Filter   string
+		Expected []string
+	}{
+		{
+			Filter:   "",
+			Expected: []string{"aws_instance", "aws_security_group", "aws_sns_topic", "aws_vpc"},
+		},
+		{
+			Filter:   "aws_vpc",
+			Expected: []string{"aws_instance", "aws_security_group", "aws_vpc"},
+		},
+		{
+			Filter:   "SNS,instance",
+			Expected: []string{"aws_instance", "aws_sns_topic"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Filter, func(t *testing.T) {
+			var got []string
+
+			for name := range filterSweepers(testCase.Filter, source) {
+				got = append(got, name)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestWriteSweeperSummary(t *testing.T) {
+	report := NewReport(false)
+	report.Add("us-west-2", "aws_vpc", ReportEntry{ID: "vpc-1", Action: ReportActionDeleted, ThrottledRetries: 2}) //lintignore:AWSAT003
+	report.Add("us-west-2", "aws_vpc", ReportEntry{ID: "vpc-2", Action: ReportActionSkipped})                      //lintignore:AWSAT003
+
+	var b bytes.Buffer
+
+	writeSweeperSummary(&b, report, []*SweeperResult{
+		{
+			Duration: 1500 * time.Millisecond,
+			Name:     "aws_vpc",
+			Region:   "us-west-2", //lintignore:AWSAT003
+			Service:  "ec2",
+			Status:   SweeperStatusOK,
+		},
+		{
+			Err:     errors.New("boom"),
+			Name:    "aws_sns_topic",
+			Region:  "us-west-2", //lintignore:AWSAT003
+			Service: "sns",
+			Status:  SweeperStatusFailed,
+		},
+		{
+			Name:    "aws_sqs_queue",
+			Reason:  "not supported",
+			Region:  "us-west-2", //lintignore:AWSAT003
+			Service: "sqs",
+			Status:  SweeperStatusNotRun,
+		},
+	})
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+
+	if len(lines) != 6 {
+		t.Fatalf("got %d lines, expected 6:\n%s", len(lines), b.String())
+	}
+
+	if got, expected := strings.Fields(lines[0]), []string{"REGION", "SWEEPER", "SERVICE", "STATUS", "DURATION", "DELETED", "SKIPPED", "FAILED", "THROTTLED"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got header %v, expected %v", got, expected)
+	}
+
+	if got, expected := strings.Fields(lines[1]), []string{"us-west-2", "aws_vpc", "ec2", "ok", "1.5s", "1", "1", "0", "2"}; !reflect.DeepEqual(got, expected) { //lintignore:AWSAT003
+		t.Errorf("got row %v, expected %v", got, expected)
+	}
+
+	if got, expected := lines[4], "us-west-2: aws_sns_topic: boom"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got error line %q, expected %q", got, expected)
+	}
+
+	if got, expected := lines[5], "us-west-2: aws_sqs_queue: not run: not supported"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got not run line %q, expected %q", got, expected)
+	}
+}
+
+// TestRegisteredSweepers verifies the dependencies of all sweepers registered by the service packages imported by the sweeper tests.
+func TestRegisteredSweepers(t *testing.T) {
+	source := make(map[string]*resource.Sweeper, len(sweepers))
+
+	for name, s := range sweepers {
+		source[name] = s.Sweeper
+	}
+
+	if _, err := newSweeperGraph(source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -5,14 +5,21 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"reflect"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,22 +32,152 @@ import (
 const (
 	SweepThrottlingRetryTimeout = 10 * time.Minute
 
+	// SweepThrottlingMinTimeout is the minimum wait before retrying a throttled deletion.
+	// The wait doubles on each retry, up to 10 seconds.
+	SweepThrottlingMinTimeout = 1 * time.Second
+
 	ResourcePrefix = "tf-acc-test"
 )
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
-// SweeperClients is a shared cache of regional conns.AWSClient
-// This prevents client re-initialization for every resource with no benefit.
-var SweeperClients map[string]interface{}
+// registeredSweeper is a sweeper registered with AddTestSweepers or AddOrchestratedTestSweepers.
+type registeredSweeper struct {
+	*resource.Sweeper
+
+	// orchestrated is whether the sweeper function deletes resources only through SweepOrchestrator.
+	orchestrated bool
+
+	// service is the name of the service package containing the sweeper function.
+	service string
+}
+
+// sweepers holds the sweepers registered with AddTestSweepers by name.
+var sweepers = make(map[string]*registeredSweeper)
+
+// sweeperNames maps the fully qualified names of sweeper functions to the names of their sweepers.
+var sweeperNames = make(map[string]string)
+
+// AddTestSweepers registers a sweeper with the Terraform Plugin SDK and with the sweeper scheduler.
+// The sweeper name, by convention the resource type, is used to attribute the resources
+// deleted by the sweeper function in the sweep report.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	registerSweeper(name, s, false)
+}
+
+// AddOrchestratedTestSweepers registers a sweeper like AddTestSweepers, declaring that the sweeper function
+// deletes resources only through SweepOrchestrator, which applies the dry run and filter options.
+// Only such sweepers are run when any of these options is set.
+func AddOrchestratedTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	registerSweeper(name, s, true)
+}
+
+func registerSweeper(name string, s *resource.Sweeper, orchestrated bool) {
+	funcName := runtime.FuncForPC(reflect.ValueOf(s.F).Pointer()).Name()
+
+	sweepers[name] = &registeredSweeper{
+		Sweeper:      s,
+		orchestrated: orchestrated,
+		service:      serviceFromFuncName(funcName),
+	}
+	sweeperNames[funcName] = name
+}
+
+// serviceFromFuncName returns the service package name from a fully qualified function name,
+// e.g. "ec2" for "github.com/hashicorp/terraform-provider-aws/internal/service/ec2.sweepVPCs".
+func serviceFromFuncName(funcName string) string {
+	if i := strings.LastIndex(funcName, "/"); i >= 0 {
+		funcName = funcName[i+1:]
+	}
+
+	if i := strings.Index(funcName, "."); i >= 0 {
+		funcName = funcName[:i]
+	}
+
+	return funcName
+}
+
+// callerSweeperName returns the name of the registered sweeper whose function is on the calling goroutine's stack.
+func callerSweeperName() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+
+		if name, ok := sweeperNames[frame.Function]; ok {
+			return name
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return "unknown"
+}
+
+// sweeperService returns the service package name of the named sweeper.
+func sweeperService(name string) string {
+	if s, ok := sweepers[name]; ok {
+		return s.service
+	}
+
+	return "unknown"
+}
+
+// sweeperClient is a cached regional conns.AWSClient.
+type sweeperClient struct {
+	mu     sync.Mutex
+	client interface{}
+}
+
+var (
+	// sweeperClients is a shared cache of regional conns.AWSClient, by region.
+	// This prevents client re-initialization for every resource with no benefit.
+	sweeperClients   = make(map[string]*sweeperClient)
+	sweeperClientsMu sync.Mutex
+
+	// newSweeperClient returns a new conns.AWSClient for the region. It is replaced in tests.
+	newSweeperClient = newRegionalSweepClient
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
-// functions for a given region
+// functions for a given region.
+// It is safe for concurrent use by sweepers running in parallel: the client for a region is
+// created once, by the first caller, and the other callers wait for it.
 func SharedRegionalSweepClient(region string) (interface{}, error) {
-	if client, ok := SweeperClients[region]; ok {
-		return client, nil
+	sweeperClientsMu.Lock()
+	c, ok := sweeperClients[region]
+	if !ok {
+		c = &sweeperClient{}
+		sweeperClients[region] = c
+	}
+	sweeperClientsMu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		return c.client, nil
 	}
 
+	client, err := newSweeperClient(region)
+	if err != nil {
+		return nil, err
+	}
+
+	c.client = client
+
+	return client, nil
+}
+
+// newRegionalSweepClient returns a new conns.AWSClient for the region, configured from environment variables.
+func newRegionalSweepClient(region string) (interface{}, error) {
 	_, _, err := conns.RequireOneOfEnvVar([]string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
@@ -56,6 +193,7 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 	conf := &conns.Config{
 		MaxRetries: 5,
 		Region:     region,
+		RetryMode:  conns.GetEnvVarWithDefault(conns.EnvVarRetryMode, conns.RetryModeAdaptive),
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
@@ -85,8 +223,6 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		return nil, fmt.Errorf("error getting AWS client: %w", err)
 	}
 
-	SweeperClients[region] = client
-
 	return client, nil
 }
 
@@ -104,22 +240,105 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
+func (sr *SweepResource) region() string {
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		return client.Region
+	}
+
+	return ""
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
-	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
+	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingMinTimeout, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes the specified resources, subject to the sweep options
+// configured by environment variables, and records them in the shared sweep report.
+// Concurrent deletions are capped per service across all running sweepers and throttled deletions are retried.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	options, err := optionsFromEnv()
+
+	if err != nil {
+		return err
+	}
+
+	report := SharedReport(options)
+	err = sweepOrchestrator(ctx, options, report, callerSweeperName(), sweepResources, delay, delayRand, minTimeout, pollInterval, timeout)
+
+	if options.ReportFile != "" {
+		if reportErr := report.WriteFile(options.ReportFile); reportErr != nil {
+			err = multierror.Append(err, reportErr).ErrorOrNil()
+		}
+	}
+
+	return err
+}
+
+func sweepOrchestrator(ctx context.Context, options *Options, report *Report, resourceType string, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
 	var g multierror.Group
+	limiter := serviceLimiter(options, sweeperService(resourceType))
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
+		limiter <- struct{}{}
+
 		g.Go(func() error {
+			defer func() { <-limiter }()
+
+			region := sweepResource.region()
+			entry := ReportEntry{
+				ID: sweepResource.d.Id(),
+			}
+
+			if options.filtered() {
+				rd, err := ReadResource(ctx, sweepResource.resource, sweepResource.d, sweepResource.meta)
+
+				if err != nil {
+					log.Printf("[WARN] Skipping sweep of %s (%s): %s", resourceType, entry.ID, err)
+					entry.Action = ReportActionSkipped
+					entry.Reason = err.Error()
+					report.Add(region, resourceType, entry)
+
+					return nil
+				}
+
+				if rd == nil {
+					log.Printf("[DEBUG] Skipping sweep of %s (%s): not found", resourceType, entry.ID)
+
+					return nil
+				}
+
+				metadata := metadataFromResourceData(sweepResource.resource, rd)
+				entry.Created = metadata.created
+				entry.Tags = metadata.tags
+
+				if reason := options.skipReason(time.Now(), metadata); reason != "" {
+					log.Printf("[INFO] Skipping sweep of %s (%s): %s", resourceType, entry.ID, reason)
+					entry.Action = ReportActionSkipped
+					entry.Reason = reason
+					report.Add(region, resourceType, entry)
+
+					return nil
+				}
+			}
+
+			if options.DryRun {
+				log.Printf("[INFO] Dry run, not sweeping %s (%s)", resourceType, entry.ID)
+				entry.Action = ReportActionWouldDelete
+				report.Add(region, resourceType, entry)
+
+				return nil
+			}
+
+			var throttled int64
+
 			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
 				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
 				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
+					if isThrottlingError(err) {
+						atomic.AddInt64(&throttled, 1)
 						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
 						return resource.RetryableError(err)
 					}
@@ -134,6 +353,17 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
+			entry.ThrottledRetries = int(atomic.LoadInt64(&throttled))
+
+			if err != nil {
+				entry.Action = ReportActionFailed
+				entry.Reason = err.Error()
+			} else {
+				entry.Action = ReportActionDeleted
+			}
+
+			report.Add(region, resourceType, entry)
+
 			return err
 		})
 	}
@@ -141,6 +371,57 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 	return g.Wait().ErrorOrNil()
 }
 
+// throttlingErrorCodes are the AWS error codes returned when an API call is throttled.
+var throttlingErrorCodes = []string{
+	"EC2ThrottledException",
+	"PriorRequestNotComplete",
+	"ProvisionedThroughputExceededException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"SlowDown",
+	"ThrottledException",
+	"Throttling",
+	"TooManyRequestsException",
+}
+
+// isThrottlingError returns whether the error from deleting a resource is caused by throttling.
+// Resources returning diagnostics only preserve the error message, so the error codes are also matched in the message.
+func isThrottlingError(err error) bool {
+	var awsErr awserr.Error
+
+	if errors.As(err, &awsErr) && request.IsErrorThrottle(awsErr) {
+		return true
+	}
+
+	for _, code := range throttlingErrorCodes {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	serviceLimiters   = make(map[string]chan struct{})
+	serviceLimitersMu sync.Mutex
+)
+
+// serviceLimiter returns the semaphore capping concurrent deletions for the specified service.
+func serviceLimiter(options *Options, service string) chan struct{} {
+	serviceLimitersMu.Lock()
+	defer serviceLimitersMu.Unlock()
+
+	if limiter, ok := serviceLimiters[service]; ok {
+		return limiter
+	}
+
+	limiter := make(chan struct{}, options.serviceConcurrency(service))
+	serviceLimiters[service] = limiter
+
+	return limiter
+}
+
 // Check sweeper API call error for reasons to skip sweeping
 // These include missing API endpoints and unsupported API calls
 func SkipSweepError(err error) bool {
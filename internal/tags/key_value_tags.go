@@ -3,9 +3,11 @@ package tags
 import (
 	"fmt"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -17,11 +19,241 @@ const (
 	NameTagKey                                  = `Name`
 	RdsTagKeyPrefix                             = `rds:`
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
+
+	// ResourceTypeVariable is replaced by the Terraform resource type in default tag values.
+	ResourceTypeVariable = `${resource_type}`
+
+	// UnknownVariableValue is the value of tags that are not known until apply in resource diffs.
+	// Matches hcl2shim.UnknownVariableValue in the Terraform Plugin SDK.
+	UnknownVariableValue = `74D0BB5D-3E06-4D1D-B6B7-2C3F45F8E6A2`
 )
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// ResourceTypeConfigs contains tags to default across resources of selected types.
+	ResourceTypeConfigs []*ResourceTypeDefaultConfig
+}
+
+// ResourceTypeDefaultConfig contains tags to default across resources of selected types.
+// Resource type patterns may contain "*" wildcards, e.g. "aws_db_*".
+type ResourceTypeDefaultConfig struct {
+	ExcludeResourceTypes []string
+	IncludeResourceTypes []string
+	Tags                 KeyValueTags
+}
+
+// Matches returns true if the configuration applies to the given resource type.
+// Exclusions take precedence over inclusions. No inclusions matches all resource types.
+func (rc *ResourceTypeDefaultConfig) Matches(resourceType string) bool {
+	return resourceTypeSelected(rc.IncludeResourceTypes, rc.ExcludeResourceTypes, resourceType)
+}
+
+// RequiredConfig contains tag keys that resources must have.
+type RequiredConfig struct {
+	Rules []*RequiredRule
+
+	// resourceType is the resource type the configuration has been resolved for.
+	resourceType string
+}
+
+// RequiredRule contains tag keys that resources of selected types must have
+// and an optional pattern that their values must match.
+// Resource type patterns may contain "*" wildcards, e.g. "aws_db_*".
+type RequiredRule struct {
+	ExcludeResourceTypes []string
+	IncludeResourceTypes []string
+	Keys                 []string
+	ValuePattern         *regexp.Regexp
+}
+
+// Matches returns true if the rule applies to the given resource type.
+// Exclusions take precedence over inclusions. No inclusions matches all resource types.
+func (rr *RequiredRule) Matches(resourceType string) bool {
+	return resourceTypeSelected(rr.IncludeResourceTypes, rr.ExcludeResourceTypes, resourceType)
+}
+
+// ForResourceType returns the RequiredConfig that applies to the given resource type.
+func (rc *RequiredConfig) ForResourceType(resourceType string) *RequiredConfig {
+	if rc == nil {
+		return nil
+	}
+
+	return &RequiredConfig{
+		Rules:        rc.Rules,
+		resourceType: resourceType,
+	}
+}
+
+// Validate returns an error naming any required tag keys missing from the given tags
+// and any tags with values not matching all of their required patterns.
+// Unknown tag values are not validated against patterns.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var missing []string
+	invalid := make(map[string][]string)
+
+	for _, rule := range rc.Rules {
+		if !rule.Matches(rc.resourceType) {
+			continue
+		}
+
+		for _, key := range rule.Keys {
+			value := tags.KeyValue(key)
+
+			if !tags.KeyExists(key) {
+				missing = append(missing, key)
+				continue
+			}
+
+			if rule.ValuePattern == nil || (value != nil && *value == UnknownVariableValue) {
+				continue
+			}
+
+			if value == nil || !rule.ValuePattern.MatchString(*value) {
+				invalid[key] = append(invalid[key], rule.ValuePattern.String())
+			}
+		}
+	}
+
+	if len(missing) == 0 && len(invalid) == 0 {
+		return nil
+	}
+
+	var problems []string
+
+	if len(missing) > 0 {
+		missing = dedupeStrings(missing)
+		sort.Strings(missing)
+		problems = append(problems, fmt.Sprintf("missing required tag keys: %s", strings.Join(missing, ", ")))
+	}
+
+	if len(invalid) > 0 {
+		keys := make([]string, 0, len(invalid))
+		for k := range invalid {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var values []string
+		for _, k := range keys {
+			var patterns []string
+			for _, pattern := range dedupeStrings(invalid[k]) {
+				patterns = append(patterns, strconv.Quote(pattern))
+			}
+			values = append(values, fmt.Sprintf("%s (must match %s)", k, strings.Join(patterns, " and ")))
+		}
+
+		problems = append(problems, fmt.Sprintf("tag values not matching required patterns: %s", strings.Join(values, ", ")))
+	}
+
+	if rc.resourceType == "" {
+		return fmt.Errorf("required tags: %s", strings.Join(problems, "; "))
+	}
+
+	return fmt.Errorf("required tags for %s: %s", rc.resourceType, strings.Join(problems, "; "))
+}
+
+func dedupeStrings(l []string) []string {
+	seen := make(map[string]struct{}, len(l))
+	result := make([]string, 0, len(l))
+
+	for _, v := range l {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// resourceTypeSelected returns true if the given resource type matches any of the included patterns,
+// or there are none, and none of the excluded patterns.
+func resourceTypeSelected(include, exclude []string, resourceType string) bool {
+	for _, pattern := range exclude {
+		if resourceTypeMatches(pattern, resourceType) {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, pattern := range include {
+		if resourceTypeMatches(pattern, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourceTypeMatches(pattern, resourceType string) bool {
+	matched, err := path.Match(pattern, resourceType)
+
+	return err == nil && matched
+}
+
+// ForResourceType returns the DefaultConfig that applies to the given resource type.
+// Tags of matching ResourceTypeConfigs are merged, in order, onto Tags and
+// any ResourceTypeVariable in tag values is replaced by the resource type.
+// If the configuration does not depend on the resource type, the DefaultConfig itself is returned.
+func (dc *DefaultConfig) ForResourceType(resourceType string) *DefaultConfig {
+	if dc == nil || !dc.dependsOnResourceType() {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, rc := range dc.ResourceTypeConfigs {
+		if rc.Matches(resourceType) {
+			tags = tags.Merge(rc.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return &DefaultConfig{}
+	}
+
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		if v != nil && v.Value != nil {
+			value := strings.ReplaceAll(*v.Value, ResourceTypeVariable, resourceType)
+			v = &TagData{
+				AdditionalBoolFields:   v.AdditionalBoolFields,
+				AdditionalStringFields: v.AdditionalStringFields,
+				Value:                  &value,
+			}
+		}
+
+		result[k] = v
+	}
+
+	return &DefaultConfig{Tags: result}
+}
+
+// dependsOnResourceType returns true if the tags defaulted by the configuration vary by resource type.
+func (dc *DefaultConfig) dependsOnResourceType() bool {
+	if len(dc.ResourceTypeConfigs) > 0 {
+		return true
+	}
+
+	for _, v := range dc.Tags {
+		if v != nil && v.Value != nil && strings.Contains(*v.Value, ResourceTypeVariable) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // IgnoreConfig contains various options for removing resource tags.
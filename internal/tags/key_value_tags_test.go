@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -291,6 +292,432 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		want          map[string]string
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			resourceType:  "aws_instance",
+			want:          map[string]string{},
+		},
+		{
+			name: "static tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "interpolated tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "${resource_type}",
+					"key3": "prefix-${resource_type}-${resource_type}",
+				}),
+			},
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "aws_instance",
+				"key3": "prefix-aws_instance-aws_instance",
+			},
+		},
+		{
+			name: "included resource type",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+				ResourceTypeConfigs: []*ResourceTypeDefaultConfig{
+					{
+						IncludeResourceTypes: []string{"aws_instance", "aws_db_*"},
+						Tags: New(map[string]string{
+							"key2": "value2",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_db_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "not included resource type",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+				ResourceTypeConfigs: []*ResourceTypeDefaultConfig{
+					{
+						IncludeResourceTypes: []string{"aws_instance", "aws_db_*"},
+						Tags: New(map[string]string{
+							"key2": "value2",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_s3_bucket",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "excluded resource type",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeConfigs: []*ResourceTypeDefaultConfig{
+					{
+						ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+						Tags: New(map[string]string{
+							"key1": "value1",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_autoscaling_group",
+			want:         map[string]string{},
+		},
+		{
+			name: "not excluded resource type",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeConfigs: []*ResourceTypeDefaultConfig{
+					{
+						ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+						Tags: New(map[string]string{
+							"key1": "value1",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "overriding resource type tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+				ResourceTypeConfigs: []*ResourceTypeDefaultConfig{
+					{
+						IncludeResourceTypes: []string{"aws_instance"},
+						Tags: New(map[string]string{
+							"key2": "${resource_type}-value2",
+						}),
+					},
+					{
+						Tags: New(map[string]string{
+							"key2": "override",
+							"key3": "value3",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "override",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.ForResourceType(testCase.resourceType)
+
+			if got != nil && len(got.ResourceTypeConfigs) > 0 {
+				t.Errorf("got %d resource type configurations, expected none", len(got.ResourceTypeConfigs))
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigForResourceTypeIndependent(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		Tags: New(map[string]string{
+			"key1": "value1",
+		}),
+	}
+
+	if got := defaultConfig.ForResourceType("aws_instance"); got != defaultConfig {
+		t.Errorf("got %v, expected the configuration itself", got)
+	}
+}
+
+func TestResourceTypeDefaultConfigMatches(t *testing.T) {
+	testCases := []struct {
+		name         string
+		config       *ResourceTypeDefaultConfig
+		resourceType string
+		want         bool
+	}{
+		{
+			name:         "empty config",
+			config:       &ResourceTypeDefaultConfig{},
+			resourceType: "aws_instance",
+			want:         true,
+		},
+		{
+			name: "included",
+			config: &ResourceTypeDefaultConfig{
+				IncludeResourceTypes: []string{"aws_instance"},
+			},
+			resourceType: "aws_instance",
+			want:         true,
+		},
+		{
+			name: "included wildcard",
+			config: &ResourceTypeDefaultConfig{
+				IncludeResourceTypes: []string{"aws_db_*"},
+			},
+			resourceType: "aws_db_instance",
+			want:         true,
+		},
+		{
+			name: "not included",
+			config: &ResourceTypeDefaultConfig{
+				IncludeResourceTypes: []string{"aws_db_*"},
+			},
+			resourceType: "aws_instance",
+			want:         false,
+		},
+		{
+			name: "excluded",
+			config: &ResourceTypeDefaultConfig{
+				ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+			},
+			resourceType: "aws_autoscaling_group",
+			want:         false,
+		},
+		{
+			name: "excluded and included",
+			config: &ResourceTypeDefaultConfig{
+				ExcludeResourceTypes: []string{"aws_db_proxy*"},
+				IncludeResourceTypes: []string{"aws_db_*"},
+			},
+			resourceType: "aws_db_proxy_endpoint",
+			want:         false,
+		},
+		{
+			name: "invalid pattern",
+			config: &ResourceTypeDefaultConfig{
+				IncludeResourceTypes: []string{"aws_db_["},
+			},
+			resourceType: "aws_db_instance",
+			want:         false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := testCase.config.Matches(testCase.resourceType); got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		resourceType   string
+		tags           KeyValueTags
+		wantErr        string
+	}{
+		{
+			name:           "nil config",
+			requiredConfig: nil,
+			resourceType:   "aws_instance",
+			tags:           New(map[string]string{}),
+		},
+		{
+			name: "all keys present",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						Keys: []string{"key1", "key2"},
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "",
+			}),
+		},
+		{
+			name: "missing keys",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						Keys: []string{"key3", "key1", "key2"},
+					},
+					{
+						Keys: []string{"key3"},
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			wantErr: "required tags for aws_instance: missing required tag keys: key2, key3",
+		},
+		{
+			name: "matching values",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						Keys:         []string{"key1"},
+						ValuePattern: regexp.MustCompile(`^value[0-9]$`),
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "invalid values",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						Keys:         []string{"key1", "key2"},
+						ValuePattern: regexp.MustCompile(`^value[0-9]$`),
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags: New(map[string]string{
+				"key1": "valueA",
+			}),
+			wantErr: `required tags for aws_instance: missing required tag keys: key2; tag values not matching required patterns: key1 (must match "^value[0-9]$")`,
+		},
+		{
+			name: "invalid values multiple patterns",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						Keys:         []string{"key1", "key2"},
+						ValuePattern: regexp.MustCompile(`^value[0-9]$`),
+					},
+					{
+						Keys:         []string{"key1"},
+						ValuePattern: regexp.MustCompile(`^[a-z]+$`),
+					},
+					{
+						Keys:         []string{"key1"},
+						ValuePattern: regexp.MustCompile(`^value[0-9]$`),
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags: New(map[string]string{
+				"key1": "valueA",
+				"key2": "value1",
+			}),
+			wantErr: `required tags for aws_instance: tag values not matching required patterns: key1 (must match "^value[0-9]$" and "^[a-z]+$")`,
+		},
+		{
+			name: "unknown values",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						Keys:         []string{"key1"},
+						ValuePattern: regexp.MustCompile(`^value[0-9]$`),
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags: New(map[string]string{
+				"key1": UnknownVariableValue,
+			}),
+		},
+		{
+			name: "excluded resource type",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+						Keys:                 []string{"key1"},
+					},
+				},
+			},
+			resourceType: "aws_autoscaling_group",
+			tags:         New(map[string]string{}),
+		},
+		{
+			name: "included resource type",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						IncludeResourceTypes: []string{"aws_db_*"},
+						Keys:                 []string{"key1"},
+					},
+				},
+			},
+			resourceType: "aws_db_instance",
+			tags:         New(map[string]string{}),
+			wantErr:      "required tags for aws_db_instance: missing required tag keys: key1",
+		},
+		{
+			name: "not included resource type",
+			requiredConfig: &RequiredConfig{
+				Rules: []*RequiredRule{
+					{
+						IncludeResourceTypes: []string{"aws_db_*"},
+						Keys:                 []string{"key1"},
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags:         New(map[string]string{}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.ForResourceType(testCase.resourceType).Validate(testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.wantErr)
+			}
+
+			if got := err.Error(); got != testCase.wantErr {
+				t.Errorf("got error %q, want %q", got, testCase.wantErr)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -0,0 +1,101 @@
+package tfresource
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time used by Retry and WaitFor.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// RealClock is the Clock backed by the system time.
+var RealClock Clock = realClock{}
+
+type clockContextKey struct{}
+
+// ContextWithClock returns a copy of the context that carries the specified Clock.
+// Retry and WaitFor use the context's Clock unless one is specified with WithClock,
+// so waiters that accept a context can be unit tested with a FakeClock.
+func ContextWithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, clock)
+}
+
+// clockFromContext returns the context's Clock, or RealClock if it has none.
+func clockFromContext(ctx context.Context) Clock {
+	if clock, ok := ctx.Value(clockContextKey{}).(Clock); ok {
+		return clock
+	}
+
+	return RealClock
+}
+
+// FakeClock is a deterministic Clock for unit tests.
+// Time only moves when After is called, which advances the clock by the requested duration and returns immediately,
+// so retry and wait logic runs without sleeping.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+// NewFakeClock returns a FakeClock set to the specified time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{
+		now: now,
+	}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+// Advance moves the clock forward by the specified duration.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Sleeps returns the durations passed to After, in order.
+func (c *FakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var sleeps []time.Duration
+
+	return append(sleeps, c.sleeps...)
+}
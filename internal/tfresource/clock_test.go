@@ -0,0 +1,34 @@
+package tfresource_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)
+	clock := tfresource.NewFakeClock(start)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("got %s, expected %s", got, start)
+	}
+
+	if got, expected := <-clock.After(5*time.Second), start.Add(5*time.Second); !got.Equal(expected) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	clock.Advance(time.Minute)
+
+	if got, expected := clock.Now(), start.Add(65*time.Second); !got.Equal(expected) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	<-clock.After(time.Second)
+
+	if got, expected := clock.Sleeps(), []time.Duration{5 * time.Second, time.Second}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got sleeps %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,93 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ConsistencyPolicy describes how long a newly created resource can take to become visible to reads.
+// Policies are defined per service, see conns.AWSClient.ConsistencyPolicy.
+type ConsistencyPolicy struct {
+	// Timeout is how long "resource not found" errors are retried when reading a new resource.
+	// A zero Timeout disables retries.
+	Timeout time.Duration
+
+	// ReadTimeout, if set, is used by Read instead of Timeout.
+	// It is set from the provider's eventual_consistency_timeout argument, which applies only to reads of new resources
+	// and not to code that uses Timeout to wait for other changes to propagate.
+	ReadTimeout *time.Duration
+
+	// ContinuousTargetOccurence is the number of consecutive successful reads required
+	// before a new resource is considered visible. Defaults to 1.
+	// APIs whose reads can succeed and then fail again shortly after creation should require more than one.
+	ContinuousTargetOccurence int
+}
+
+// Read calls the function `f`, which reads a resource.
+// If `isNewResource` is true, "resource not found" errors are retried until the resource has been read
+// successfully the number of times required by the policy or the policy's read timeout expires,
+// in which case the result of the last read is returned.
+// Other errors are returned immediately.
+// Results are returned by `f` capturing variables:
+//
+//	var output *iam.Role
+//
+//	err := meta.(*conns.AWSClient).ConsistencyPolicy(conns.IAM).Read(ctx, d.IsNewResource(), func(ctx context.Context) error {
+//		var err error
+//		output, err = FindRoleByName(conn, d.Id())
+//		return err
+//	})
+func (p ConsistencyPolicy) Read(ctx context.Context, isNewResource bool, f func(context.Context) error, optFns ...OptionsFunc) error {
+	timeout := p.Timeout
+
+	if p.ReadTimeout != nil {
+		timeout = *p.ReadTimeout
+	}
+
+	if !isNewResource || timeout <= 0 {
+		return f(ctx)
+	}
+
+	target := p.ContinuousTargetOccurence
+
+	if target < 1 {
+		target = 1
+	}
+
+	var lastErr error
+	found := 0
+
+	optFns = append([]OptionsFunc{
+		WithRetryIf(IsNotFound),
+		WithTimeout(timeout),
+	}, optFns...)
+
+	err := WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		if err := f(ctx); err != nil {
+			if NotFound(err) {
+				found = 0
+				lastErr = err
+			}
+
+			return false, err
+		}
+
+		found++
+		lastErr = nil
+
+		return found >= target, nil
+	}, optFns...)
+
+	// On timeout, return the result of the last read rather than the timeout so that callers can handle
+	// a "resource not found" error as usual. A resource whose last read succeeded is considered visible.
+	var timeoutErr *resource.TimeoutError
+
+	if errors.As(err, &timeoutErr) {
+		return lastErr
+	}
+
+	return err
+}
@@ -0,0 +1,144 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestConsistencyPolicyRead(t *testing.T) {
+	notFoundErr := &resource.NotFoundError{}
+	otherErr := errors.New("test")
+
+	testCases := []struct {
+		Name             string
+		Policy           tfresource.ConsistencyPolicy
+		IsNewResource    bool
+		Results          []error
+		ExpectedAttempts int
+		ExpectedSleeps   []time.Duration
+		ExpectedError    error
+	}{
+		{
+			Name:             "existing resource found",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Minute},
+			Results:          []error{nil},
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "existing resource not found",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Minute},
+			Results:          []error{notFoundErr},
+			ExpectedAttempts: 1,
+			ExpectedError:    notFoundErr,
+		},
+		{
+			Name:             "new resource found",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Minute},
+			IsNewResource:    true,
+			Results:          []error{nil},
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "new resource not found then found",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Minute},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr, notFoundErr, nil},
+			ExpectedAttempts: 3,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond, 200 * time.Millisecond},
+		},
+		{
+			Name:             "new resource other error",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Minute},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr, otherErr},
+			ExpectedAttempts: 2,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond},
+			ExpectedError:    otherErr,
+		},
+		{
+			Name:             "new resource retries disabled",
+			Policy:           tfresource.ConsistencyPolicy{},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr},
+			ExpectedAttempts: 1,
+			ExpectedError:    notFoundErr,
+		},
+		{
+			Name:             "new resource read timeout",
+			Policy:           tfresource.ConsistencyPolicy{ReadTimeout: durationPtr(time.Minute)},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr, nil},
+			ExpectedAttempts: 2,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond},
+		},
+		{
+			Name:             "new resource read timeout retries disabled",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Minute, ReadTimeout: durationPtr(0)},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr},
+			ExpectedAttempts: 1,
+			ExpectedError:    notFoundErr,
+		},
+		{
+			Name:             "new resource consecutive reads",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Minute, ContinuousTargetOccurence: 2},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr, nil, notFoundErr, nil, nil},
+			ExpectedAttempts: 5,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+		},
+		{
+			Name:             "new resource timeout not found",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Second},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr, notFoundErr, notFoundErr, notFoundErr, notFoundErr},
+			ExpectedAttempts: 5,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 300 * time.Millisecond},
+			ExpectedError:    notFoundErr,
+		},
+		{
+			Name:             "new resource timeout found",
+			Policy:           tfresource.ConsistencyPolicy{Timeout: time.Second, ContinuousTargetOccurence: 3},
+			IsNewResource:    true,
+			Results:          []error{notFoundErr, notFoundErr, notFoundErr, nil, nil},
+			ExpectedAttempts: 5,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 300 * time.Millisecond},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			clock := tfresource.NewFakeClock(time.Now())
+			attempts := 0
+
+			err := testCase.Policy.Read(tfresource.ContextWithClock(context.Background(), clock), testCase.IsNewResource, func(ctx context.Context) error {
+				err := testCase.Results[attempts]
+				attempts++
+
+				return err
+			})
+
+			if err != testCase.ExpectedError { //nolint:errorlint // Error must be returned unwrapped
+				t.Errorf("got error %v, expected %v", err, testCase.ExpectedError)
+			}
+
+			if attempts != testCase.ExpectedAttempts {
+				t.Errorf("got %d attempts, expected %d", attempts, testCase.ExpectedAttempts)
+			}
+
+			if got := clock.Sleeps(); !reflect.DeepEqual(got, testCase.ExpectedSleeps) {
+				t.Errorf("got sleeps %v, expected %v", got, testCase.ExpectedSleeps)
+			}
+		})
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
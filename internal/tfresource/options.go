@@ -0,0 +1,277 @@
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Backoff returns the delay before the specified retry attempt, starting at 1 for the first retry.
+type Backoff func(retry int) time.Duration
+
+// ConstantBackoff returns a Backoff that always waits the specified delay.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff returns a Backoff that doubles the delay on each retry, starting at initial, up to max.
+func ExponentialBackoff(initial, max time.Duration) Backoff {
+	return func(retry int) time.Duration {
+		delay := initial
+
+		for i := 1; i < retry; i++ {
+			delay *= 2
+
+			if delay >= max {
+				return max
+			}
+		}
+
+		if delay > max {
+			return max
+		}
+
+		return delay
+	}
+}
+
+// Options configures Retry and WaitFor.
+type Options struct {
+	// AttemptTimeout, if positive, limits the duration of each attempt via the context passed to the function.
+	AttemptTimeout time.Duration
+
+	// Backoff determines the delay between attempts.
+	// Defaults to exponential backoff from 100 milliseconds to 10 seconds, as used by resource.StateChangeConf.
+	Backoff Backoff
+
+	// Clock is the source of time. Defaults to the context's Clock (see ContextWithClock), or RealClock.
+	Clock Clock
+
+	// Delay is the wait before the first attempt.
+	Delay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each backoff delay that is randomized.
+	// Randomizing delays avoids many callers hitting an API at the same time.
+	Jitter float64
+
+	// MaxAttempts, if positive, limits the number of attempts.
+	MaxAttempts int
+
+	// RetryIf determines which errors are retried. Other errors are returned immediately.
+	// Defaults to retrying no errors.
+	RetryIf Predicate
+
+	// Timeout, if positive, limits the total duration of all attempts.
+	Timeout time.Duration
+}
+
+// OptionsFunc configures Options.
+type OptionsFunc func(*Options)
+
+// WithAttemptTimeout limits the duration of each attempt.
+func WithAttemptTimeout(timeout time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.AttemptTimeout = timeout
+	}
+}
+
+// WithBackoff sets the delay between attempts.
+func WithBackoff(backoff Backoff) OptionsFunc {
+	return func(o *Options) {
+		o.Backoff = backoff
+	}
+}
+
+// WithClock sets the source of time.
+func WithClock(clock Clock) OptionsFunc {
+	return func(o *Options) {
+		o.Clock = clock
+	}
+}
+
+// WithDelay sets the wait before the first attempt.
+func WithDelay(delay time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.Delay = delay
+	}
+}
+
+// WithJitter randomizes the specified fraction of each backoff delay.
+func WithJitter(jitter float64) OptionsFunc {
+	return func(o *Options) {
+		o.Jitter = jitter
+	}
+}
+
+// WithMaxAttempts limits the number of attempts.
+func WithMaxAttempts(maxAttempts int) OptionsFunc {
+	return func(o *Options) {
+		o.MaxAttempts = maxAttempts
+	}
+}
+
+// WithRetryIf retries errors matching any of the specified predicates.
+func WithRetryIf(predicates ...Predicate) OptionsFunc {
+	return func(o *Options) {
+		o.RetryIf = Any(predicates...)
+	}
+}
+
+// WithTimeout limits the total duration of all attempts.
+func WithTimeout(timeout time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.Timeout = timeout
+	}
+}
+
+// MaxAttemptsError is returned by Retry and WaitFor when the maximum number of attempts is reached.
+type MaxAttemptsError struct {
+	Attempts  int
+	LastError error
+}
+
+func (e *MaxAttemptsError) Error() string {
+	if e.LastError != nil {
+		return fmt.Sprintf("giving up after %d attempt(s): %s", e.Attempts, e.LastError)
+	}
+
+	return fmt.Sprintf("giving up after %d attempt(s)", e.Attempts)
+}
+
+func (e *MaxAttemptsError) Unwrap() error {
+	return e.LastError
+}
+
+// Retry calls the function `f` until it returns nil or an error that is not retryable,
+// or until the timeout or maximum number of attempts configured by `optFns` is reached.
+// Results are returned by `f` capturing variables, so no type assertion is required:
+//
+//	var output *ec2.Vpc
+//
+//	err := tfresource.Retry(ctx, func(ctx context.Context) error {
+//		var err error
+//		output, err = FindVPCByID(conn, id)
+//		return err
+//	}, tfresource.WithTimeout(2*time.Minute), tfresource.WithRetryIf(tfresource.IsNotFound))
+func Retry(ctx context.Context, f func(context.Context) error, optFns ...OptionsFunc) error {
+	return retry(ctx, func(ctx context.Context) (bool, error) {
+		if err := f(ctx); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}, optFns...)
+}
+
+// WaitFor calls the function `f` until it returns true or an error that is not retryable,
+// or until the timeout or maximum number of attempts configured by `optFns` is reached.
+func WaitFor(ctx context.Context, f func(context.Context) (bool, error), optFns ...OptionsFunc) error {
+	return retry(ctx, f, optFns...)
+}
+
+func retry(ctx context.Context, f func(context.Context) (bool, error), optFns ...OptionsFunc) error {
+	opts := Options{
+		Backoff: ExponentialBackoff(100*time.Millisecond, 10*time.Second),
+		RetryIf: func(error) bool { return false },
+	}
+
+	for _, optFn := range optFns {
+		optFn(&opts)
+	}
+
+	clock := opts.Clock
+
+	if clock == nil {
+		clock = clockFromContext(ctx)
+	}
+
+	start := clock.Now()
+
+	if opts.Delay > 0 {
+		if err := sleep(ctx, clock, opts.Delay); err != nil {
+			return err
+		}
+	}
+
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		done, err := attemptWithTimeout(ctx, opts.AttemptTimeout, f)
+
+		if err == nil && done {
+			return nil
+		}
+
+		if err != nil && !opts.RetryIf(err) {
+			return err
+		}
+
+		lastErr = err
+
+		if opts.MaxAttempts > 0 && attempt >= opts.MaxAttempts {
+			return &MaxAttemptsError{
+				Attempts:  attempt,
+				LastError: lastErr,
+			}
+		}
+
+		delay := jitter(opts.Backoff(attempt), opts.Jitter)
+
+		if opts.Timeout > 0 {
+			remaining := opts.Timeout - clock.Now().Sub(start)
+
+			if remaining <= 0 {
+				return &resource.TimeoutError{
+					LastError: lastErr,
+					Timeout:   opts.Timeout,
+				}
+			}
+
+			// Make a final attempt at the timeout rather than giving up early.
+			if delay > remaining {
+				delay = remaining
+			}
+		}
+
+		if err := sleep(ctx, clock, delay); err != nil {
+			return err
+		}
+	}
+}
+
+func attemptWithTimeout(ctx context.Context, timeout time.Duration, f func(context.Context) (bool, error)) (bool, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return f(ctx)
+}
+
+// jitter randomly reduces the delay by up to the specified fraction.
+func jitter(delay time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 || delay <= 0 {
+		return delay
+	}
+
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	return delay - time.Duration(rand.Float64()*fraction*float64(delay))
+}
+
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-clock.After(d):
+		return nil
+	}
+}
@@ -0,0 +1,282 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := tfresource.ExponentialBackoff(100*time.Millisecond, time.Second)
+
+	var got []time.Duration
+
+	for retry := 1; retry <= 6; retry++ {
+		got = append(got, backoff(retry))
+	}
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	retryableErr := awserr.New("Throttling", "Rate exceeded", nil)
+	otherErr := errors.New("test")
+
+	testCases := []struct {
+		Name             string
+		Results          []error
+		OptFns           []tfresource.OptionsFunc
+		ExpectedAttempts int
+		ExpectedSleeps   []time.Duration
+		ExpectedError    func(error) bool
+	}{
+		{
+			Name:             "success",
+			Results:          []error{nil},
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "not retryable by default",
+			Results:          []error{retryableErr},
+			ExpectedAttempts: 1,
+			ExpectedError: func(err error) bool {
+				return err == retryableErr //nolint:errorlint // Error must be returned unwrapped
+			},
+		},
+		{
+			Name:    "retryable then success",
+			Results: []error{retryableErr, retryableErr, nil},
+			OptFns: []tfresource.OptionsFunc{
+				tfresource.WithRetryIf(tfresource.IsAWSErrCode("Throttling")),
+			},
+			ExpectedAttempts: 3,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond, 200 * time.Millisecond},
+		},
+		{
+			Name:    "retryable then not retryable",
+			Results: []error{retryableErr, otherErr},
+			OptFns: []tfresource.OptionsFunc{
+				tfresource.WithRetryIf(tfresource.IsAWSErrCode("Throttling")),
+			},
+			ExpectedAttempts: 2,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond},
+			ExpectedError: func(err error) bool {
+				return err == otherErr //nolint:errorlint // Error must be returned unwrapped
+			},
+		},
+		{
+			Name:    "max attempts",
+			Results: []error{retryableErr, retryableErr, retryableErr, nil},
+			OptFns: []tfresource.OptionsFunc{
+				tfresource.WithBackoff(tfresource.ConstantBackoff(time.Second)),
+				tfresource.WithMaxAttempts(3),
+				tfresource.WithRetryIf(tfresource.IsAWSErrCode("Throttling")),
+			},
+			ExpectedAttempts: 3,
+			ExpectedSleeps:   []time.Duration{time.Second, time.Second},
+			ExpectedError: func(err error) bool {
+				var maxAttemptsErr *tfresource.MaxAttemptsError
+
+				return errors.As(err, &maxAttemptsErr) && maxAttemptsErr.Attempts == 3 && errors.Is(err, retryableErr)
+			},
+		},
+		{
+			Name:    "timeout",
+			Results: []error{retryableErr, retryableErr, retryableErr, retryableErr, nil},
+			OptFns: []tfresource.OptionsFunc{
+				tfresource.WithBackoff(tfresource.ConstantBackoff(40 * time.Second)),
+				tfresource.WithRetryIf(tfresource.IsAWSErrCode("Throttling")),
+				tfresource.WithTimeout(time.Minute),
+			},
+			ExpectedAttempts: 3,
+			ExpectedSleeps:   []time.Duration{40 * time.Second, 20 * time.Second},
+			ExpectedError: func(err error) bool {
+				var timeoutErr *resource.TimeoutError
+
+				return errors.As(err, &timeoutErr) && timeoutErr.LastError == retryableErr //nolint:errorlint // Error must be returned unwrapped
+			},
+		},
+		{
+			Name:    "delay",
+			Results: []error{nil},
+			OptFns: []tfresource.OptionsFunc{
+				tfresource.WithDelay(5 * time.Second),
+			},
+			ExpectedAttempts: 1,
+			ExpectedSleeps:   []time.Duration{5 * time.Second},
+		},
+		{
+			Name:    "retry new resource not found",
+			Results: []error{&resource.NotFoundError{}, nil},
+			OptFns: []tfresource.OptionsFunc{
+				tfresource.WithRetryIf(tfresource.All(tfresource.If(true), tfresource.IsNotFound)),
+			},
+			ExpectedAttempts: 2,
+			ExpectedSleeps:   []time.Duration{100 * time.Millisecond},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			clock := tfresource.NewFakeClock(time.Now())
+			attempts := 0
+
+			err := tfresource.Retry(tfresource.ContextWithClock(context.Background(), clock), func(ctx context.Context) error {
+				err := testCase.Results[attempts]
+				attempts++
+
+				return err
+			}, testCase.OptFns...)
+
+			if testCase.ExpectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if !testCase.ExpectedError(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if attempts != testCase.ExpectedAttempts {
+				t.Errorf("got %d attempts, expected %d", attempts, testCase.ExpectedAttempts)
+			}
+
+			if got := clock.Sleeps(); !reflect.DeepEqual(got, testCase.ExpectedSleeps) {
+				t.Errorf("got sleeps %v, expected %v", got, testCase.ExpectedSleeps)
+			}
+		})
+	}
+}
+
+func TestRetryJitter(t *testing.T) {
+	clock := tfresource.NewFakeClock(time.Now())
+	attempts := 0
+
+	err := tfresource.Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		if attempts < 20 {
+			return errors.New("retry")
+		}
+
+		return nil
+	},
+		tfresource.WithBackoff(tfresource.ConstantBackoff(10*time.Second)),
+		tfresource.WithClock(clock),
+		tfresource.WithJitter(0.5),
+		tfresource.WithRetryIf(func(error) bool { return true }),
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, sleep := range clock.Sleeps() {
+		if sleep < 5*time.Second || sleep > 10*time.Second {
+			t.Errorf("got sleep %s, expected between 5s and 10s", sleep)
+		}
+	}
+}
+
+func TestRetryAttemptTimeout(t *testing.T) {
+	clock := tfresource.NewFakeClock(time.Now())
+	attempts := 0
+
+	err := tfresource.Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("expected attempt context to have a deadline")
+		}
+
+		if attempts == 1 {
+			<-ctx.Done()
+
+			return ctx.Err()
+		}
+
+		return nil
+	},
+		tfresource.WithAttemptTimeout(time.Millisecond),
+		tfresource.WithClock(clock),
+		tfresource.WithRetryIf(func(err error) bool { return errors.Is(err, context.DeadlineExceeded) }),
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("got %d attempts, expected 2", attempts)
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := tfresource.Retry(ctx, func(ctx context.Context) error {
+		return errors.New("retry")
+	}, tfresource.WithRetryIf(func(error) bool { return true }))
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWaitFor(t *testing.T) {
+	clock := tfresource.NewFakeClock(time.Now())
+	states := []string{"pending", "pending", "available"}
+	attempts := 0
+
+	err := tfresource.WaitFor(tfresource.ContextWithClock(context.Background(), clock), func(ctx context.Context) (bool, error) {
+		state := states[attempts]
+		attempts++
+
+		return state == "available", nil
+	}, tfresource.WithBackoff(tfresource.ExponentialBackoff(time.Second, time.Minute)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := clock.Sleeps(), []time.Duration{time.Second, 2 * time.Second}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got sleeps %v, expected %v", got, expected)
+	}
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	clock := tfresource.NewFakeClock(time.Now())
+
+	err := tfresource.WaitFor(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, nil
+	},
+		tfresource.WithBackoff(tfresource.ConstantBackoff(time.Minute)),
+		tfresource.WithClock(clock),
+		tfresource.WithTimeout(10*time.Minute),
+	)
+
+	if !tfresource.TimedOut(err) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, expected := len(clock.Sleeps()), 10; got != expected {
+		t.Errorf("got %d sleeps, expected %d", got, expected)
+	}
+}
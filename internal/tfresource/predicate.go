@@ -0,0 +1,67 @@
+package tfresource
+
+import (
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// Predicate reports whether an error satisfies a condition, e.g. whether it is retryable.
+// Predicates are never called with a nil error.
+type Predicate func(error) bool
+
+// IsAWSErrCode returns a Predicate that matches AWS errors with any of the specified codes.
+func IsAWSErrCode(codes ...string) Predicate {
+	return func(err error) bool {
+		return tfawserr.ErrCodeEquals(err, codes...)
+	}
+}
+
+// IsAWSErrMessageContains returns a Predicate that matches AWS errors with the specified code and a message containing the specified string.
+func IsAWSErrMessageContains(code, message string) Predicate {
+	return func(err error) bool {
+		return tfawserr.ErrMessageContains(err, code, message)
+	}
+}
+
+// IsNotFound is a Predicate that matches "resource not found" errors.
+var IsNotFound Predicate = NotFound
+
+// Any returns a Predicate that matches errors matched by any of the specified predicates.
+func Any(predicates ...Predicate) Predicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if predicate(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// All returns a Predicate that matches errors matched by all of the specified predicates.
+func All(predicates ...Predicate) Predicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if !predicate(err) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Not returns a Predicate that matches errors not matched by the specified predicate.
+func Not(predicate Predicate) Predicate {
+	return func(err error) bool {
+		return !predicate(err)
+	}
+}
+
+// If returns a Predicate that matches all errors if the condition is true and no errors otherwise.
+// For example, to retry NotFound errors only for a new resource: All(If(d.IsNewResource()), IsNotFound).
+func If(condition bool) Predicate {
+	return func(error) bool {
+		return condition
+	}
+}
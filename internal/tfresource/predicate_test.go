@@ -0,0 +1,105 @@
+package tfresource_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestPredicates(t *testing.T) {
+	awsErr := awserr.New("InvalidVpcID.NotFound", "The vpc ID 'vpc-12345678' does not exist", nil)
+	notFoundErr := &resource.NotFoundError{}
+	otherErr := errors.New("test")
+
+	testCases := []struct {
+		Name      string
+		Predicate tfresource.Predicate
+		Err       error
+		Expected  bool
+	}{
+		{
+			Name:      "IsAWSErrCode match",
+			Predicate: tfresource.IsAWSErrCode("Throttling", "InvalidVpcID.NotFound"),
+			Err:       awsErr,
+			Expected:  true,
+		},
+		{
+			Name:      "IsAWSErrCode wrapped match",
+			Predicate: tfresource.IsAWSErrCode("InvalidVpcID.NotFound"),
+			Err:       fmt.Errorf("reading VPC: %w", awsErr),
+			Expected:  true,
+		},
+		{
+			Name:      "IsAWSErrCode no match",
+			Predicate: tfresource.IsAWSErrCode("Throttling"),
+			Err:       awsErr,
+		},
+		{
+			Name:      "IsAWSErrMessageContains match",
+			Predicate: tfresource.IsAWSErrMessageContains("InvalidVpcID.NotFound", "does not exist"),
+			Err:       awsErr,
+			Expected:  true,
+		},
+		{
+			Name:      "IsAWSErrMessageContains no match",
+			Predicate: tfresource.IsAWSErrMessageContains("InvalidVpcID.NotFound", "in use"),
+			Err:       awsErr,
+		},
+		{
+			Name:      "IsNotFound match",
+			Predicate: tfresource.IsNotFound,
+			Err:       fmt.Errorf("reading VPC: %w", notFoundErr),
+			Expected:  true,
+		},
+		{
+			Name:      "IsNotFound no match",
+			Predicate: tfresource.IsNotFound,
+			Err:       otherErr,
+		},
+		{
+			Name:      "Any match",
+			Predicate: tfresource.Any(tfresource.IsAWSErrCode("Throttling"), tfresource.IsNotFound),
+			Err:       notFoundErr,
+			Expected:  true,
+		},
+		{
+			Name:      "Any no match",
+			Predicate: tfresource.Any(tfresource.IsAWSErrCode("Throttling"), tfresource.IsNotFound),
+			Err:       otherErr,
+		},
+		{
+			Name:      "Any empty",
+			Predicate: tfresource.Any(),
+			Err:       otherErr,
+		},
+		{
+			Name:      "All match",
+			Predicate: tfresource.All(tfresource.If(true), tfresource.IsNotFound),
+			Err:       notFoundErr,
+			Expected:  true,
+		},
+		{
+			Name:      "All no match",
+			Predicate: tfresource.All(tfresource.If(false), tfresource.IsNotFound),
+			Err:       notFoundErr,
+		},
+		{
+			Name:      "Not",
+			Predicate: tfresource.Not(tfresource.IsNotFound),
+			Err:       otherErr,
+			Expected:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Predicate(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -20,9 +20,18 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+// Returns an error if the merged tags do not satisfy the provider-level required tags.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The provider passes each resource a client with the default and required tags configuration resolved
+	// for the resource type. See conns.AWSClient.ForResourceType.
+	return SetTagsDiffWithDefaultTagsConfig(ctx, diff, meta, meta.(*conns.AWSClient).DefaultTagsConfig)
+}
+
+// SetTagsDiffWithDefaultTagsConfig is SetTagsDiff for resources that can override
+// the provider-level default tags, e.g. via an "override_provider" configuration block.
+func SetTagsDiffWithDefaultTagsConfig(_ context.Context, diff *schema.ResourceDiff, meta interface{}, defaultTagsConfig *tftags.DefaultConfig) error {
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -32,6 +41,12 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	if diff.NewValueKnown("tags") {
+		if err := requiredTagsConfig.Validate(allTags); err != nil {
+			return err
+		}
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
@@ -55,6 +70,29 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// ValidateRegionDiff returns a CustomizeDiffFunc that returns an error if the provider configuration does not allow
+// the region in the specified attribute. The region is checked only when it changes and is known at plan time;
+// resources must still call conns.AWSClient.ValidateRegion before making any change.
+func ValidateRegionDiff(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		region := diff.Get(key).(string)
+
+		if region == "" {
+			return nil
+		}
+
+		if err := meta.(*conns.AWSClient).ValidateRegion(region); err != nil {
+			return fmt.Errorf("error validating %s: %w", key, err)
+		}
+
+		return nil
+	}
+}
+
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
 	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
 	if err != nil {
@@ -93,6 +93,24 @@ func ValidCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidDuration ensures that the string value is a valid, non-negative duration, e.g. "90s" or "2m"
+func ValidDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	duration, err := time.ParseDuration(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative, got: %s", k, value))
+	}
+
+	return
+}
+
 func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	// IAM Policy documents need to be valid JSON, and pass legacy parsing
 	value := v.(string)
@@ -240,6 +240,42 @@ func TestValidCIDRNetworkAddress(t *testing.T) {
 	}
 }
 
+func TestValidDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "0s",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2m30s",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-1m",
+			ErrCount: 1,
+		},
+		{
+			Value:    "2 minutes",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidDuration(tc.Value, "eventual_consistency_timeout")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
 func TestValidIPv4CIDRBlock(t *testing.T) {
 	for _, ts := range []struct {
 		cidr  string
@@ -0,0 +1,40 @@
+// Package allowlist reads files listing known violations of a check.
+package allowlist
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// ReadFile returns the entries of the allowlist file at path, one per line.
+// Leading and trailing white space is trimmed, and blank lines and lines
+// beginning with # are ignored.
+func ReadFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		entries = append(entries, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
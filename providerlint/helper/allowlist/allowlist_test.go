@@ -0,0 +1,38 @@
+package allowlist
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allowlist.txt")
+	content := `# Known violations.
+aws_example_one
+
+  aws_example_two  
+# aws_example_three
+`
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{"aws_example_one", "aws_example_two"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestReadFile_notFound(t *testing.T) {
+	if _, err := ReadFile(filepath.Join(t.TempDir(), "allowlist.txt")); err == nil {
+		t.Error("expected error, got none")
+	}
+}
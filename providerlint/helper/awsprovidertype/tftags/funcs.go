@@ -0,0 +1,7 @@
+package tftags
+
+const (
+	FuncNameTagsSchema         = `TagsSchema`
+	FuncNameTagsSchemaComputed = `TagsSchemaComputed`
+	FuncNameTagsSchemaForceNew = `TagsSchemaForceNew`
+)
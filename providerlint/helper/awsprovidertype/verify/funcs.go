@@ -0,0 +1,5 @@
+package verify
+
+const (
+	FuncNameSetTagsDiff = `SetTagsDiff`
+)
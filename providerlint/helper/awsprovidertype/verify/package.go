@@ -0,0 +1,18 @@
+package verify
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `verify`
+	PackagePath = `github.com/hashicorp/terraform-provider-aws/internal/verify`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(e, info, PackagePath, funcName)
+}
@@ -0,0 +1,208 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tftags"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/verify"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resources with tags attribute missing tags_all attribute or SetTagsDiff
+
+The AWSR003 analyzer reports when a resource schema declares the tags attribute
+using tftags.TagsSchema() or tftags.TagsSchemaForceNew(), but does not declare
+the tags_all attribute using tftags.TagsSchemaComputed() or does not include
+verify.SetTagsDiff in CustomizeDiff. Both are required for provider level
+default_tags configuration to be applied.
+
+The CustomizeDiff check follows functions declared in the same package, e.g.
+a resource specific CustomizeDiff function that calls verify.SetTagsDiff().
+The schema check is only performed when Schema is a map literal.
+`
+
+const (
+	analyzerName = "AWSR003"
+
+	attributeNameTags    = "tags"
+	attributeNameTagsAll = "tags_all"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	funcDecls := packageFuncDecls(pass)
+
+	for _, resourceInfo := range resourceInfos {
+		if commentIgnorer.ShouldIgnore(analyzerName, resourceInfo.AstCompositeLit) {
+			continue
+		}
+
+		if !isResource(resourceInfo) {
+			continue
+		}
+
+		schemaField := resourceInfo.Fields[schema.ResourceFieldSchema]
+
+		if schemaField == nil {
+			continue
+		}
+
+		schemaCompositeLit, ok := schemaField.Value.(*ast.CompositeLit)
+
+		if !ok {
+			continue
+		}
+
+		attributes := schemaAttributes(schemaCompositeLit)
+		tags := attributes[attributeNameTags]
+
+		if tags == nil {
+			continue
+		}
+
+		if !isTagsSchemaCall(tags.Value, pass.TypesInfo, tftags.FuncNameTagsSchema, tftags.FuncNameTagsSchemaForceNew) {
+			continue
+		}
+
+		if tagsAll := attributes[attributeNameTagsAll]; tagsAll == nil {
+			pass.Reportf(tags.Pos(), "%s: missing tags_all attribute using tftags.TagsSchemaComputed()", analyzerName)
+		} else if !isTagsSchemaCall(tagsAll.Value, pass.TypesInfo, tftags.FuncNameTagsSchemaComputed) {
+			pass.Reportf(tagsAll.Value.Pos(), "%s: tags_all attribute should use tftags.TagsSchemaComputed()", analyzerName)
+		}
+
+		customizeDiffField := resourceInfo.Fields[schema.ResourceFieldCustomizeDiff]
+
+		if customizeDiffField == nil {
+			pass.Reportf(resourceInfo.AstCompositeLit.Pos(), "%s: missing CustomizeDiff with verify.SetTagsDiff", analyzerName)
+			continue
+		}
+
+		if !referencesSetTagsDiff(customizeDiffField.Value, pass.TypesInfo, funcDecls, make(map[*types.Func]bool)) {
+			pass.Reportf(customizeDiffField.Value.Pos(), "%s: missing verify.SetTagsDiff in CustomizeDiff", analyzerName)
+		}
+	}
+
+	return nil, nil
+}
+
+// isResource returns if the Resource type matches a Terraform Resource declaration.
+// Unlike (schema.ResourceInfo).IsResource(), context aware create functions are included.
+func isResource(info *schema.ResourceInfo) bool {
+	return info.DeclaresField(schema.ResourceFieldCreate) ||
+		info.DeclaresField(schema.ResourceFieldCreateContext) ||
+		info.DeclaresField(schema.ResourceFieldCreateWithoutTimeout)
+}
+
+// schemaAttributes returns the attribute names and their schema of a map[string]*schema.Schema literal.
+func schemaAttributes(cl *ast.CompositeLit) map[string]*ast.KeyValueExpr {
+	result := make(map[string]*ast.KeyValueExpr)
+
+	for _, elt := range cl.Elts {
+		kvExpr, ok := elt.(*ast.KeyValueExpr)
+
+		if !ok {
+			continue
+		}
+
+		key := astutils.ExprStringValue(kvExpr.Key)
+
+		if key == nil {
+			continue
+		}
+
+		result[*key] = kvExpr
+	}
+
+	return result
+}
+
+// isTagsSchemaCall returns if the expression is a call of any of the tftags package functions.
+func isTagsSchemaCall(e ast.Expr, info *types.Info, funcNames ...string) bool {
+	callExpr, ok := e.(*ast.CallExpr)
+
+	if !ok {
+		return false
+	}
+
+	for _, funcName := range funcNames {
+		if tftags.IsFunc(callExpr.Fun, info, funcName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// packageFuncDecls returns the function declarations of the package.
+func packageFuncDecls(pass *analysis.Pass) map[*types.Func]*ast.FuncDecl {
+	result := make(map[*types.Func]*ast.FuncDecl)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			if fn, ok := pass.TypesInfo.Defs[funcDecl.Name].(*types.Func); ok {
+				result[fn] = funcDecl
+			}
+		}
+	}
+
+	return result
+}
+
+// referencesSetTagsDiff returns if the node references verify.SetTagsDiff,
+// directly or in the body of a function declared in the package.
+func referencesSetTagsDiff(node ast.Node, info *types.Info, funcDecls map[*types.Func]*ast.FuncDecl, visited map[*types.Func]bool) bool {
+	var found bool
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if verify.IsFunc(n, info, verify.FuncNameSetTagsDiff) {
+				found = true
+				return false
+			}
+		case *ast.Ident:
+			fn, ok := info.Uses[n].(*types.Func)
+
+			if !ok || visited[fn] {
+				return true
+			}
+
+			visited[fn] = true
+
+			if funcDecl, ok := funcDecls[fn]; ok && referencesSetTagsDiff(funcDecl.Body, info, funcDecls, visited) {
+				found = true
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
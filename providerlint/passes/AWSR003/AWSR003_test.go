@@ -0,0 +1,14 @@
+package AWSR003
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// The testdata package is placed under the provider import path so that it
+// can import stubs of the provider internal packages.
+func TestAWSR003(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "github.com/hashicorp/terraform-provider-aws/internal/service/a")
+}
@@ -0,0 +1,150 @@
+package a
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		Create:        resourceCreate,
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+
+	_ = &schema.Resource{
+		Create:        resourceCreate,
+		CustomizeDiff: resourceCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchemaForceNew(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+
+	_ = &schema.Resource{
+		Create:        resourceCreate,
+		CustomizeDiff: sequence(resourceOtherCustomizeDiff, verify.SetTagsDiff),
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	_ = &schema.Resource{
+		Read: resourceRead,
+		Schema: map[string]*schema.Schema{
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Schema: resourceSchema(),
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR003
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Schema: map[string]*schema.Schema{
+			"tags": tftags.TagsSchema(),
+		},
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{ // want "missing CustomizeDiff with verify.SetTagsDiff"
+		Create: resourceCreate,
+		Schema: map[string]*schema.Schema{
+			"tags": tftags.TagsSchema(), // want "missing tags_all attribute using tftags.TagsSchemaComputed"
+		},
+	}
+
+	_ = &schema.Resource{
+		Create:        resourceCreate,
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchema(), // want "tags_all attribute should use tftags.TagsSchemaComputed"
+		},
+	}
+
+	_ = &schema.Resource{ // want "missing CustomizeDiff with verify.SetTagsDiff"
+		CreateContext: resourceCreateContext,
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+
+	_ = &schema.Resource{
+		Create:        resourceCreate,
+		CustomizeDiff: resourceOtherCustomizeDiff, // want "missing verify.SetTagsDiff in CustomizeDiff"
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchemaForceNew(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tags": tftags.TagsSchema(),
+	}
+}
+
+func resourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceOtherCustomizeDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	return verify.SetTagsDiff(ctx, diff, meta)
+}
+
+func resourceOtherCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return nil
+}
+
+func sequence(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,405 @@
+package AWSR004
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/allowlist"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resources missing Importer or disappears acceptance test
+
+The AWSR004 analyzer reports when a resource registered in the provider
+ResourcesMap does not declare an Importer, or when no test file in the
+resource's package calls acctest.CheckResourceDisappears() with the resource.
+
+The resource function is followed from the ResourcesMap to its package, where
+the returned schema.Resource literal is inspected, following returned calls of
+other functions of the package, e.g. wrapper constructors, and the package's _test.go
+files are searched for acctest.CheckResourceDisappears() calls with an argument
+calling the resource function, e.g. tfsqs.ResourceQueue().
+
+Optional parameters:
+  - disappears-allowlist Comma-separated list of resource type names to ignore for the disappears test check, defaults to none.
+  - disappears-allowlist-file Path of a file listing resource type names to ignore for the disappears test check, one per line, defaults to none.
+  - importer-allowlist Comma-separated list of resource type names to ignore for the Importer check, defaults to none.
+  - importer-allowlist-file Path of a file listing resource type names to ignore for the Importer check, one per line, defaults to none.
+`
+
+const (
+	analyzerName = "AWSR004"
+
+	fieldNameResourcesMap           = "ResourcesMap"
+	funcNameCheckResourceDisappears = "CheckResourceDisappears"
+	packageNameAcctest              = "acctest"
+	testFileSuffix                  = "_test.go"
+)
+
+var (
+	disappearsAllowlist     string
+	disappearsAllowlistFile string
+	importerAllowlist       string
+	importerAllowlistFile   string
+
+	allowlistFilesOnce sync.Once
+	allowlistFilesErr  error
+)
+
+func parseFlags() flag.FlagSet {
+	var flags = flag.NewFlagSet(analyzerName, flag.ExitOnError)
+	flags.StringVar(&disappearsAllowlist, "disappears-allowlist", "", "Comma-separated list of resource type names to ignore for the disappears test check")
+	flags.StringVar(&disappearsAllowlistFile, "disappears-allowlist-file", "", "Path of a file listing resource type names to ignore for the disappears test check, one per line")
+	flags.StringVar(&importerAllowlist, "importer-allowlist", "", "Comma-separated list of resource type names to ignore for the Importer check")
+	flags.StringVar(&importerAllowlistFile, "importer-allowlist-file", "", "Path of a file listing resource type names to ignore for the Importer check, one per line")
+	return *flags
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name:  analyzerName,
+	Doc:   Doc,
+	Flags: parseFlags(),
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	FactTypes: []analysis.Fact{new(resourceFact)},
+	Run:       run,
+}
+
+// resourceFact is exported for functions returning a Terraform Resource declaration.
+type resourceFact struct {
+	DisappearsTest bool
+	Importer       bool
+}
+
+func (*resourceFact) AFact() {}
+
+func (f *resourceFact) String() string {
+	return fmt.Sprintf("resource(disappears=%t, importer=%t)", f.DisappearsTest, f.Importer)
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	allowlistFilesOnce.Do(func() {
+		allowlistFilesErr = readAllowlistFiles()
+	})
+
+	if allowlistFilesErr != nil {
+		return nil, allowlistFilesErr
+	}
+
+	exportResourceFacts(pass)
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	nodeFilter := []ast.Node{
+		(*ast.KeyValueExpr)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		kvExpr := n.(*ast.KeyValueExpr)
+
+		if ident, ok := kvExpr.Key.(*ast.Ident); !ok || ident.Name != fieldNameResourcesMap {
+			return
+		}
+
+		compositeLit, ok := kvExpr.Value.(*ast.CompositeLit)
+
+		if !ok || !schema.IsMapStringResource(compositeLit, pass.TypesInfo) {
+			return
+		}
+
+		for _, elt := range compositeLit.Elts {
+			resourceKVExpr, ok := elt.(*ast.KeyValueExpr)
+
+			if !ok || commentIgnorer.ShouldIgnore(analyzerName, resourceKVExpr) {
+				continue
+			}
+
+			resourceType := astutils.ExprStringValue(resourceKVExpr.Key)
+
+			if resourceType == nil {
+				continue
+			}
+
+			fn := calledFunc(resourceKVExpr.Value, pass.TypesInfo)
+
+			if fn == nil {
+				continue
+			}
+
+			var fact resourceFact
+
+			if !pass.ImportObjectFact(fn, &fact) {
+				continue
+			}
+
+			if !fact.Importer && !isAllowlisted(*resourceType, importerAllowlist) {
+				pass.Reportf(resourceKVExpr.Value.Pos(), "%s: resource %s missing Importer", analyzerName, *resourceType)
+			}
+
+			if !fact.DisappearsTest && !isAllowlisted(*resourceType, disappearsAllowlist) {
+				pass.Reportf(resourceKVExpr.Value.Pos(), "%s: resource %s missing acctest.CheckResourceDisappears() test", analyzerName, *resourceType)
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// exportResourceFacts exports a resourceFact for each function in the package returning a Terraform Resource declaration.
+func exportResourceFacts(pass *analysis.Pass) {
+	var disappearsTestFuncNames map[string]bool
+
+	funcDecls := packageFuncDecls(pass)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Body == nil || funcDecl.Recv != nil {
+				continue
+			}
+
+			fn, ok := pass.TypesInfo.Defs[funcDecl.Name].(*types.Func)
+
+			if !ok || fn.Type().(*types.Signature).Results().Len() != 1 || !schema.IsTypeResource(fn.Type().(*types.Signature).Results().At(0).Type()) {
+				continue
+			}
+
+			resourceInfo := returnedResourceInfo(funcDecl, pass.TypesInfo, funcDecls, map[*types.Func]bool{fn: true})
+
+			if resourceInfo == nil || !isResource(resourceInfo) {
+				continue
+			}
+
+			if disappearsTestFuncNames == nil {
+				disappearsTestFuncNames = packageDisappearsTestFuncNames(pass)
+			}
+
+			pass.ExportObjectFact(fn, &resourceFact{
+				DisappearsTest: disappearsTestFuncNames[fn.Name()],
+				Importer:       resourceInfo.DeclaresField(schema.ResourceFieldImporter),
+			})
+		}
+	}
+}
+
+// packageFuncDecls returns the function declarations of the package.
+func packageFuncDecls(pass *analysis.Pass) map[*types.Func]*ast.FuncDecl {
+	result := make(map[*types.Func]*ast.FuncDecl)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			if fn, ok := pass.TypesInfo.Defs[funcDecl.Name].(*types.Func); ok {
+				result[fn] = funcDecl
+			}
+		}
+	}
+
+	return result
+}
+
+// returnedResourceInfo returns the Resource literal returned by the function, if any,
+// directly or by a call of a function declared in the package.
+func returnedResourceInfo(funcDecl *ast.FuncDecl, info *types.Info, funcDecls map[*types.Func]*ast.FuncDecl, visited map[*types.Func]bool) *schema.ResourceInfo {
+	var result *schema.ResourceInfo
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if result != nil {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) != 1 {
+				return false
+			}
+
+			expr := n.Results[0]
+
+			if unaryExpr, ok := expr.(*ast.UnaryExpr); ok && unaryExpr.Op == token.AND {
+				expr = unaryExpr.X
+			}
+
+			if compositeLit, ok := expr.(*ast.CompositeLit); ok && schema.IsTypeResource(info.TypeOf(compositeLit)) {
+				result = schema.NewResourceInfo(compositeLit, info)
+			}
+
+			if fn := calledFunc(expr, info); fn != nil && !visited[fn] {
+				visited[fn] = true
+
+				if funcDecl, ok := funcDecls[fn]; ok {
+					result = returnedResourceInfo(funcDecl, info, funcDecls, visited)
+				}
+			}
+
+			return false
+		}
+
+		return true
+	})
+
+	return result
+}
+
+// isResource returns if the Resource type matches a Terraform Resource declaration.
+// Unlike (schema.ResourceInfo).IsResource(), context aware create functions are included.
+func isResource(info *schema.ResourceInfo) bool {
+	return info.DeclaresField(schema.ResourceFieldCreate) ||
+		info.DeclaresField(schema.ResourceFieldCreateContext) ||
+		info.DeclaresField(schema.ResourceFieldCreateWithoutTimeout)
+}
+
+// packageDisappearsTestFuncNames returns the names of the functions called in the arguments of
+// acctest.CheckResourceDisappears() calls in the _test.go files of the package's directory.
+// Test files are parsed separately as they are not part of the package when not analyzing tests
+// and external test packages are analyzed as separate packages.
+func packageDisappearsTestFuncNames(pass *analysis.Pass) map[string]bool {
+	result := make(map[string]bool)
+
+	if len(pass.Files) == 0 {
+		return result
+	}
+
+	dir := filepath.Dir(pass.Fset.Position(pass.Files[0].Pos()).Filename)
+	fileNames, err := filepath.Glob(filepath.Join(dir, "*"+testFileSuffix))
+
+	if err != nil {
+		return result
+	}
+
+	fset := token.NewFileSet()
+
+	for _, fileName := range fileNames {
+		file, err := parser.ParseFile(fset, fileName, nil, 0)
+
+		if err != nil {
+			continue
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok || !isCheckResourceDisappears(callExpr.Fun) {
+				return true
+			}
+
+			for _, arg := range callExpr.Args {
+				argCallExpr, ok := arg.(*ast.CallExpr)
+
+				if !ok {
+					continue
+				}
+
+				switch fun := argCallExpr.Fun.(type) {
+				case *ast.Ident:
+					result[fun.Name] = true
+				case *ast.SelectorExpr:
+					result[fun.Sel.Name] = true
+				}
+			}
+
+			return true
+		})
+	}
+
+	return result
+}
+
+// isCheckResourceDisappears returns if the expression is the acctest.CheckResourceDisappears function.
+// Test files are not type checked, so the function is matched by name.
+func isCheckResourceDisappears(e ast.Expr) bool {
+	selectorExpr, ok := e.(*ast.SelectorExpr)
+
+	if !ok || selectorExpr.Sel.Name != funcNameCheckResourceDisappears {
+		return false
+	}
+
+	ident, ok := selectorExpr.X.(*ast.Ident)
+
+	return ok && ident.Name == packageNameAcctest
+}
+
+// calledFunc returns the function called by the expression, if any.
+func calledFunc(e ast.Expr, info *types.Info) *types.Func {
+	callExpr, ok := e.(*ast.CallExpr)
+
+	if !ok {
+		return nil
+	}
+
+	var ident *ast.Ident
+
+	switch fun := callExpr.Fun.(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := info.Uses[ident].(*types.Func)
+
+	return fn
+}
+
+// readAllowlistFiles adds the resource type names listed in the allowlist files to the allowlists.
+func readAllowlistFiles() error {
+	for _, v := range []struct {
+		file      string
+		allowlist *string
+	}{
+		{disappearsAllowlistFile, &disappearsAllowlist},
+		{importerAllowlistFile, &importerAllowlist},
+	} {
+		if v.file == "" {
+			continue
+		}
+
+		entries, err := allowlist.ReadFile(v.file)
+
+		if err != nil {
+			return fmt.Errorf("reading allowlist file: %w", err)
+		}
+
+		if *v.allowlist != "" {
+			entries = append([]string{*v.allowlist}, entries...)
+		}
+
+		*v.allowlist = strings.Join(entries, ",")
+	}
+
+	return nil
+}
+
+func isAllowlisted(resourceType string, allowlist string) bool {
+	for _, v := range strings.Split(allowlist, ",") {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,55 @@
+package AWSR004
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR004(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "a")
+}
+
+func TestAWSR004_allowlist(t *testing.T) {
+	defer func(disappears, importer string) {
+		disappearsAllowlist = disappears
+		importerAllowlist = importer
+	}(disappearsAllowlist, importerAllowlist)
+
+	disappearsAllowlist = "aws_example_no_disappears,aws_example_neither"
+	importerAllowlist = "aws_example_no_importer,aws_example_neither"
+
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "a/allowlist")
+}
+
+func TestAWSR004_allowlistFile(t *testing.T) {
+	defer func(disappears, importer string) {
+		disappearsAllowlist = disappears
+		disappearsAllowlistFile = ""
+		importerAllowlist = importer
+		importerAllowlistFile = ""
+		allowlistFilesOnce = sync.Once{}
+	}(disappearsAllowlist, importerAllowlist)
+
+	dir := t.TempDir()
+	disappearsAllowlistFile = filepath.Join(dir, "disappears.txt")
+	importerAllowlistFile = filepath.Join(dir, "importer.txt")
+
+	if err := os.WriteFile(disappearsAllowlistFile, []byte("aws_example_no_disappears\naws_example_neither\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(importerAllowlistFile, []byte("# Known violations.\naws_example_no_importer\naws_example_neither\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	allowlistFilesOnce = sync.Once{}
+
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "a/allowlist")
+}
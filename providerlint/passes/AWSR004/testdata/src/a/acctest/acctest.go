@@ -0,0 +1,11 @@
+package acctest
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var Provider *schema.Provider
+
+func CheckResourceDisappears(provo *schema.Provider, resource *schema.Resource, resourceName string) func() error {
+	return nil
+}
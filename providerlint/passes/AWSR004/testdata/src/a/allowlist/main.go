@@ -0,0 +1,23 @@
+package allowlist
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"a/service"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			/* Allowlisted cases */
+
+			"aws_example_no_disappears": service.ResourceNoDisappears(),
+			"aws_example_no_importer":   service.ResourceNoImporter(),
+			"aws_example_neither":       service.ResourceNeither(),
+
+			/* Failing cases */
+
+			"aws_example_other": service.ResourceNeither(), // want "resource aws_example_other missing Importer" "resource aws_example_other missing acctest.CheckResourceDisappears"
+		},
+	}
+}
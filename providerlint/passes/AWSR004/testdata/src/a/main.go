@@ -0,0 +1,35 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"a/service"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_example": service.DataSourceExample(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			/* Passing cases */
+
+			"aws_example_complete":      service.ResourceComplete(),
+			"aws_example_internal_test": service.ResourceInternalTest(),
+			"aws_example_wrapper":       service.ResourceWrapper(),
+
+			/* Comment ignored cases */
+
+			//lintignore:AWSR004
+			"aws_example_ignored": service.ResourceNeither(),
+
+			/* Failing cases */
+
+			"aws_example_no_disappears":         service.ResourceNoDisappears(),        // want "resource aws_example_no_disappears missing acctest.CheckResourceDisappears"
+			"aws_example_no_importer":           service.ResourceNoImporter(),          // want "resource aws_example_no_importer missing Importer"
+			"aws_example_neither":               service.ResourceNeither(),             // want "resource aws_example_neither missing Importer" "resource aws_example_neither missing acctest.CheckResourceDisappears"
+			"aws_example_wrapper_no_disappears": service.ResourceWrapperNoDisappears(), // want "resource aws_example_wrapper_no_disappears missing acctest.CheckResourceDisappears"
+		},
+	}
+}
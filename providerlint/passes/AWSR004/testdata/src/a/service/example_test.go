@@ -0,0 +1,12 @@
+package service_test
+
+import (
+	"a/acctest"
+	tfservice "a/service"
+)
+
+func testCheck() {
+	acctest.CheckResourceDisappears(acctest.Provider, tfservice.ResourceComplete(), "aws_example_complete.test")
+	acctest.CheckResourceDisappears(acctest.Provider, tfservice.ResourceNoImporter(), "aws_example_no_importer.test")
+	acctest.CheckResourceDisappears(acctest.Provider, tfservice.ResourceWrapper(), "aws_example_wrapper.test")
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceComplete() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCreateContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func ResourceInternalTest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func ResourceNoDisappears() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func ResourceNoImporter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreate,
+	}
+}
+
+func ResourceNeither() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCreateContext,
+	}
+}
+
+func ResourceWrapper() *schema.Resource {
+	return resourceWrapped("wrapper")
+}
+
+func ResourceWrapperNoDisappears() *schema.Resource {
+	return resourceWrapped("wrapper_no_disappears")
+}
+
+func resourceWrapped(name string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCreateContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func DataSourceExample() *schema.Resource {
+	return &schema.Resource{
+		Read: resourceCreate,
+	}
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
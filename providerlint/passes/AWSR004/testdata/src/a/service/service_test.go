@@ -0,0 +1,9 @@
+package service
+
+import (
+	"a/acctest"
+)
+
+func testCheckInternal() {
+	acctest.CheckResourceDisappears(acctest.Provider, ResourceInternalTest(), "aws_example_internal_test.test")
+}
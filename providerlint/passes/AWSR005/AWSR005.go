@@ -0,0 +1,268 @@
+package AWSR005
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/allowlist"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for non-context aware CRUD functions and AWS Go SDK calls
+
+The AWSR005 analyzer reports:
+
+- schema.Resource literals declaring the Create, Read, Update or Delete
+  fields instead of the context aware CreateContext, ReadContext,
+  UpdateContext or DeleteContext fields.
+- AWS Go SDK service client method calls that have a WithContext variant,
+  e.g. DescribeVpcs() instead of DescribeVpcsWithContext(), within functions
+  that have a context.Context parameter in scope.
+
+Without the context, in-flight requests are not cancelled on interruption or
+timeout. A suggested fix passing the context to the WithContext variant is
+provided for AWS Go SDK calls.
+
+Optional parameters:
+  - allowlist-file Path of a file listing Go source files, relative to the module root, to ignore, one per line, defaults to none.
+`
+
+const (
+	analyzerName = "AWSR005"
+
+	awsSDKServicePackagePath = "github.com/aws/aws-sdk-go/service/"
+	withContextSuffix        = "WithContext"
+)
+
+var legacyResourceFields = map[string]string{
+	schema.ResourceFieldCreate: schema.ResourceFieldCreateContext,
+	schema.ResourceFieldDelete: schema.ResourceFieldDeleteContext,
+	schema.ResourceFieldRead:   schema.ResourceFieldReadContext,
+	schema.ResourceFieldUpdate: schema.ResourceFieldUpdateContext,
+}
+
+var (
+	allowlistFile string
+
+	allowlistedFiles     []string
+	allowlistedFilesOnce sync.Once
+	allowlistedFilesErr  error
+)
+
+func parseFlags() flag.FlagSet {
+	var flags = flag.NewFlagSet(analyzerName, flag.ExitOnError)
+	flags.StringVar(&allowlistFile, "allowlist-file", "", "Path of a file listing Go source files, relative to the module root, to ignore, one per line")
+	return *flags
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name:  analyzerName,
+	Doc:   Doc,
+	Flags: parseFlags(),
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	allowlistedFilesOnce.Do(func() {
+		if allowlistFile == "" {
+			return
+		}
+
+		allowlistedFiles, allowlistedFilesErr = allowlist.ReadFile(allowlistFile)
+
+		if allowlistedFilesErr != nil {
+			allowlistedFilesErr = fmt.Errorf("reading allowlist file: %w", allowlistedFilesErr)
+		}
+	})
+
+	if allowlistedFilesErr != nil {
+		return nil, allowlistedFilesErr
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+
+	for _, resourceInfo := range resourceInfos {
+		for _, fieldName := range []string{schema.ResourceFieldCreate, schema.ResourceFieldRead, schema.ResourceFieldUpdate, schema.ResourceFieldDelete} {
+			kvExpr := resourceInfo.Fields[fieldName]
+
+			if kvExpr == nil || commentIgnorer.ShouldIgnore(analyzerName, kvExpr) || isAllowlisted(pass.Fset, kvExpr.Pos()) {
+				continue
+			}
+
+			pass.Reportf(kvExpr.Key.Pos(), "%s: prefer %s over %s", analyzerName, legacyResourceFields[fieldName], fieldName)
+		}
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		callExpr := n.(*ast.CallExpr)
+
+		if callExpr.Ellipsis.IsValid() {
+			return true
+		}
+
+		selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+		if !ok {
+			return true
+		}
+
+		withContextMethodName := withContextMethod(pass.TypesInfo, selectorExpr)
+
+		if withContextMethodName == "" {
+			return true
+		}
+
+		ctxName := contextInScope(pass.TypesInfo, stack)
+
+		if ctxName == "" || commentIgnorer.ShouldIgnore(analyzerName, callExpr) || isAllowlisted(pass.Fset, callExpr.Pos()) {
+			return true
+		}
+
+		ctxArg := ctxName
+
+		if len(callExpr.Args) > 0 {
+			ctxArg += ", "
+		}
+
+		message := fmt.Sprintf("%s: prefer %s(%s, ...) over %s() with context in scope", analyzerName, withContextMethodName, ctxName, selectorExpr.Sel.Name)
+
+		pass.Report(analysis.Diagnostic{
+			Pos:     selectorExpr.Sel.Pos(),
+			End:     callExpr.End(),
+			Message: message,
+			SuggestedFixes: []analysis.SuggestedFix{
+				{
+					Message: fmt.Sprintf("Replace with %s", withContextMethodName),
+					TextEdits: []analysis.TextEdit{
+						{
+							Pos:     selectorExpr.Sel.Pos(),
+							End:     selectorExpr.Sel.End(),
+							NewText: []byte(withContextMethodName),
+						},
+						{
+							Pos:     callExpr.Lparen + 1,
+							End:     callExpr.Lparen + 1,
+							NewText: []byte(ctxArg),
+						},
+					},
+				},
+			},
+		})
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// withContextMethod returns the name of the WithContext variant of the AWS Go SDK service client method,
+// or an empty string if the selector is not such a method or has no such variant.
+func withContextMethod(info *types.Info, selectorExpr *ast.SelectorExpr) string {
+	selection, ok := info.Selections[selectorExpr]
+
+	if !ok || selection.Kind() != types.MethodVal {
+		return ""
+	}
+
+	method := selection.Obj()
+
+	// Contains rather than HasPrefix to allow for vendored package paths.
+	if method.Pkg() == nil || !strings.Contains(method.Pkg().Path(), awsSDKServicePackagePath) {
+		return ""
+	}
+
+	if strings.HasSuffix(method.Name(), withContextSuffix) {
+		return ""
+	}
+
+	name := method.Name() + withContextSuffix
+
+	if types.NewMethodSet(selection.Recv()).Lookup(method.Pkg(), name) == nil {
+		return ""
+	}
+
+	return name
+}
+
+// contextInScope returns the name of the innermost context.Context parameter of the functions enclosing the node,
+// or an empty string if there is none.
+func contextInScope(info *types.Info, stack []ast.Node) string {
+	for i := len(stack) - 1; i >= 0; i-- {
+		var funcType *ast.FuncType
+
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			funcType = n.Type
+		case *ast.FuncLit:
+			funcType = n.Type
+		default:
+			continue
+		}
+
+		for _, field := range funcType.Params.List {
+			if !isContextType(info.TypeOf(field.Type)) {
+				continue
+			}
+
+			for _, name := range field.Names {
+				if name.Name != "_" {
+					return name.Name
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// isAllowlisted returns whether the position is in a file listed in the allowlist file.
+func isAllowlisted(fset *token.FileSet, pos token.Pos) bool {
+	filename := filepath.ToSlash(fset.Position(pos).Filename)
+
+	for _, v := range allowlistedFiles {
+		if filename == v || strings.HasSuffix(filename, "/"+v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isContextType returns if the type is context.Context.
+func isContextType(t types.Type) bool {
+	named, ok := t.(*types.Named)
+
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
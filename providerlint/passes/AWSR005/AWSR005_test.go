@@ -0,0 +1,34 @@
+package AWSR005
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR005(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, Analyzer, "a")
+}
+
+func TestAWSR005_allowlistFile(t *testing.T) {
+	defer func() {
+		allowlistFile = ""
+		allowlistedFiles = nil
+		allowlistedFilesOnce = sync.Once{}
+	}()
+
+	allowlistFile = filepath.Join(t.TempDir(), "allowlist.txt")
+
+	if err := os.WriteFile(allowlistFile, []byte("# Known violations.\na/allowlist/allowlisted.go\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	allowlistedFilesOnce = sync.Once{}
+
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "a/allowlist")
+}
@@ -0,0 +1,18 @@
+package allowlist
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/* Failing cases */
+
+func f(ctx context.Context, conn *s3.S3) {
+	_ = &schema.Resource{
+		Read: resourceRead, // want "prefer ReadContext over Read"
+	}
+
+	_, _ = conn.GetObject(&s3.GetObjectInput{}) // want "prefer GetObjectWithContext\\(ctx, ...\\) over GetObject\\(\\)"
+}
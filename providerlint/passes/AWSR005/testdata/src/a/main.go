@@ -0,0 +1,109 @@
+package a
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		CreateContext: resourceCreateContext,
+		ReadContext:   resourceReadContext,
+		UpdateContext: resourceUpdateContext,
+		DeleteContext: resourceDeleteContext,
+	}
+
+	_ = &schema.Resource{
+		ReadWithoutTimeout: resourceReadContext,
+	}
+
+	/* Comment ignored cases */
+
+	_ = &schema.Resource{
+		//lintignore:AWSR005
+		Read: resourceRead,
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{
+		Create: resourceCreate, // want "prefer CreateContext over Create"
+		Read:   resourceRead,   // want "prefer ReadContext over Read"
+		Update: resourceUpdate, // want "prefer UpdateContext over Update"
+		Delete: resourceDelete, // want "prefer DeleteContext over Delete"
+	}
+}
+
+func sdkCalls(ctx context.Context, conn *s3.S3) {
+	/* Passing cases */
+
+	_, _ = conn.GetObjectWithContext(ctx, &s3.GetObjectInput{})
+
+	_, _ = conn.GetObjectRequest(&s3.GetObjectInput{})
+
+	_ = conn.WaitUntilObjectExistsWithContext(ctx, &s3.HeadObjectInput{})
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	_, _ = conn.GetObject(&s3.GetObjectInput{})
+
+	/* Failing cases */
+
+	_, _ = conn.GetObject(&s3.GetObjectInput{}) // want "prefer GetObjectWithContext\\(ctx, ...\\) over GetObject\\(\\)"
+
+	_ = conn.ListObjectsV2Pages(&s3.ListObjectsV2Input{}, func(page *s3.ListObjectsV2Output, lastPage bool) bool { // want "prefer ListObjectsV2PagesWithContext\\(ctx, ...\\) over ListObjectsV2Pages\\(\\)"
+		return !lastPage
+	})
+
+	_ = conn.WaitUntilObjectExists(&s3.HeadObjectInput{}) // want "prefer WaitUntilObjectExistsWithContext\\(ctx, ...\\) over WaitUntilObjectExists\\(\\)"
+
+	func() {
+		_, _ = conn.HeadObject(&s3.HeadObjectInput{}) // want "prefer HeadObjectWithContext\\(ctx, ...\\) over HeadObject\\(\\)"
+	}()
+}
+
+func sdkCallsNoContext(conn *s3.S3) {
+	_, _ = conn.GetObject(&s3.GetObjectInput{})
+}
+
+func sdkCallsBlankContext(_ context.Context, conn *s3.S3) {
+	_, _ = conn.GetObject(&s3.GetObjectInput{})
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceDeleteContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}